@@ -14,27 +14,35 @@ import (
 )
 
 var (
-	md_RecordPacketData             protoreflect.MessageDescriptor
-	fd_RecordPacketData_record_data protoreflect.FieldDescriptor
-	fd_RecordPacketData_sender      protoreflect.FieldDescriptor
+	md_CheckersPacketData                    protoreflect.MessageDescriptor
+	fd_CheckersPacketData_record_packet      protoreflect.FieldDescriptor
+	fd_CheckersPacketData_game_invite_packet protoreflect.FieldDescriptor
+	fd_CheckersPacketData_game_accept_packet protoreflect.FieldDescriptor
+	fd_CheckersPacketData_game_move_packet   protoreflect.FieldDescriptor
+	fd_CheckersPacketData_game_resign_packet protoreflect.FieldDescriptor
+	fd_CheckersPacketData_game_result_packet protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_buzzing_checkers_v1_packet_proto_init()
-	md_RecordPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("RecordPacketData")
-	fd_RecordPacketData_record_data = md_RecordPacketData.Fields().ByName("record_data")
-	fd_RecordPacketData_sender = md_RecordPacketData.Fields().ByName("sender")
+	md_CheckersPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("CheckersPacketData")
+	fd_CheckersPacketData_record_packet = md_CheckersPacketData.Fields().ByName("record_packet")
+	fd_CheckersPacketData_game_invite_packet = md_CheckersPacketData.Fields().ByName("game_invite_packet")
+	fd_CheckersPacketData_game_accept_packet = md_CheckersPacketData.Fields().ByName("game_accept_packet")
+	fd_CheckersPacketData_game_move_packet = md_CheckersPacketData.Fields().ByName("game_move_packet")
+	fd_CheckersPacketData_game_resign_packet = md_CheckersPacketData.Fields().ByName("game_resign_packet")
+	fd_CheckersPacketData_game_result_packet = md_CheckersPacketData.Fields().ByName("game_result_packet")
 }
 
-var _ protoreflect.Message = (*fastReflection_RecordPacketData)(nil)
+var _ protoreflect.Message = (*fastReflection_CheckersPacketData)(nil)
 
-type fastReflection_RecordPacketData RecordPacketData
+type fastReflection_CheckersPacketData CheckersPacketData
 
-func (x *RecordPacketData) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RecordPacketData)(x)
+func (x *CheckersPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CheckersPacketData)(x)
 }
 
-func (x *RecordPacketData) slowProtoReflect() protoreflect.Message {
+func (x *CheckersPacketData) slowProtoReflect() protoreflect.Message {
 	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -46,43 +54,43 @@ func (x *RecordPacketData) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RecordPacketData_messageType fastReflection_RecordPacketData_messageType
-var _ protoreflect.MessageType = fastReflection_RecordPacketData_messageType{}
+var _fastReflection_CheckersPacketData_messageType fastReflection_CheckersPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_CheckersPacketData_messageType{}
 
-type fastReflection_RecordPacketData_messageType struct{}
+type fastReflection_CheckersPacketData_messageType struct{}
 
-func (x fastReflection_RecordPacketData_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RecordPacketData)(nil)
+func (x fastReflection_CheckersPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CheckersPacketData)(nil)
 }
-func (x fastReflection_RecordPacketData_messageType) New() protoreflect.Message {
-	return new(fastReflection_RecordPacketData)
+func (x fastReflection_CheckersPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_CheckersPacketData)
 }
-func (x fastReflection_RecordPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordPacketData
+func (x fastReflection_CheckersPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CheckersPacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RecordPacketData) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordPacketData
+func (x *fastReflection_CheckersPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_CheckersPacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RecordPacketData) Type() protoreflect.MessageType {
-	return _fastReflection_RecordPacketData_messageType
+func (x *fastReflection_CheckersPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_CheckersPacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RecordPacketData) New() protoreflect.Message {
-	return new(fastReflection_RecordPacketData)
+func (x *fastReflection_CheckersPacketData) New() protoreflect.Message {
+	return new(fastReflection_CheckersPacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RecordPacketData) Interface() protoreflect.ProtoMessage {
-	return (*RecordPacketData)(x)
+func (x *fastReflection_CheckersPacketData) Interface() protoreflect.ProtoMessage {
+	return (*CheckersPacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -90,17 +98,45 @@ func (x *fastReflection_RecordPacketData) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RecordPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RecordData != "" {
-		value := protoreflect.ValueOfString(x.RecordData)
-		if !f(fd_RecordPacketData_record_data, value) {
-			return
-		}
-	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_RecordPacketData_sender, value) {
-			return
+func (x *fastReflection_CheckersPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Packet != nil {
+		switch o := x.Packet.(type) {
+		case *CheckersPacketData_RecordPacket:
+			v := o.RecordPacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_record_packet, value) {
+				return
+			}
+		case *CheckersPacketData_GameInvitePacket:
+			v := o.GameInvitePacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_game_invite_packet, value) {
+				return
+			}
+		case *CheckersPacketData_GameAcceptPacket:
+			v := o.GameAcceptPacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_game_accept_packet, value) {
+				return
+			}
+		case *CheckersPacketData_GameMovePacket:
+			v := o.GameMovePacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_game_move_packet, value) {
+				return
+			}
+		case *CheckersPacketData_GameResignPacket:
+			v := o.GameResignPacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_game_resign_packet, value) {
+				return
+			}
+		case *CheckersPacketData_GameResultPacket:
+			v := o.GameResultPacket
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_CheckersPacketData_game_result_packet, value) {
+				return
+			}
 		}
 	}
 }
@@ -116,17 +152,61 @@ func (x *fastReflection_RecordPacketData) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RecordPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CheckersPacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		return x.RecordData != ""
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		return x.Sender != ""
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_RecordPacket); ok {
+			return true
+		} else {
+			return false
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_GameInvitePacket); ok {
+			return true
+		} else {
+			return false
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_GameAcceptPacket); ok {
+			return true
+		} else {
+			return false
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_GameMovePacket); ok {
+			return true
+		} else {
+			return false
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_GameResignPacket); ok {
+			return true
+		} else {
+			return false
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		if x.Packet == nil {
+			return false
+		} else if _, ok := x.Packet.(*CheckersPacketData_GameResultPacket); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -136,17 +216,25 @@ func (x *fastReflection_RecordPacketData) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketData) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CheckersPacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		x.RecordData = ""
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		x.Sender = ""
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		x.Packet = nil
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		x.Packet = nil
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		x.Packet = nil
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		x.Packet = nil
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		x.Packet = nil
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		x.Packet = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -156,19 +244,61 @@ func (x *fastReflection_RecordPacketData) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RecordPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckersPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		value := x.RecordData
-		return protoreflect.ValueOfString(value)
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		value := x.Sender
-		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*RecordPacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_RecordPacket); ok {
+			return protoreflect.ValueOfMessage(v.RecordPacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*RecordPacketData)(nil).ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*GameInvitePacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_GameInvitePacket); ok {
+			return protoreflect.ValueOfMessage(v.GameInvitePacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*GameInvitePacketData)(nil).ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*GameAcceptPacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_GameAcceptPacket); ok {
+			return protoreflect.ValueOfMessage(v.GameAcceptPacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*GameAcceptPacketData)(nil).ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*GameMovePacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_GameMovePacket); ok {
+			return protoreflect.ValueOfMessage(v.GameMovePacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*GameMovePacketData)(nil).ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*GameResignPacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_GameResignPacket); ok {
+			return protoreflect.ValueOfMessage(v.GameResignPacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*GameResignPacketData)(nil).ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		if x.Packet == nil {
+			return protoreflect.ValueOfMessage((*GameResultPacketData)(nil).ProtoReflect())
+		} else if v, ok := x.Packet.(*CheckersPacketData_GameResultPacket); ok {
+			return protoreflect.ValueOfMessage(v.GameResultPacket.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*GameResultPacketData)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -182,17 +312,31 @@ func (x *fastReflection_RecordPacketData) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CheckersPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		x.RecordData = value.Interface().(string)
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		x.Sender = value.Interface().(string)
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		cv := value.Message().Interface().(*RecordPacketData)
+		x.Packet = &CheckersPacketData_RecordPacket{RecordPacket: cv}
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		cv := value.Message().Interface().(*GameInvitePacketData)
+		x.Packet = &CheckersPacketData_GameInvitePacket{GameInvitePacket: cv}
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		cv := value.Message().Interface().(*GameAcceptPacketData)
+		x.Packet = &CheckersPacketData_GameAcceptPacket{GameAcceptPacket: cv}
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		cv := value.Message().Interface().(*GameMovePacketData)
+		x.Packet = &CheckersPacketData_GameMovePacket{GameMovePacket: cv}
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		cv := value.Message().Interface().(*GameResignPacketData)
+		x.Packet = &CheckersPacketData_GameResignPacket{GameResignPacket: cv}
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		cv := value.Message().Interface().(*GameResultPacketData)
+		x.Packet = &CheckersPacketData_GameResultPacket{GameResultPacket: cv}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -206,44 +350,168 @@ func (x *fastReflection_RecordPacketData) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckersPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		panic(fmt.Errorf("field record_data of message buzzing.checkers.v1.RecordPacketData is not mutable"))
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		panic(fmt.Errorf("field sender of message buzzing.checkers.v1.RecordPacketData is not mutable"))
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		if x.Packet == nil {
+			value := &RecordPacketData{}
+			oneofValue := &CheckersPacketData_RecordPacket{RecordPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_RecordPacket:
+			return protoreflect.ValueOfMessage(m.RecordPacket.ProtoReflect())
+		default:
+			value := &RecordPacketData{}
+			oneofValue := &CheckersPacketData_RecordPacket{RecordPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		if x.Packet == nil {
+			value := &GameInvitePacketData{}
+			oneofValue := &CheckersPacketData_GameInvitePacket{GameInvitePacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_GameInvitePacket:
+			return protoreflect.ValueOfMessage(m.GameInvitePacket.ProtoReflect())
+		default:
+			value := &GameInvitePacketData{}
+			oneofValue := &CheckersPacketData_GameInvitePacket{GameInvitePacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		if x.Packet == nil {
+			value := &GameAcceptPacketData{}
+			oneofValue := &CheckersPacketData_GameAcceptPacket{GameAcceptPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_GameAcceptPacket:
+			return protoreflect.ValueOfMessage(m.GameAcceptPacket.ProtoReflect())
+		default:
+			value := &GameAcceptPacketData{}
+			oneofValue := &CheckersPacketData_GameAcceptPacket{GameAcceptPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		if x.Packet == nil {
+			value := &GameMovePacketData{}
+			oneofValue := &CheckersPacketData_GameMovePacket{GameMovePacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_GameMovePacket:
+			return protoreflect.ValueOfMessage(m.GameMovePacket.ProtoReflect())
+		default:
+			value := &GameMovePacketData{}
+			oneofValue := &CheckersPacketData_GameMovePacket{GameMovePacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		if x.Packet == nil {
+			value := &GameResignPacketData{}
+			oneofValue := &CheckersPacketData_GameResignPacket{GameResignPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_GameResignPacket:
+			return protoreflect.ValueOfMessage(m.GameResignPacket.ProtoReflect())
+		default:
+			value := &GameResignPacketData{}
+			oneofValue := &CheckersPacketData_GameResignPacket{GameResignPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		if x.Packet == nil {
+			value := &GameResultPacketData{}
+			oneofValue := &CheckersPacketData_GameResultPacket{GameResultPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Packet.(type) {
+		case *CheckersPacketData_GameResultPacket:
+			return protoreflect.ValueOfMessage(m.GameResultPacket.ProtoReflect())
+		default:
+			value := &GameResultPacketData{}
+			oneofValue := &CheckersPacketData_GameResultPacket{GameResultPacket: value}
+			x.Packet = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RecordPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CheckersPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketData.record_data":
-		return protoreflect.ValueOfString("")
-	case "buzzing.checkers.v1.RecordPacketData.sender":
-		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.CheckersPacketData.record_packet":
+		value := &RecordPacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.CheckersPacketData.game_invite_packet":
+		value := &GameInvitePacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.CheckersPacketData.game_accept_packet":
+		value := &GameAcceptPacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.CheckersPacketData.game_move_packet":
+		value := &GameMovePacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.CheckersPacketData.game_resign_packet":
+		value := &GameResignPacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.CheckersPacketData.game_result_packet":
+		value := &GameResultPacketData{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.CheckersPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.CheckersPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RecordPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CheckersPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
+	case "buzzing.checkers.v1.CheckersPacketData.packet":
+		if x.Packet == nil {
+			return nil
+		}
+		switch x.Packet.(type) {
+		case *CheckersPacketData_RecordPacket:
+			return x.Descriptor().Fields().ByName("record_packet")
+		case *CheckersPacketData_GameInvitePacket:
+			return x.Descriptor().Fields().ByName("game_invite_packet")
+		case *CheckersPacketData_GameAcceptPacket:
+			return x.Descriptor().Fields().ByName("game_accept_packet")
+		case *CheckersPacketData_GameMovePacket:
+			return x.Descriptor().Fields().ByName("game_move_packet")
+		case *CheckersPacketData_GameResignPacket:
+			return x.Descriptor().Fields().ByName("game_resign_packet")
+		case *CheckersPacketData_GameResultPacket:
+			return x.Descriptor().Fields().ByName("game_result_packet")
+		}
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordPacketData", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.CheckersPacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -251,7 +519,7 @@ func (x *fastReflection_RecordPacketData) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RecordPacketData) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CheckersPacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -262,7 +530,7 @@ func (x *fastReflection_RecordPacketData) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketData) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CheckersPacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -274,7 +542,7 @@ func (x *fastReflection_RecordPacketData) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RecordPacketData) IsValid() bool {
+func (x *fastReflection_CheckersPacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -284,9 +552,9 @@ func (x *fastReflection_RecordPacketData) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CheckersPacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RecordPacketData)
+		x := input.Message.Interface().(*CheckersPacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -298,12 +566,42 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RecordData)
-		if l > 0 {
+		switch x := x.Packet.(type) {
+		case *CheckersPacketData_RecordPacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.RecordPacket)
 			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Sender)
-		if l > 0 {
+		case *CheckersPacketData_GameInvitePacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GameInvitePacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *CheckersPacketData_GameAcceptPacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GameAcceptPacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *CheckersPacketData_GameMovePacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GameMovePacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *CheckersPacketData_GameResignPacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GameResignPacket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *CheckersPacketData_GameResultPacket:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GameResultPacket)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -316,7 +614,7 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RecordPacketData)
+		x := input.Message.Interface().(*CheckersPacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -335,19 +633,85 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		switch x := x.Packet.(type) {
+		case *CheckersPacketData_RecordPacket:
+			encoded, err := options.Marshal(x.RecordPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *CheckersPacketData_GameInvitePacket:
+			encoded, err := options.Marshal(x.GameInvitePacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
-		}
-		if len(x.RecordData) > 0 {
-			i -= len(x.RecordData)
-			copy(dAtA[i:], x.RecordData)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordData)))
+		case *CheckersPacketData_GameAcceptPacket:
+			encoded, err := options.Marshal(x.GameAcceptPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		case *CheckersPacketData_GameMovePacket:
+			encoded, err := options.Marshal(x.GameMovePacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *CheckersPacketData_GameResignPacket:
+			encoded, err := options.Marshal(x.GameResignPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		case *CheckersPacketData_GameResultPacket:
+			encoded, err := options.Marshal(x.GameResultPacket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -360,7 +724,7 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RecordPacketData)
+		x := input.Message.Interface().(*CheckersPacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -392,17 +756,17 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketData: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CheckersPacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CheckersPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordPacket", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -412,29 +776,32 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecordData = string(dAtA[iNdEx:postIndex])
+				v := &RecordPacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Packet = &CheckersPacketData_RecordPacket{v}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameInvitePacket", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -444,51 +811,194 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
+				v := &GameInvitePacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Packet = &CheckersPacketData_GameInvitePacket{v}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameAcceptPacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &GameAcceptPacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.Packet = &CheckersPacketData_GameAcceptPacket{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameMovePacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				v := &GameMovePacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+				x.Packet = &CheckersPacketData_GameMovePacket{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameResignPacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &GameResignPacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Packet = &CheckersPacketData_GameResignPacket{v}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameResultPacket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &GameResultPacketData{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Packet = &CheckersPacketData_GameResultPacket{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
 		Size:              size,
 		Marshal:           marshal,
 		Unmarshal:         unmarshal,
@@ -498,25 +1008,27 @@ func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_RecordPacketAck        protoreflect.MessageDescriptor
-	fd_RecordPacketAck_result protoreflect.FieldDescriptor
+	md_RecordPacketData             protoreflect.MessageDescriptor
+	fd_RecordPacketData_record_data protoreflect.FieldDescriptor
+	fd_RecordPacketData_sender      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_buzzing_checkers_v1_packet_proto_init()
-	md_RecordPacketAck = File_buzzing_checkers_v1_packet_proto.Messages().ByName("RecordPacketAck")
-	fd_RecordPacketAck_result = md_RecordPacketAck.Fields().ByName("result")
+	md_RecordPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("RecordPacketData")
+	fd_RecordPacketData_record_data = md_RecordPacketData.Fields().ByName("record_data")
+	fd_RecordPacketData_sender = md_RecordPacketData.Fields().ByName("sender")
 }
 
-var _ protoreflect.Message = (*fastReflection_RecordPacketAck)(nil)
+var _ protoreflect.Message = (*fastReflection_RecordPacketData)(nil)
 
-type fastReflection_RecordPacketAck RecordPacketAck
+type fastReflection_RecordPacketData RecordPacketData
 
-func (x *RecordPacketAck) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RecordPacketAck)(x)
+func (x *RecordPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordPacketData)(x)
 }
 
-func (x *RecordPacketAck) slowProtoReflect() protoreflect.Message {
+func (x *RecordPacketData) slowProtoReflect() protoreflect.Message {
 	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -528,43 +1040,43 @@ func (x *RecordPacketAck) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RecordPacketAck_messageType fastReflection_RecordPacketAck_messageType
-var _ protoreflect.MessageType = fastReflection_RecordPacketAck_messageType{}
+var _fastReflection_RecordPacketData_messageType fastReflection_RecordPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_RecordPacketData_messageType{}
 
-type fastReflection_RecordPacketAck_messageType struct{}
+type fastReflection_RecordPacketData_messageType struct{}
 
-func (x fastReflection_RecordPacketAck_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RecordPacketAck)(nil)
+func (x fastReflection_RecordPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordPacketData)(nil)
 }
-func (x fastReflection_RecordPacketAck_messageType) New() protoreflect.Message {
-	return new(fastReflection_RecordPacketAck)
+func (x fastReflection_RecordPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordPacketData)
 }
-func (x fastReflection_RecordPacketAck_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordPacketAck
+func (x fastReflection_RecordPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordPacketData
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RecordPacketAck) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordPacketAck
+func (x *fastReflection_RecordPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordPacketData
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RecordPacketAck) Type() protoreflect.MessageType {
-	return _fastReflection_RecordPacketAck_messageType
+func (x *fastReflection_RecordPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_RecordPacketData_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RecordPacketAck) New() protoreflect.Message {
-	return new(fastReflection_RecordPacketAck)
+func (x *fastReflection_RecordPacketData) New() protoreflect.Message {
+	return new(fastReflection_RecordPacketData)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RecordPacketAck) Interface() protoreflect.ProtoMessage {
-	return (*RecordPacketAck)(x)
+func (x *fastReflection_RecordPacketData) Interface() protoreflect.ProtoMessage {
+	return (*RecordPacketData)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -572,10 +1084,16 @@ func (x *fastReflection_RecordPacketAck) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RecordPacketAck) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Result != "" {
-		value := protoreflect.ValueOfString(x.Result)
-		if !f(fd_RecordPacketAck_result, value) {
+func (x *fastReflection_RecordPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordData != "" {
+		value := protoreflect.ValueOfString(x.RecordData)
+		if !f(fd_RecordPacketData_record_data, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_RecordPacketData_sender, value) {
 			return
 		}
 	}
@@ -592,15 +1110,17 @@ func (x *fastReflection_RecordPacketAck) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RecordPacketAck) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RecordPacketData) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
-		return x.Result != ""
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		return x.RecordData != ""
+	case "buzzing.checkers.v1.RecordPacketData.sender":
+		return x.Sender != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -610,15 +1130,17 @@ func (x *fastReflection_RecordPacketAck) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketAck) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RecordPacketData) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
-		x.Result = ""
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		x.RecordData = ""
+	case "buzzing.checkers.v1.RecordPacketData.sender":
+		x.Sender = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -628,16 +1150,19 @@ func (x *fastReflection_RecordPacketAck) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RecordPacketAck) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
-		value := x.Result
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		value := x.RecordData
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RecordPacketData.sender":
+		value := x.Sender
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -651,15 +1176,17 @@ func (x *fastReflection_RecordPacketAck) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketAck) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RecordPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
-		x.Result = value.Interface().(string)
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		x.RecordData = value.Interface().(string)
+	case "buzzing.checkers.v1.RecordPacketData.sender":
+		x.Sender = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -673,40 +1200,44 @@ func (x *fastReflection_RecordPacketAck) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketAck) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
-		panic(fmt.Errorf("field result of message buzzing.checkers.v1.RecordPacketAck is not mutable"))
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		panic(fmt.Errorf("field record_data of message buzzing.checkers.v1.RecordPacketData is not mutable"))
+	case "buzzing.checkers.v1.RecordPacketData.sender":
+		panic(fmt.Errorf("field sender of message buzzing.checkers.v1.RecordPacketData is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RecordPacketAck) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "buzzing.checkers.v1.RecordPacketAck.result":
+	case "buzzing.checkers.v1.RecordPacketData.record_data":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RecordPacketData.sender":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketData"))
 		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketData does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RecordPacketAck) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RecordPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordPacketAck", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordPacketData", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -714,7 +1245,7 @@ func (x *fastReflection_RecordPacketAck) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RecordPacketAck) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RecordPacketData) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -725,7 +1256,7 @@ func (x *fastReflection_RecordPacketAck) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordPacketAck) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RecordPacketData) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -737,7 +1268,7 @@ func (x *fastReflection_RecordPacketAck) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RecordPacketAck) IsValid() bool {
+func (x *fastReflection_RecordPacketData) IsValid() bool {
 	return x != nil
 }
 
@@ -747,9 +1278,9 @@ func (x *fastReflection_RecordPacketAck) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RecordPacketData) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RecordPacketAck)
+		x := input.Message.Interface().(*RecordPacketData)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -761,7 +1292,11 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Result)
+		l = len(x.RecordData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -775,7 +1310,7 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RecordPacketAck)
+		x := input.Message.Interface().(*RecordPacketData)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -794,10 +1329,17 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Result) > 0 {
-			i -= len(x.Result)
-			copy(dAtA[i:], x.Result)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordData) > 0 {
+			i -= len(x.RecordData)
+			copy(dAtA[i:], x.RecordData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordData)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -812,7 +1354,7 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RecordPacketAck)
+		x := input.Message.Interface().(*RecordPacketData)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -844,15 +1386,15 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketAck: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketData: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -880,7 +1422,39 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Result = string(dAtA[iNdEx:postIndex])
+				x.RecordData = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -917,170 +1491,4782 @@ func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// packet.proto 文件定义了通过 IBC 模块传输的消息格式
+var (
+	md_RecordPacketAck        protoreflect.MessageDescriptor
+	fd_RecordPacketAck_result protoreflect.FieldDescriptor
+	fd_RecordPacketAck_height protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: buzzing/checkers/v1/packet.proto
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_RecordPacketAck = File_buzzing_checkers_v1_packet_proto.Messages().ByName("RecordPacketAck")
+	fd_RecordPacketAck_result = md_RecordPacketAck.Fields().ByName("result")
+	fd_RecordPacketAck_height = md_RecordPacketAck.Fields().ByName("height")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_RecordPacketAck)(nil)
 
-// RecordPacketData IBC 发送的消息格式
-type RecordPacketData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_RecordPacketAck RecordPacketAck
 
-	RecordData string `protobuf:"bytes,1,opt,name=record_data,json=recordData,proto3" json:"record_data,omitempty"`
-	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+func (x *RecordPacketAck) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordPacketAck)(x)
 }
 
-func (x *RecordPacketData) Reset() {
-	*x = RecordPacketData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[0]
+func (x *RecordPacketAck) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *RecordPacketData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RecordPacketAck_messageType fastReflection_RecordPacketAck_messageType
+var _ protoreflect.MessageType = fastReflection_RecordPacketAck_messageType{}
 
-func (*RecordPacketData) ProtoMessage() {}
+type fastReflection_RecordPacketAck_messageType struct{}
 
-// Deprecated: Use RecordPacketData.ProtoReflect.Descriptor instead.
-func (*RecordPacketData) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_RecordPacketAck_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordPacketAck)(nil)
 }
-
-func (x *RecordPacketData) GetRecordData() string {
-	if x != nil {
-		return x.RecordData
-	}
-	return ""
+func (x fastReflection_RecordPacketAck_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordPacketAck)
 }
-
-func (x *RecordPacketData) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
+func (x fastReflection_RecordPacketAck_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordPacketAck
 }
 
-// RecordPacketAck IBC 消息的确认格式
-type RecordPacketAck struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RecordPacketAck) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordPacketAck
 }
 
-func (x *RecordPacketAck) Reset() {
-	*x = RecordPacketAck{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RecordPacketAck) Type() protoreflect.MessageType {
+	return _fastReflection_RecordPacketAck_messageType
 }
 
-func (x *RecordPacketAck) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RecordPacketAck) New() protoreflect.Message {
+	return new(fastReflection_RecordPacketAck)
 }
 
-func (*RecordPacketAck) ProtoMessage() {}
-
-// Deprecated: Use RecordPacketAck.ProtoReflect.Descriptor instead.
-func (*RecordPacketAck) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RecordPacketAck) Interface() protoreflect.ProtoMessage {
+	return (*RecordPacketAck)(x)
 }
 
-func (x *RecordPacketAck) GetResult() string {
-	if x != nil {
-		return x.Result
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RecordPacketAck) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != "" {
+		value := protoreflect.ValueOfString(x.Result)
+		if !f(fd_RecordPacketAck_result, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_RecordPacketAck_height, value) {
+			return
+		}
 	}
-	return ""
 }
 
-var File_buzzing_checkers_v1_packet_proto protoreflect.FileDescriptor
-
-var file_buzzing_checkers_v1_packet_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
-	0x72, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a,
-	0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0xd4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75,
-	0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76,
-	0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x7a,
-	0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
-	0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x42, 0x43, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x42,
-	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x3a, 0x3a,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RecordPacketAck) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		return x.Result != ""
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_buzzing_checkers_v1_packet_proto_rawDescOnce sync.Once
-	file_buzzing_checkers_v1_packet_proto_rawDescData = file_buzzing_checkers_v1_packet_proto_rawDesc
-)
-
-func file_buzzing_checkers_v1_packet_proto_rawDescGZIP() []byte {
-	file_buzzing_checkers_v1_packet_proto_rawDescOnce.Do(func() {
-		file_buzzing_checkers_v1_packet_proto_rawDescData = protoimpl.X.CompressGZIP(file_buzzing_checkers_v1_packet_proto_rawDescData)
-	})
-	return file_buzzing_checkers_v1_packet_proto_rawDescData
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordPacketAck) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		x.Result = ""
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_buzzing_checkers_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_buzzing_checkers_v1_packet_proto_goTypes = []interface{}{
-	(*RecordPacketData)(nil), // 0: buzzing.checkers.v1.RecordPacketData
-	(*RecordPacketAck)(nil),  // 1: buzzing.checkers.v1.RecordPacketAck
-}
-var file_buzzing_checkers_v1_packet_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RecordPacketAck) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		value := x.Result
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func init() { file_buzzing_checkers_v1_packet_proto_init() }
-func file_buzzing_checkers_v1_packet_proto_init() {
-	if File_buzzing_checkers_v1_packet_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_buzzing_checkers_v1_packet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordPacketData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordPacketAck) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		x.Result = value.Interface().(string)
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordPacketAck) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		panic(fmt.Errorf("field result of message buzzing.checkers.v1.RecordPacketAck is not mutable"))
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		panic(fmt.Errorf("field height of message buzzing.checkers.v1.RecordPacketAck is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RecordPacketAck) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordPacketAck.result":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RecordPacketAck.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordPacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordPacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RecordPacketAck) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordPacketAck", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RecordPacketAck) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordPacketAck) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RecordPacketAck) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RecordPacketAck) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RecordPacketAck)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RecordPacketAck)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RecordPacketAck)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketAck: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GameInvitePacketData                protoreflect.MessageDescriptor
+	fd_GameInvitePacketData_sender_game_id protoreflect.FieldDescriptor
+	fd_GameInvitePacketData_inviter        protoreflect.FieldDescriptor
+	fd_GameInvitePacketData_invitee        protoreflect.FieldDescriptor
+	fd_GameInvitePacketData_inviter_color  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GameInvitePacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GameInvitePacketData")
+	fd_GameInvitePacketData_sender_game_id = md_GameInvitePacketData.Fields().ByName("sender_game_id")
+	fd_GameInvitePacketData_inviter = md_GameInvitePacketData.Fields().ByName("inviter")
+	fd_GameInvitePacketData_invitee = md_GameInvitePacketData.Fields().ByName("invitee")
+	fd_GameInvitePacketData_inviter_color = md_GameInvitePacketData.Fields().ByName("inviter_color")
+}
+
+var _ protoreflect.Message = (*fastReflection_GameInvitePacketData)(nil)
+
+type fastReflection_GameInvitePacketData GameInvitePacketData
+
+func (x *GameInvitePacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GameInvitePacketData)(x)
+}
+
+func (x *GameInvitePacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GameInvitePacketData_messageType fastReflection_GameInvitePacketData_messageType
+var _ protoreflect.MessageType = fastReflection_GameInvitePacketData_messageType{}
+
+type fastReflection_GameInvitePacketData_messageType struct{}
+
+func (x fastReflection_GameInvitePacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GameInvitePacketData)(nil)
+}
+func (x fastReflection_GameInvitePacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_GameInvitePacketData)
+}
+func (x fastReflection_GameInvitePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameInvitePacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GameInvitePacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameInvitePacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GameInvitePacketData) Type() protoreflect.MessageType {
+	return _fastReflection_GameInvitePacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GameInvitePacketData) New() protoreflect.Message {
+	return new(fastReflection_GameInvitePacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GameInvitePacketData) Interface() protoreflect.ProtoMessage {
+	return (*GameInvitePacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GameInvitePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SenderGameId)
+		if !f(fd_GameInvitePacketData_sender_game_id, value) {
+			return
+		}
+	}
+	if x.Inviter != "" {
+		value := protoreflect.ValueOfString(x.Inviter)
+		if !f(fd_GameInvitePacketData_inviter, value) {
+			return
+		}
+	}
+	if x.Invitee != "" {
+		value := protoreflect.ValueOfString(x.Invitee)
+		if !f(fd_GameInvitePacketData_invitee, value) {
+			return
+		}
+	}
+	if x.InviterColor != "" {
+		value := protoreflect.ValueOfString(x.InviterColor)
+		if !f(fd_GameInvitePacketData_inviter_color, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GameInvitePacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		return x.SenderGameId != uint64(0)
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		return x.Inviter != ""
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		return x.Invitee != ""
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		return x.InviterColor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameInvitePacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		x.SenderGameId = uint64(0)
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		x.Inviter = ""
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		x.Invitee = ""
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		x.InviterColor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GameInvitePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		value := x.SenderGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		value := x.Inviter
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		value := x.Invitee
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		value := x.InviterColor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameInvitePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		x.SenderGameId = value.Uint()
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		x.Inviter = value.Interface().(string)
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		x.Invitee = value.Interface().(string)
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		x.InviterColor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameInvitePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		panic(fmt.Errorf("field sender_game_id of message buzzing.checkers.v1.GameInvitePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		panic(fmt.Errorf("field inviter of message buzzing.checkers.v1.GameInvitePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		panic(fmt.Errorf("field invitee of message buzzing.checkers.v1.GameInvitePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		panic(fmt.Errorf("field inviter_color of message buzzing.checkers.v1.GameInvitePacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GameInvitePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameInvitePacketData.sender_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameInvitePacketData.invitee":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameInvitePacketData.inviter_color":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameInvitePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameInvitePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GameInvitePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GameInvitePacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GameInvitePacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameInvitePacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GameInvitePacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GameInvitePacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GameInvitePacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SenderGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SenderGameId))
+		}
+		l = len(x.Inviter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Invitee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InviterColor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GameInvitePacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InviterColor) > 0 {
+			i -= len(x.InviterColor)
+			copy(dAtA[i:], x.InviterColor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InviterColor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Invitee) > 0 {
+			i -= len(x.Invitee)
+			copy(dAtA[i:], x.Invitee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Invitee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Inviter) > 0 {
+			i -= len(x.Inviter)
+			copy(dAtA[i:], x.Inviter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inviter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SenderGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SenderGameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GameInvitePacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameInvitePacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameInvitePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+				}
+				x.SenderGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SenderGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inviter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Inviter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invitee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Invitee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InviterColor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InviterColor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GameAcceptPacketData                  protoreflect.MessageDescriptor
+	fd_GameAcceptPacketData_sender_game_id   protoreflect.FieldDescriptor
+	fd_GameAcceptPacketData_receiver_game_id protoreflect.FieldDescriptor
+	fd_GameAcceptPacketData_player           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GameAcceptPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GameAcceptPacketData")
+	fd_GameAcceptPacketData_sender_game_id = md_GameAcceptPacketData.Fields().ByName("sender_game_id")
+	fd_GameAcceptPacketData_receiver_game_id = md_GameAcceptPacketData.Fields().ByName("receiver_game_id")
+	fd_GameAcceptPacketData_player = md_GameAcceptPacketData.Fields().ByName("player")
+}
+
+var _ protoreflect.Message = (*fastReflection_GameAcceptPacketData)(nil)
+
+type fastReflection_GameAcceptPacketData GameAcceptPacketData
+
+func (x *GameAcceptPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GameAcceptPacketData)(x)
+}
+
+func (x *GameAcceptPacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GameAcceptPacketData_messageType fastReflection_GameAcceptPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_GameAcceptPacketData_messageType{}
+
+type fastReflection_GameAcceptPacketData_messageType struct{}
+
+func (x fastReflection_GameAcceptPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GameAcceptPacketData)(nil)
+}
+func (x fastReflection_GameAcceptPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_GameAcceptPacketData)
+}
+func (x fastReflection_GameAcceptPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameAcceptPacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GameAcceptPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameAcceptPacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GameAcceptPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_GameAcceptPacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GameAcceptPacketData) New() protoreflect.Message {
+	return new(fastReflection_GameAcceptPacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GameAcceptPacketData) Interface() protoreflect.ProtoMessage {
+	return (*GameAcceptPacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GameAcceptPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SenderGameId)
+		if !f(fd_GameAcceptPacketData_sender_game_id, value) {
+			return
+		}
+	}
+	if x.ReceiverGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiverGameId)
+		if !f(fd_GameAcceptPacketData_receiver_game_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_GameAcceptPacketData_player, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GameAcceptPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		return x.SenderGameId != uint64(0)
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		return x.ReceiverGameId != uint64(0)
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		return x.Player != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameAcceptPacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		x.SenderGameId = uint64(0)
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		x.ReceiverGameId = uint64(0)
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		x.Player = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GameAcceptPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		value := x.SenderGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		value := x.ReceiverGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameAcceptPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		x.SenderGameId = value.Uint()
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		x.ReceiverGameId = value.Uint()
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		x.Player = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameAcceptPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		panic(fmt.Errorf("field sender_game_id of message buzzing.checkers.v1.GameAcceptPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		panic(fmt.Errorf("field receiver_game_id of message buzzing.checkers.v1.GameAcceptPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.GameAcceptPacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GameAcceptPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameAcceptPacketData.sender_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameAcceptPacketData.receiver_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameAcceptPacketData.player":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameAcceptPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameAcceptPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GameAcceptPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GameAcceptPacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GameAcceptPacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameAcceptPacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GameAcceptPacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GameAcceptPacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GameAcceptPacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SenderGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SenderGameId))
+		}
+		if x.ReceiverGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiverGameId))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GameAcceptPacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReceiverGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiverGameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SenderGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SenderGameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GameAcceptPacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameAcceptPacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameAcceptPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+				}
+				x.SenderGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SenderGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+				}
+				x.ReceiverGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiverGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GameMovePacketData                  protoreflect.MessageDescriptor
+	fd_GameMovePacketData_sender_game_id   protoreflect.FieldDescriptor
+	fd_GameMovePacketData_receiver_game_id protoreflect.FieldDescriptor
+	fd_GameMovePacketData_player           protoreflect.FieldDescriptor
+	fd_GameMovePacketData_move_count       protoreflect.FieldDescriptor
+	fd_GameMovePacketData_from_x           protoreflect.FieldDescriptor
+	fd_GameMovePacketData_from_y           protoreflect.FieldDescriptor
+	fd_GameMovePacketData_to_x             protoreflect.FieldDescriptor
+	fd_GameMovePacketData_to_y             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GameMovePacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GameMovePacketData")
+	fd_GameMovePacketData_sender_game_id = md_GameMovePacketData.Fields().ByName("sender_game_id")
+	fd_GameMovePacketData_receiver_game_id = md_GameMovePacketData.Fields().ByName("receiver_game_id")
+	fd_GameMovePacketData_player = md_GameMovePacketData.Fields().ByName("player")
+	fd_GameMovePacketData_move_count = md_GameMovePacketData.Fields().ByName("move_count")
+	fd_GameMovePacketData_from_x = md_GameMovePacketData.Fields().ByName("from_x")
+	fd_GameMovePacketData_from_y = md_GameMovePacketData.Fields().ByName("from_y")
+	fd_GameMovePacketData_to_x = md_GameMovePacketData.Fields().ByName("to_x")
+	fd_GameMovePacketData_to_y = md_GameMovePacketData.Fields().ByName("to_y")
+}
+
+var _ protoreflect.Message = (*fastReflection_GameMovePacketData)(nil)
+
+type fastReflection_GameMovePacketData GameMovePacketData
+
+func (x *GameMovePacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GameMovePacketData)(x)
+}
+
+func (x *GameMovePacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GameMovePacketData_messageType fastReflection_GameMovePacketData_messageType
+var _ protoreflect.MessageType = fastReflection_GameMovePacketData_messageType{}
+
+type fastReflection_GameMovePacketData_messageType struct{}
+
+func (x fastReflection_GameMovePacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GameMovePacketData)(nil)
+}
+func (x fastReflection_GameMovePacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_GameMovePacketData)
+}
+func (x fastReflection_GameMovePacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameMovePacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GameMovePacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameMovePacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GameMovePacketData) Type() protoreflect.MessageType {
+	return _fastReflection_GameMovePacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GameMovePacketData) New() protoreflect.Message {
+	return new(fastReflection_GameMovePacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GameMovePacketData) Interface() protoreflect.ProtoMessage {
+	return (*GameMovePacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GameMovePacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SenderGameId)
+		if !f(fd_GameMovePacketData_sender_game_id, value) {
+			return
+		}
+	}
+	if x.ReceiverGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiverGameId)
+		if !f(fd_GameMovePacketData_receiver_game_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_GameMovePacketData_player, value) {
+			return
+		}
+	}
+	if x.MoveCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MoveCount)
+		if !f(fd_GameMovePacketData_move_count, value) {
+			return
+		}
+	}
+	if x.FromX != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromX)
+		if !f(fd_GameMovePacketData_from_x, value) {
+			return
+		}
+	}
+	if x.FromY != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromY)
+		if !f(fd_GameMovePacketData_from_y, value) {
+			return
+		}
+	}
+	if x.ToX != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ToX)
+		if !f(fd_GameMovePacketData_to_x, value) {
+			return
+		}
+	}
+	if x.ToY != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ToY)
+		if !f(fd_GameMovePacketData_to_y, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GameMovePacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		return x.SenderGameId != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		return x.ReceiverGameId != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		return x.MoveCount != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		return x.FromX != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		return x.FromY != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		return x.ToX != uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		return x.ToY != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameMovePacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		x.SenderGameId = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		x.ReceiverGameId = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		x.MoveCount = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		x.FromX = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		x.FromY = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		x.ToX = uint64(0)
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		x.ToY = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GameMovePacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		value := x.SenderGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		value := x.ReceiverGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		value := x.MoveCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		value := x.FromX
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		value := x.FromY
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		value := x.ToX
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		value := x.ToY
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameMovePacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		x.SenderGameId = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		x.ReceiverGameId = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		x.MoveCount = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		x.FromX = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		x.FromY = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		x.ToX = value.Uint()
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		x.ToY = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameMovePacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		panic(fmt.Errorf("field sender_game_id of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		panic(fmt.Errorf("field receiver_game_id of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		panic(fmt.Errorf("field move_count of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		panic(fmt.Errorf("field from_x of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		panic(fmt.Errorf("field from_y of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		panic(fmt.Errorf("field to_x of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		panic(fmt.Errorf("field to_y of message buzzing.checkers.v1.GameMovePacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GameMovePacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameMovePacketData.sender_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.receiver_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameMovePacketData.move_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.from_x":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.from_y":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.to_x":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameMovePacketData.to_y":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameMovePacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameMovePacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GameMovePacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GameMovePacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GameMovePacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameMovePacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GameMovePacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GameMovePacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GameMovePacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SenderGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SenderGameId))
+		}
+		if x.ReceiverGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiverGameId))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MoveCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MoveCount))
+		}
+		if x.FromX != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromX))
+		}
+		if x.FromY != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromY))
+		}
+		if x.ToX != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToX))
+		}
+		if x.ToY != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToY))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GameMovePacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ToY != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToY))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ToX != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToX))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FromY != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromY))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.FromX != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromX))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MoveCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MoveCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReceiverGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiverGameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SenderGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SenderGameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GameMovePacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameMovePacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameMovePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+				}
+				x.SenderGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SenderGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+				}
+				x.ReceiverGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiverGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+				}
+				x.MoveCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MoveCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
+				}
+				x.FromX = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromX |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+				}
+				x.FromY = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromY |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+				}
+				x.ToX = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToX |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
+				}
+				x.ToY = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToY |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GameResignPacketData                  protoreflect.MessageDescriptor
+	fd_GameResignPacketData_sender_game_id   protoreflect.FieldDescriptor
+	fd_GameResignPacketData_receiver_game_id protoreflect.FieldDescriptor
+	fd_GameResignPacketData_player           protoreflect.FieldDescriptor
+	fd_GameResignPacketData_move_count       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GameResignPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GameResignPacketData")
+	fd_GameResignPacketData_sender_game_id = md_GameResignPacketData.Fields().ByName("sender_game_id")
+	fd_GameResignPacketData_receiver_game_id = md_GameResignPacketData.Fields().ByName("receiver_game_id")
+	fd_GameResignPacketData_player = md_GameResignPacketData.Fields().ByName("player")
+	fd_GameResignPacketData_move_count = md_GameResignPacketData.Fields().ByName("move_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_GameResignPacketData)(nil)
+
+type fastReflection_GameResignPacketData GameResignPacketData
+
+func (x *GameResignPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GameResignPacketData)(x)
+}
+
+func (x *GameResignPacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GameResignPacketData_messageType fastReflection_GameResignPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_GameResignPacketData_messageType{}
+
+type fastReflection_GameResignPacketData_messageType struct{}
+
+func (x fastReflection_GameResignPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GameResignPacketData)(nil)
+}
+func (x fastReflection_GameResignPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_GameResignPacketData)
+}
+func (x fastReflection_GameResignPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameResignPacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GameResignPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameResignPacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GameResignPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_GameResignPacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GameResignPacketData) New() protoreflect.Message {
+	return new(fastReflection_GameResignPacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GameResignPacketData) Interface() protoreflect.ProtoMessage {
+	return (*GameResignPacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GameResignPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SenderGameId)
+		if !f(fd_GameResignPacketData_sender_game_id, value) {
+			return
+		}
+	}
+	if x.ReceiverGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiverGameId)
+		if !f(fd_GameResignPacketData_receiver_game_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_GameResignPacketData_player, value) {
+			return
+		}
+	}
+	if x.MoveCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MoveCount)
+		if !f(fd_GameResignPacketData_move_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GameResignPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		return x.SenderGameId != uint64(0)
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		return x.ReceiverGameId != uint64(0)
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		return x.MoveCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResignPacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		x.SenderGameId = uint64(0)
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		x.ReceiverGameId = uint64(0)
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		x.MoveCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GameResignPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		value := x.SenderGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		value := x.ReceiverGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		value := x.MoveCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResignPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		x.SenderGameId = value.Uint()
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		x.ReceiverGameId = value.Uint()
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		x.MoveCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResignPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		panic(fmt.Errorf("field sender_game_id of message buzzing.checkers.v1.GameResignPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		panic(fmt.Errorf("field receiver_game_id of message buzzing.checkers.v1.GameResignPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.GameResignPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		panic(fmt.Errorf("field move_count of message buzzing.checkers.v1.GameResignPacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GameResignPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResignPacketData.sender_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameResignPacketData.receiver_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameResignPacketData.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameResignPacketData.move_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResignPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResignPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GameResignPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GameResignPacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GameResignPacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResignPacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GameResignPacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GameResignPacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GameResignPacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SenderGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SenderGameId))
+		}
+		if x.ReceiverGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiverGameId))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MoveCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MoveCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GameResignPacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MoveCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MoveCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReceiverGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiverGameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SenderGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SenderGameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GameResignPacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameResignPacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameResignPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+				}
+				x.SenderGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SenderGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+				}
+				x.ReceiverGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiverGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+				}
+				x.MoveCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MoveCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GameResultPacketData                  protoreflect.MessageDescriptor
+	fd_GameResultPacketData_sender_game_id   protoreflect.FieldDescriptor
+	fd_GameResultPacketData_receiver_game_id protoreflect.FieldDescriptor
+	fd_GameResultPacketData_player           protoreflect.FieldDescriptor
+	fd_GameResultPacketData_move_count       protoreflect.FieldDescriptor
+	fd_GameResultPacketData_winner           protoreflect.FieldDescriptor
+	fd_GameResultPacketData_reason           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GameResultPacketData = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GameResultPacketData")
+	fd_GameResultPacketData_sender_game_id = md_GameResultPacketData.Fields().ByName("sender_game_id")
+	fd_GameResultPacketData_receiver_game_id = md_GameResultPacketData.Fields().ByName("receiver_game_id")
+	fd_GameResultPacketData_player = md_GameResultPacketData.Fields().ByName("player")
+	fd_GameResultPacketData_move_count = md_GameResultPacketData.Fields().ByName("move_count")
+	fd_GameResultPacketData_winner = md_GameResultPacketData.Fields().ByName("winner")
+	fd_GameResultPacketData_reason = md_GameResultPacketData.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_GameResultPacketData)(nil)
+
+type fastReflection_GameResultPacketData GameResultPacketData
+
+func (x *GameResultPacketData) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GameResultPacketData)(x)
+}
+
+func (x *GameResultPacketData) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GameResultPacketData_messageType fastReflection_GameResultPacketData_messageType
+var _ protoreflect.MessageType = fastReflection_GameResultPacketData_messageType{}
+
+type fastReflection_GameResultPacketData_messageType struct{}
+
+func (x fastReflection_GameResultPacketData_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GameResultPacketData)(nil)
+}
+func (x fastReflection_GameResultPacketData_messageType) New() protoreflect.Message {
+	return new(fastReflection_GameResultPacketData)
+}
+func (x fastReflection_GameResultPacketData_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameResultPacketData
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GameResultPacketData) Descriptor() protoreflect.MessageDescriptor {
+	return md_GameResultPacketData
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GameResultPacketData) Type() protoreflect.MessageType {
+	return _fastReflection_GameResultPacketData_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GameResultPacketData) New() protoreflect.Message {
+	return new(fastReflection_GameResultPacketData)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GameResultPacketData) Interface() protoreflect.ProtoMessage {
+	return (*GameResultPacketData)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GameResultPacketData) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SenderGameId)
+		if !f(fd_GameResultPacketData_sender_game_id, value) {
+			return
+		}
+	}
+	if x.ReceiverGameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiverGameId)
+		if !f(fd_GameResultPacketData_receiver_game_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_GameResultPacketData_player, value) {
+			return
+		}
+	}
+	if x.MoveCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MoveCount)
+		if !f(fd_GameResultPacketData_move_count, value) {
+			return
+		}
+	}
+	if x.Winner != "" {
+		value := protoreflect.ValueOfString(x.Winner)
+		if !f(fd_GameResultPacketData_winner, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_GameResultPacketData_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GameResultPacketData) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		return x.SenderGameId != uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		return x.ReceiverGameId != uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		return x.MoveCount != uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		return x.Winner != ""
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		return x.Reason != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResultPacketData) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		x.SenderGameId = uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		x.ReceiverGameId = uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		x.MoveCount = uint64(0)
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		x.Winner = ""
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		x.Reason = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GameResultPacketData) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		value := x.SenderGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		value := x.ReceiverGameId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		value := x.MoveCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		value := x.Winner
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResultPacketData) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		x.SenderGameId = value.Uint()
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		x.ReceiverGameId = value.Uint()
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		x.MoveCount = value.Uint()
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		x.Winner = value.Interface().(string)
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		x.Reason = (GameEndReason)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResultPacketData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		panic(fmt.Errorf("field sender_game_id of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		panic(fmt.Errorf("field receiver_game_id of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		panic(fmt.Errorf("field move_count of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		panic(fmt.Errorf("field winner of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		panic(fmt.Errorf("field reason of message buzzing.checkers.v1.GameResultPacketData is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GameResultPacketData) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GameResultPacketData.sender_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameResultPacketData.receiver_game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameResultPacketData.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameResultPacketData.move_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GameResultPacketData.winner":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.GameResultPacketData.reason":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GameResultPacketData"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GameResultPacketData does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GameResultPacketData) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GameResultPacketData", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GameResultPacketData) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GameResultPacketData) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GameResultPacketData) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GameResultPacketData) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GameResultPacketData)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SenderGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SenderGameId))
+		}
+		if x.ReceiverGameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiverGameId))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MoveCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MoveCount))
+		}
+		l = len(x.Winner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GameResultPacketData)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Winner) > 0 {
+			i -= len(x.Winner)
+			copy(dAtA[i:], x.Winner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Winner)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MoveCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MoveCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ReceiverGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiverGameId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SenderGameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SenderGameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GameResultPacketData)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameResultPacketData: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GameResultPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+				}
+				x.SenderGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SenderGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+				}
+				x.ReceiverGameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiverGameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+				}
+				x.MoveCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MoveCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Winner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= GameEndReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GamePacketAck         protoreflect.MessageDescriptor
+	fd_GamePacketAck_game_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_packet_proto_init()
+	md_GamePacketAck = File_buzzing_checkers_v1_packet_proto.Messages().ByName("GamePacketAck")
+	fd_GamePacketAck_game_id = md_GamePacketAck.Fields().ByName("game_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_GamePacketAck)(nil)
+
+type fastReflection_GamePacketAck GamePacketAck
+
+func (x *GamePacketAck) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GamePacketAck)(x)
+}
+
+func (x *GamePacketAck) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GamePacketAck_messageType fastReflection_GamePacketAck_messageType
+var _ protoreflect.MessageType = fastReflection_GamePacketAck_messageType{}
+
+type fastReflection_GamePacketAck_messageType struct{}
+
+func (x fastReflection_GamePacketAck_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GamePacketAck)(nil)
+}
+func (x fastReflection_GamePacketAck_messageType) New() protoreflect.Message {
+	return new(fastReflection_GamePacketAck)
+}
+func (x fastReflection_GamePacketAck_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GamePacketAck
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GamePacketAck) Descriptor() protoreflect.MessageDescriptor {
+	return md_GamePacketAck
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GamePacketAck) Type() protoreflect.MessageType {
+	return _fastReflection_GamePacketAck_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GamePacketAck) New() protoreflect.Message {
+	return new(fastReflection_GamePacketAck)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GamePacketAck) Interface() protoreflect.ProtoMessage {
+	return (*GamePacketAck)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GamePacketAck) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GameId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GameId)
+		if !f(fd_GamePacketAck_game_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GamePacketAck) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		return x.GameId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GamePacketAck) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		x.GameId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GamePacketAck) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		value := x.GameId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GamePacketAck) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		x.GameId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GamePacketAck) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		panic(fmt.Errorf("field game_id of message buzzing.checkers.v1.GamePacketAck is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GamePacketAck) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GamePacketAck.game_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GamePacketAck"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GamePacketAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GamePacketAck) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GamePacketAck", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GamePacketAck) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GamePacketAck) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GamePacketAck) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GamePacketAck) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GamePacketAck)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GameId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GameId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GamePacketAck)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GameId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GameId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GamePacketAck)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GamePacketAck: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GamePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				}
+				x.GameId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GameId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// packet.proto 文件定义了通过 IBC 模块传输的消息格式
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: buzzing/checkers/v1/packet.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CheckersPacketData 为模块所有 IBC 数据包的外层结构，通过 oneof 区分数据包的类型
+// 数据包以 ModuleCdc 的 JSON 编码发送，收发两端使用相同的编码
+// 新增的数据包类型（例如跨链游戏）使用新的字段编号，已使用的编号不能修改或复用
+type CheckersPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Packet:
+	//	*CheckersPacketData_RecordPacket
+	//	*CheckersPacketData_GameInvitePacket
+	//	*CheckersPacketData_GameAcceptPacket
+	//	*CheckersPacketData_GameMovePacket
+	//	*CheckersPacketData_GameResignPacket
+	//	*CheckersPacketData_GameResultPacket
+	Packet isCheckersPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (x *CheckersPacketData) Reset() {
+	*x = CheckersPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckersPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckersPacketData) ProtoMessage() {}
+
+// Deprecated: Use CheckersPacketData.ProtoReflect.Descriptor instead.
+func (*CheckersPacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckersPacketData) GetPacket() isCheckersPacketData_Packet {
+	if x != nil {
+		return x.Packet
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetRecordPacket() *RecordPacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_RecordPacket); ok {
+		return x.RecordPacket
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetGameInvitePacket() *GameInvitePacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_GameInvitePacket); ok {
+		return x.GameInvitePacket
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetGameAcceptPacket() *GameAcceptPacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_GameAcceptPacket); ok {
+		return x.GameAcceptPacket
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetGameMovePacket() *GameMovePacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_GameMovePacket); ok {
+		return x.GameMovePacket
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetGameResignPacket() *GameResignPacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_GameResignPacket); ok {
+		return x.GameResignPacket
+	}
+	return nil
+}
+
+func (x *CheckersPacketData) GetGameResultPacket() *GameResultPacketData {
+	if x, ok := x.GetPacket().(*CheckersPacketData_GameResultPacket); ok {
+		return x.GameResultPacket
+	}
+	return nil
+}
+
+type isCheckersPacketData_Packet interface {
+	isCheckersPacketData_Packet()
+}
+
+type CheckersPacketData_RecordPacket struct {
+	RecordPacket *RecordPacketData `protobuf:"bytes,1,opt,name=record_packet,json=recordPacket,proto3,oneof"`
+}
+
+type CheckersPacketData_GameInvitePacket struct {
+	GameInvitePacket *GameInvitePacketData `protobuf:"bytes,2,opt,name=game_invite_packet,json=gameInvitePacket,proto3,oneof"`
+}
+
+type CheckersPacketData_GameAcceptPacket struct {
+	GameAcceptPacket *GameAcceptPacketData `protobuf:"bytes,3,opt,name=game_accept_packet,json=gameAcceptPacket,proto3,oneof"`
+}
+
+type CheckersPacketData_GameMovePacket struct {
+	GameMovePacket *GameMovePacketData `protobuf:"bytes,4,opt,name=game_move_packet,json=gameMovePacket,proto3,oneof"`
+}
+
+type CheckersPacketData_GameResignPacket struct {
+	GameResignPacket *GameResignPacketData `protobuf:"bytes,5,opt,name=game_resign_packet,json=gameResignPacket,proto3,oneof"`
+}
+
+type CheckersPacketData_GameResultPacket struct {
+	GameResultPacket *GameResultPacketData `protobuf:"bytes,6,opt,name=game_result_packet,json=gameResultPacket,proto3,oneof"`
+}
+
+func (*CheckersPacketData_RecordPacket) isCheckersPacketData_Packet() {}
+
+func (*CheckersPacketData_GameInvitePacket) isCheckersPacketData_Packet() {}
+
+func (*CheckersPacketData_GameAcceptPacket) isCheckersPacketData_Packet() {}
+
+func (*CheckersPacketData_GameMovePacket) isCheckersPacketData_Packet() {}
+
+func (*CheckersPacketData_GameResignPacket) isCheckersPacketData_Packet() {}
+
+func (*CheckersPacketData_GameResultPacket) isCheckersPacketData_Packet() {}
+
+// RecordPacketData IBC 发送的消息格式
+type RecordPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordData string `protobuf:"bytes,1,opt,name=record_data,json=recordData,proto3" json:"record_data,omitempty"`
+	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *RecordPacketData) Reset() {
+	*x = RecordPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPacketData) ProtoMessage() {}
+
+// Deprecated: Use RecordPacketData.ProtoReflect.Descriptor instead.
+func (*RecordPacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordPacketData) GetRecordData() string {
+	if x != nil {
+		return x.RecordData
+	}
+	return ""
+}
+
+func (x *RecordPacketData) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// RecordPacketAck IBC 消息的确认格式
+type RecordPacketAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// height 为接收方保存 record 时的区块高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *RecordPacketAck) Reset() {
+	*x = RecordPacketAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordPacketAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPacketAck) ProtoMessage() {}
+
+// Deprecated: Use RecordPacketAck.ProtoReflect.Descriptor instead.
+func (*RecordPacketAck) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordPacketAck) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *RecordPacketAck) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GameInvitePacketData 邀请对方链上的玩家进行一局游戏
+type GameInvitePacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderGameId uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	// inviter 为发送方链上发出邀请的玩家
+	Inviter string `protobuf:"bytes,2,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	// invitee 为接收方链上被邀请的玩家
+	Invitee string `protobuf:"bytes,3,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	// inviter_color 为发出邀请的玩家执的棋子颜色，"b" 或 "r"
+	InviterColor string `protobuf:"bytes,4,opt,name=inviter_color,json=inviterColor,proto3" json:"inviter_color,omitempty"`
+}
+
+func (x *GameInvitePacketData) Reset() {
+	*x = GameInvitePacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInvitePacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInvitePacketData) ProtoMessage() {}
+
+// Deprecated: Use GameInvitePacketData.ProtoReflect.Descriptor instead.
+func (*GameInvitePacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameInvitePacketData) GetSenderGameId() uint64 {
+	if x != nil {
+		return x.SenderGameId
+	}
+	return 0
+}
+
+func (x *GameInvitePacketData) GetInviter() string {
+	if x != nil {
+		return x.Inviter
+	}
+	return ""
+}
+
+func (x *GameInvitePacketData) GetInvitee() string {
+	if x != nil {
+		return x.Invitee
+	}
+	return ""
+}
+
+func (x *GameInvitePacketData) GetInviterColor() string {
+	if x != nil {
+		return x.InviterColor
+	}
+	return ""
+}
+
+// GameAcceptPacketData 接受对方链发来的游戏邀请
+type GameAcceptPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *GameAcceptPacketData) Reset() {
+	*x = GameAcceptPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameAcceptPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAcceptPacketData) ProtoMessage() {}
+
+// Deprecated: Use GameAcceptPacketData.ProtoReflect.Descriptor instead.
+func (*GameAcceptPacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameAcceptPacketData) GetSenderGameId() uint64 {
+	if x != nil {
+		return x.SenderGameId
+	}
+	return 0
+}
+
+func (x *GameAcceptPacketData) GetReceiverGameId() uint64 {
+	if x != nil {
+		return x.ReceiverGameId
+	}
+	return 0
+}
+
+func (x *GameAcceptPacketData) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// GameMovePacketData 在跨链游戏中走一步棋
+type GameMovePacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64 `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	FromX          uint64 `protobuf:"varint,5,opt,name=from_x,json=fromX,proto3" json:"from_x,omitempty"`
+	FromY          uint64 `protobuf:"varint,6,opt,name=from_y,json=fromY,proto3" json:"from_y,omitempty"`
+	ToX            uint64 `protobuf:"varint,7,opt,name=to_x,json=toX,proto3" json:"to_x,omitempty"`
+	ToY            uint64 `protobuf:"varint,8,opt,name=to_y,json=toY,proto3" json:"to_y,omitempty"`
+}
+
+func (x *GameMovePacketData) Reset() {
+	*x = GameMovePacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameMovePacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMovePacketData) ProtoMessage() {}
+
+// Deprecated: Use GameMovePacketData.ProtoReflect.Descriptor instead.
+func (*GameMovePacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GameMovePacketData) GetSenderGameId() uint64 {
+	if x != nil {
+		return x.SenderGameId
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetReceiverGameId() uint64 {
+	if x != nil {
+		return x.ReceiverGameId
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GameMovePacketData) GetMoveCount() uint64 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetFromX() uint64 {
+	if x != nil {
+		return x.FromX
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetFromY() uint64 {
+	if x != nil {
+		return x.FromY
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetToX() uint64 {
+	if x != nil {
+		return x.ToX
+	}
+	return 0
+}
+
+func (x *GameMovePacketData) GetToY() uint64 {
+	if x != nil {
+		return x.ToY
+	}
+	return 0
+}
+
+// GameResignPacketData 在跨链游戏中认输
+type GameResignPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64 `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+}
+
+func (x *GameResignPacketData) Reset() {
+	*x = GameResignPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResignPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResignPacketData) ProtoMessage() {}
+
+// Deprecated: Use GameResignPacketData.ProtoReflect.Descriptor instead.
+func (*GameResignPacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GameResignPacketData) GetSenderGameId() uint64 {
+	if x != nil {
+		return x.SenderGameId
+	}
+	return 0
+}
+
+func (x *GameResignPacketData) GetReceiverGameId() uint64 {
+	if x != nil {
+		return x.ReceiverGameId
+	}
+	return 0
+}
+
+func (x *GameResignPacketData) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GameResignPacketData) GetMoveCount() uint64 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+// GameResultPacketData 通知对方链游戏在发送方链上以 winner 获胜结束
+// 目前只用于对手超过 IbcGameTurnTimeout 没有走棋时，由等待的一方宣布获胜
+type GameResultPacketData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderGameId   uint64        `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64        `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string        `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64        `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	Winner         string        `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	Reason         GameEndReason `protobuf:"varint,6,opt,name=reason,proto3,enum=buzzing.checkers.v1.GameEndReason" json:"reason,omitempty"`
+}
+
+func (x *GameResultPacketData) Reset() {
+	*x = GameResultPacketData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResultPacketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResultPacketData) ProtoMessage() {}
+
+// Deprecated: Use GameResultPacketData.ProtoReflect.Descriptor instead.
+func (*GameResultPacketData) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GameResultPacketData) GetSenderGameId() uint64 {
+	if x != nil {
+		return x.SenderGameId
+	}
+	return 0
+}
+
+func (x *GameResultPacketData) GetReceiverGameId() uint64 {
+	if x != nil {
+		return x.ReceiverGameId
+	}
+	return 0
+}
+
+func (x *GameResultPacketData) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GameResultPacketData) GetMoveCount() uint64 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
+}
+
+func (x *GameResultPacketData) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *GameResultPacketData) GetReason() GameEndReason {
+	if x != nil {
+		return x.Reason
+	}
+	return GameEndReason_GAME_END_REASON_UNSPECIFIED
+}
+
+// GamePacketAck 跨链游戏数据包的确认格式
+type GamePacketAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// game_id 为接收方链上的游戏 id
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GamePacketAck) Reset() {
+	*x = GamePacketAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_packet_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamePacketAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePacketAck) ProtoMessage() {}
+
+// Deprecated: Use GamePacketAck.ProtoReflect.Descriptor instead.
+func (*GamePacketAck) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_packet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GamePacketAck) GetGameId() uint64 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+var File_buzzing_checkers_v1_packet_proto protoreflect.FileDescriptor
+
+var file_buzzing_checkers_v1_packet_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62,
+	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xad, 0x04, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x59, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75,
+	0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x53, 0x0a, 0x10, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x6f, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x0e, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x59, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75,
+	0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x12, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x4b, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x95, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x7e, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x24, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xef, 0x01, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x72, 0x6f, 0x6d, 0x58, 0x12, 0x15, 0x0a, 0x06,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x72,
+	0x6f, 0x6d, 0x59, 0x12, 0x11, 0x0a, 0x04, 0x74, 0x6f, 0x5f, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x74, 0x6f, 0x58, 0x12, 0x11, 0x0a, 0x04, 0x74, 0x6f, 0x5f, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x6f, 0x59, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x14, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x28, 0x0a,
+	0x0d, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x42, 0xd4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x43, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x3a, 0x3a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buzzing_checkers_v1_packet_proto_rawDescOnce sync.Once
+	file_buzzing_checkers_v1_packet_proto_rawDescData = file_buzzing_checkers_v1_packet_proto_rawDesc
+)
+
+func file_buzzing_checkers_v1_packet_proto_rawDescGZIP() []byte {
+	file_buzzing_checkers_v1_packet_proto_rawDescOnce.Do(func() {
+		file_buzzing_checkers_v1_packet_proto_rawDescData = protoimpl.X.CompressGZIP(file_buzzing_checkers_v1_packet_proto_rawDescData)
+	})
+	return file_buzzing_checkers_v1_packet_proto_rawDescData
+}
+
+var file_buzzing_checkers_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_buzzing_checkers_v1_packet_proto_goTypes = []interface{}{
+	(*CheckersPacketData)(nil),   // 0: buzzing.checkers.v1.CheckersPacketData
+	(*RecordPacketData)(nil),     // 1: buzzing.checkers.v1.RecordPacketData
+	(*RecordPacketAck)(nil),      // 2: buzzing.checkers.v1.RecordPacketAck
+	(*GameInvitePacketData)(nil), // 3: buzzing.checkers.v1.GameInvitePacketData
+	(*GameAcceptPacketData)(nil), // 4: buzzing.checkers.v1.GameAcceptPacketData
+	(*GameMovePacketData)(nil),   // 5: buzzing.checkers.v1.GameMovePacketData
+	(*GameResignPacketData)(nil), // 6: buzzing.checkers.v1.GameResignPacketData
+	(*GameResultPacketData)(nil), // 7: buzzing.checkers.v1.GameResultPacketData
+	(*GamePacketAck)(nil),        // 8: buzzing.checkers.v1.GamePacketAck
+	(GameEndReason)(0),           // 9: buzzing.checkers.v1.GameEndReason
+}
+var file_buzzing_checkers_v1_packet_proto_depIdxs = []int32{
+	1, // 0: buzzing.checkers.v1.CheckersPacketData.record_packet:type_name -> buzzing.checkers.v1.RecordPacketData
+	3, // 1: buzzing.checkers.v1.CheckersPacketData.game_invite_packet:type_name -> buzzing.checkers.v1.GameInvitePacketData
+	4, // 2: buzzing.checkers.v1.CheckersPacketData.game_accept_packet:type_name -> buzzing.checkers.v1.GameAcceptPacketData
+	5, // 3: buzzing.checkers.v1.CheckersPacketData.game_move_packet:type_name -> buzzing.checkers.v1.GameMovePacketData
+	6, // 4: buzzing.checkers.v1.CheckersPacketData.game_resign_packet:type_name -> buzzing.checkers.v1.GameResignPacketData
+	7, // 5: buzzing.checkers.v1.CheckersPacketData.game_result_packet:type_name -> buzzing.checkers.v1.GameResultPacketData
+	9, // 6: buzzing.checkers.v1.GameResultPacketData.reason:type_name -> buzzing.checkers.v1.GameEndReason
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_buzzing_checkers_v1_packet_proto_init() }
+func file_buzzing_checkers_v1_packet_proto_init() {
+	if File_buzzing_checkers_v1_packet_proto != nil {
+		return
+	}
+	file_buzzing_checkers_v1_events_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_buzzing_checkers_v1_packet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckersPacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -1088,6 +6274,18 @@ func file_buzzing_checkers_v1_packet_proto_init() {
 			}
 		}
 		file_buzzing_checkers_v1_packet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordPacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecordPacketAck); i {
 			case 0:
 				return &v.state
@@ -1099,6 +6297,86 @@ func file_buzzing_checkers_v1_packet_proto_init() {
 				return nil
 			}
 		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInvitePacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameAcceptPacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMovePacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResignPacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResultPacketData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_packet_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GamePacketAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buzzing_checkers_v1_packet_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*CheckersPacketData_RecordPacket)(nil),
+		(*CheckersPacketData_GameInvitePacket)(nil),
+		(*CheckersPacketData_GameAcceptPacket)(nil),
+		(*CheckersPacketData_GameMovePacket)(nil),
+		(*CheckersPacketData_GameResignPacket)(nil),
+		(*CheckersPacketData_GameResultPacket)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1106,7 +6384,7 @@ func file_buzzing_checkers_v1_packet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_buzzing_checkers_v1_packet_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,17 +21,76 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GetGame_FullMethodName       = "/buzzing.checkers.v1.Query/GetGame"
-	Query_GetRecordList_FullMethodName = "/buzzing.checkers.v1.Query/GetRecordList"
+	Query_Params_FullMethodName              = "/buzzing.checkers.v1.Query/Params"
+	Query_GetGame_FullMethodName             = "/buzzing.checkers.v1.Query/GetGame"
+	Query_ListGames_FullMethodName           = "/buzzing.checkers.v1.Query/ListGames"
+	Query_OpenGames_FullMethodName           = "/buzzing.checkers.v1.Query/OpenGames"
+	Query_GetPlayerInfo_FullMethodName       = "/buzzing.checkers.v1.Query/GetPlayerInfo"
+	Query_GetTournament_FullMethodName       = "/buzzing.checkers.v1.Query/GetTournament"
+	Query_ListTournaments_FullMethodName     = "/buzzing.checkers.v1.Query/ListTournaments"
+	Query_TournamentStandings_FullMethodName = "/buzzing.checkers.v1.Query/TournamentStandings"
+	Query_Invariants_FullMethodName          = "/buzzing.checkers.v1.Query/Invariants"
+	Query_GetRecordList_FullMethodName       = "/buzzing.checkers.v1.Query/GetRecordList"
+	Query_GetRecord_FullMethodName           = "/buzzing.checkers.v1.Query/GetRecord"
+	Query_RecordLogHead_FullMethodName       = "/buzzing.checkers.v1.Query/RecordLogHead"
+	Query_VerifyRecordRange_FullMethodName   = "/buzzing.checkers.v1.Query/VerifyRecordRange"
+	Query_GetRemoteRecords_FullMethodName    = "/buzzing.checkers.v1.Query/GetRemoteRecords"
+	Query_GetOutgoingPacket_FullMethodName   = "/buzzing.checkers.v1.Query/GetOutgoingPacket"
+	Query_GetPacketStatus_FullMethodName     = "/buzzing.checkers.v1.Query/GetPacketStatus"
+	Query_ListOutgoingPackets_FullMethodName = "/buzzing.checkers.v1.Query/ListOutgoingPackets"
+	Query_GetIbcGame_FullMethodName          = "/buzzing.checkers.v1.Query/GetIbcGame"
+	Query_ListIbcGames_FullMethodName        = "/buzzing.checkers.v1.Query/ListIbcGames"
+	Query_Channels_FullMethodName            = "/buzzing.checkers.v1.Query/Channels"
+	Query_GetRecordsByAuthor_FullMethodName  = "/buzzing.checkers.v1.Query/GetRecordsByAuthor"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params 查询模块参数
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// rpc 服务的方法名，参数和返回值
 	GetGame(ctx context.Context, in *QueryGetGameRequest, opts ...grpc.CallOption) (*QueryGetGameResponse, error)
+	// ListGames 分页列出游戏，可按玩家、状态以及是否轮到该玩家走棋进行过滤
+	ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error)
+	// OpenGames 分页列出大厅中等待玩家加入的游戏
+	OpenGames(ctx context.Context, in *QueryOpenGamesRequest, opts ...grpc.CallOption) (*QueryOpenGamesResponse, error)
+	// GetPlayerInfo 查询玩家的对局统计
+	GetPlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error)
+	// GetTournament 查询锦标赛
+	GetTournament(ctx context.Context, in *QueryGetTournamentRequest, opts ...grpc.CallOption) (*QueryGetTournamentResponse, error)
+	// ListTournaments 分页列出锦标赛
+	ListTournaments(ctx context.Context, in *QueryListTournamentsRequest, opts ...grpc.CallOption) (*QueryListTournamentsResponse, error)
+	// TournamentStandings 查询锦标赛的排名，按得分、Sonneborn-Berger、Buchholz 和报名顺序排列
+	TournamentStandings(ctx context.Context, in *QueryTournamentStandingsRequest, opts ...grpc.CallOption) (*QueryTournamentStandingsResponse, error)
+	// Invariants 检查模块的不变量，可以只检查 route 指定的一项
+	// 遍历全部游戏，开销较大，只用于诊断，不应在状态机中调用
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// GetRecordList 按 id 分页列出 record
 	GetRecordList(ctx context.Context, in *QueryGetRecordListRequest, opts ...grpc.CallOption) (*QueryGetRecordListResponse, error)
+	// GetRecord 按 id 查询 record
+	GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error)
+	// RecordLogHead 查询 record 链的头部
+	RecordLogHead(ctx context.Context, in *QueryRecordLogHeadRequest, opts ...grpc.CallOption) (*QueryRecordLogHeadResponse, error)
+	// VerifyRecordRange 验证 id 在 [from, to] 之间的 record 的 hash 和链接
+	VerifyRecordRange(ctx context.Context, in *QueryVerifyRecordRangeRequest, opts ...grpc.CallOption) (*QueryVerifyRecordRangeResponse, error)
+	// GetRemoteRecords 按数据包序号分页列出从一个通道收到的 record
+	GetRemoteRecords(ctx context.Context, in *QueryGetRemoteRecordsRequest, opts ...grpc.CallOption) (*QueryGetRemoteRecordsResponse, error)
+	// GetOutgoingPacket 查询本链发送的数据包
+	GetOutgoingPacket(ctx context.Context, in *QueryGetOutgoingPacketRequest, opts ...grpc.CallOption) (*QueryGetOutgoingPacketResponse, error)
+	// GetPacketStatus 查询本链发送的数据包的状态和对方链返回的确认
+	GetPacketStatus(ctx context.Context, in *QueryGetPacketStatusRequest, opts ...grpc.CallOption) (*QueryGetPacketStatusResponse, error)
+	// ListOutgoingPackets 按序号分页列出从一个通道发送的数据包
+	ListOutgoingPackets(ctx context.Context, in *QueryListOutgoingPacketsRequest, opts ...grpc.CallOption) (*QueryListOutgoingPacketsResponse, error)
+	// GetIbcGame 查询一局跨链游戏
+	GetIbcGame(ctx context.Context, in *QueryGetIbcGameRequest, opts ...grpc.CallOption) (*QueryGetIbcGameResponse, error)
+	// ListIbcGames 按 id 分页列出一个通道上的跨链游戏
+	ListIbcGames(ctx context.Context, in *QueryListIbcGamesRequest, opts ...grpc.CallOption) (*QueryListIbcGamesResponse, error)
+	// Channels 按通道分页列出模块的端口上已经开启的通道，以及跨链功能的默认通道
+	Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error)
+	// GetRecordsByAuthor 按 id 分页列出一个作者的 record
+	GetRecordsByAuthor(ctx context.Context, in *QueryGetRecordsByAuthorRequest, opts ...grpc.CallOption) (*QueryGetRecordsByAuthorResponse, error)
 }
 
 type queryClient struct {
@@ -42,6 +101,15 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetGame(ctx context.Context, in *QueryGetGameRequest, opts ...grpc.CallOption) (*QueryGetGameResponse, error) {
 	out := new(QueryGetGameResponse)
 	err := c.cc.Invoke(ctx, Query_GetGame_FullMethodName, in, out, opts...)
@@ -51,6 +119,69 @@ func (c *queryClient) GetGame(ctx context.Context, in *QueryGetGameRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error) {
+	out := new(QueryListGamesResponse)
+	err := c.cc.Invoke(ctx, Query_ListGames_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenGames(ctx context.Context, in *QueryOpenGamesRequest, opts ...grpc.CallOption) (*QueryOpenGamesResponse, error) {
+	out := new(QueryOpenGamesResponse)
+	err := c.cc.Invoke(ctx, Query_OpenGames_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error) {
+	out := new(QueryGetPlayerInfoResponse)
+	err := c.cc.Invoke(ctx, Query_GetPlayerInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTournament(ctx context.Context, in *QueryGetTournamentRequest, opts ...grpc.CallOption) (*QueryGetTournamentResponse, error) {
+	out := new(QueryGetTournamentResponse)
+	err := c.cc.Invoke(ctx, Query_GetTournament_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTournaments(ctx context.Context, in *QueryListTournamentsRequest, opts ...grpc.CallOption) (*QueryListTournamentsResponse, error) {
+	out := new(QueryListTournamentsResponse)
+	err := c.cc.Invoke(ctx, Query_ListTournaments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TournamentStandings(ctx context.Context, in *QueryTournamentStandingsRequest, opts ...grpc.CallOption) (*QueryTournamentStandingsResponse, error) {
+	out := new(QueryTournamentStandingsResponse)
+	err := c.cc.Invoke(ctx, Query_TournamentStandings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetRecordList(ctx context.Context, in *QueryGetRecordListRequest, opts ...grpc.CallOption) (*QueryGetRecordListResponse, error) {
 	out := new(QueryGetRecordListResponse)
 	err := c.cc.Invoke(ctx, Query_GetRecordList_FullMethodName, in, out, opts...)
@@ -60,13 +191,152 @@ func (c *queryClient) GetRecordList(ctx context.Context, in *QueryGetRecordListR
 	return out, nil
 }
 
+func (c *queryClient) GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error) {
+	out := new(QueryGetRecordResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordLogHead(ctx context.Context, in *QueryRecordLogHeadRequest, opts ...grpc.CallOption) (*QueryRecordLogHeadResponse, error) {
+	out := new(QueryRecordLogHeadResponse)
+	err := c.cc.Invoke(ctx, Query_RecordLogHead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyRecordRange(ctx context.Context, in *QueryVerifyRecordRangeRequest, opts ...grpc.CallOption) (*QueryVerifyRecordRangeResponse, error) {
+	out := new(QueryVerifyRecordRangeResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyRecordRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRemoteRecords(ctx context.Context, in *QueryGetRemoteRecordsRequest, opts ...grpc.CallOption) (*QueryGetRemoteRecordsResponse, error) {
+	out := new(QueryGetRemoteRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_GetRemoteRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOutgoingPacket(ctx context.Context, in *QueryGetOutgoingPacketRequest, opts ...grpc.CallOption) (*QueryGetOutgoingPacketResponse, error) {
+	out := new(QueryGetOutgoingPacketResponse)
+	err := c.cc.Invoke(ctx, Query_GetOutgoingPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPacketStatus(ctx context.Context, in *QueryGetPacketStatusRequest, opts ...grpc.CallOption) (*QueryGetPacketStatusResponse, error) {
+	out := new(QueryGetPacketStatusResponse)
+	err := c.cc.Invoke(ctx, Query_GetPacketStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListOutgoingPackets(ctx context.Context, in *QueryListOutgoingPacketsRequest, opts ...grpc.CallOption) (*QueryListOutgoingPacketsResponse, error) {
+	out := new(QueryListOutgoingPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_ListOutgoingPackets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetIbcGame(ctx context.Context, in *QueryGetIbcGameRequest, opts ...grpc.CallOption) (*QueryGetIbcGameResponse, error) {
+	out := new(QueryGetIbcGameResponse)
+	err := c.cc.Invoke(ctx, Query_GetIbcGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListIbcGames(ctx context.Context, in *QueryListIbcGamesRequest, opts ...grpc.CallOption) (*QueryListIbcGamesResponse, error) {
+	out := new(QueryListIbcGamesResponse)
+	err := c.cc.Invoke(ctx, Query_ListIbcGames_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error) {
+	out := new(QueryChannelsResponse)
+	err := c.cc.Invoke(ctx, Query_Channels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordsByAuthor(ctx context.Context, in *QueryGetRecordsByAuthorRequest, opts ...grpc.CallOption) (*QueryGetRecordsByAuthorResponse, error) {
+	out := new(QueryGetRecordsByAuthorResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordsByAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
+	// Params 查询模块参数
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// rpc 服务的方法名，参数和返回值
 	GetGame(context.Context, *QueryGetGameRequest) (*QueryGetGameResponse, error)
+	// ListGames 分页列出游戏，可按玩家、状态以及是否轮到该玩家走棋进行过滤
+	ListGames(context.Context, *QueryListGamesRequest) (*QueryListGamesResponse, error)
+	// OpenGames 分页列出大厅中等待玩家加入的游戏
+	OpenGames(context.Context, *QueryOpenGamesRequest) (*QueryOpenGamesResponse, error)
+	// GetPlayerInfo 查询玩家的对局统计
+	GetPlayerInfo(context.Context, *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error)
+	// GetTournament 查询锦标赛
+	GetTournament(context.Context, *QueryGetTournamentRequest) (*QueryGetTournamentResponse, error)
+	// ListTournaments 分页列出锦标赛
+	ListTournaments(context.Context, *QueryListTournamentsRequest) (*QueryListTournamentsResponse, error)
+	// TournamentStandings 查询锦标赛的排名，按得分、Sonneborn-Berger、Buchholz 和报名顺序排列
+	TournamentStandings(context.Context, *QueryTournamentStandingsRequest) (*QueryTournamentStandingsResponse, error)
+	// Invariants 检查模块的不变量，可以只检查 route 指定的一项
+	// 遍历全部游戏，开销较大，只用于诊断，不应在状态机中调用
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// GetRecordList 按 id 分页列出 record
 	GetRecordList(context.Context, *QueryGetRecordListRequest) (*QueryGetRecordListResponse, error)
+	// GetRecord 按 id 查询 record
+	GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error)
+	// RecordLogHead 查询 record 链的头部
+	RecordLogHead(context.Context, *QueryRecordLogHeadRequest) (*QueryRecordLogHeadResponse, error)
+	// VerifyRecordRange 验证 id 在 [from, to] 之间的 record 的 hash 和链接
+	VerifyRecordRange(context.Context, *QueryVerifyRecordRangeRequest) (*QueryVerifyRecordRangeResponse, error)
+	// GetRemoteRecords 按数据包序号分页列出从一个通道收到的 record
+	GetRemoteRecords(context.Context, *QueryGetRemoteRecordsRequest) (*QueryGetRemoteRecordsResponse, error)
+	// GetOutgoingPacket 查询本链发送的数据包
+	GetOutgoingPacket(context.Context, *QueryGetOutgoingPacketRequest) (*QueryGetOutgoingPacketResponse, error)
+	// GetPacketStatus 查询本链发送的数据包的状态和对方链返回的确认
+	GetPacketStatus(context.Context, *QueryGetPacketStatusRequest) (*QueryGetPacketStatusResponse, error)
+	// ListOutgoingPackets 按序号分页列出从一个通道发送的数据包
+	ListOutgoingPackets(context.Context, *QueryListOutgoingPacketsRequest) (*QueryListOutgoingPacketsResponse, error)
+	// GetIbcGame 查询一局跨链游戏
+	GetIbcGame(context.Context, *QueryGetIbcGameRequest) (*QueryGetIbcGameResponse, error)
+	// ListIbcGames 按 id 分页列出一个通道上的跨链游戏
+	ListIbcGames(context.Context, *QueryListIbcGamesRequest) (*QueryListIbcGamesResponse, error)
+	// Channels 按通道分页列出模块的端口上已经开启的通道，以及跨链功能的默认通道
+	Channels(context.Context, *QueryChannelsRequest) (*QueryChannelsResponse, error)
+	// GetRecordsByAuthor 按 id 分页列出一个作者的 record
+	GetRecordsByAuthor(context.Context, *QueryGetRecordsByAuthorRequest) (*QueryGetRecordsByAuthorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -74,12 +344,69 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) GetGame(context.Context, *QueryGetGameRequest) (*QueryGetGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
 }
+func (UnimplementedQueryServer) ListGames(context.Context, *QueryListGamesRequest) (*QueryListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedQueryServer) OpenGames(context.Context, *QueryOpenGamesRequest) (*QueryOpenGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenGames not implemented")
+}
+func (UnimplementedQueryServer) GetPlayerInfo(context.Context, *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerInfo not implemented")
+}
+func (UnimplementedQueryServer) GetTournament(context.Context, *QueryGetTournamentRequest) (*QueryGetTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTournament not implemented")
+}
+func (UnimplementedQueryServer) ListTournaments(context.Context, *QueryListTournamentsRequest) (*QueryListTournamentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTournaments not implemented")
+}
+func (UnimplementedQueryServer) TournamentStandings(context.Context, *QueryTournamentStandingsRequest) (*QueryTournamentStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TournamentStandings not implemented")
+}
+func (UnimplementedQueryServer) Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 func (UnimplementedQueryServer) GetRecordList(context.Context, *QueryGetRecordListRequest) (*QueryGetRecordListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecordList not implemented")
 }
+func (UnimplementedQueryServer) GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
+}
+func (UnimplementedQueryServer) RecordLogHead(context.Context, *QueryRecordLogHeadRequest) (*QueryRecordLogHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLogHead not implemented")
+}
+func (UnimplementedQueryServer) VerifyRecordRange(context.Context, *QueryVerifyRecordRangeRequest) (*QueryVerifyRecordRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecordRange not implemented")
+}
+func (UnimplementedQueryServer) GetRemoteRecords(context.Context, *QueryGetRemoteRecordsRequest) (*QueryGetRemoteRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteRecords not implemented")
+}
+func (UnimplementedQueryServer) GetOutgoingPacket(context.Context, *QueryGetOutgoingPacketRequest) (*QueryGetOutgoingPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutgoingPacket not implemented")
+}
+func (UnimplementedQueryServer) GetPacketStatus(context.Context, *QueryGetPacketStatusRequest) (*QueryGetPacketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPacketStatus not implemented")
+}
+func (UnimplementedQueryServer) ListOutgoingPackets(context.Context, *QueryListOutgoingPacketsRequest) (*QueryListOutgoingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingPackets not implemented")
+}
+func (UnimplementedQueryServer) GetIbcGame(context.Context, *QueryGetIbcGameRequest) (*QueryGetIbcGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIbcGame not implemented")
+}
+func (UnimplementedQueryServer) ListIbcGames(context.Context, *QueryListIbcGamesRequest) (*QueryListIbcGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIbcGames not implemented")
+}
+func (UnimplementedQueryServer) Channels(context.Context, *QueryChannelsRequest) (*QueryChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Channels not implemented")
+}
+func (UnimplementedQueryServer) GetRecordsByAuthor(context.Context, *QueryGetRecordsByAuthorRequest) (*QueryGetRecordsByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByAuthor not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +420,24 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetGameRequest)
 	if err := dec(in); err != nil {
@@ -111,6 +456,132 @@ func _Query_GetGame_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGames(ctx, req.(*QueryListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OpenGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenGames(ctx, req.(*QueryOpenGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPlayerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPlayerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPlayerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPlayerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPlayerInfo(ctx, req.(*QueryGetPlayerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTournament_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTournament(ctx, req.(*QueryGetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTournaments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTournamentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTournaments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListTournaments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTournaments(ctx, req.(*QueryListTournamentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TournamentStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTournamentStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TournamentStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TournamentStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TournamentStandings(ctx, req.(*QueryTournamentStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetRecordList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetRecordListRequest)
 	if err := dec(in); err != nil {
@@ -129,6 +600,204 @@ func _Query_GetRecordList_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecord(ctx, req.(*QueryGetRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordLogHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordLogHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordLogHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecordLogHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordLogHead(ctx, req.(*QueryRecordLogHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyRecordRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyRecordRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyRecordRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyRecordRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyRecordRange(ctx, req.(*QueryVerifyRecordRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRemoteRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemoteRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemoteRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRemoteRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemoteRecords(ctx, req.(*QueryGetRemoteRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOutgoingPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutgoingPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOutgoingPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetOutgoingPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOutgoingPacket(ctx, req.(*QueryGetOutgoingPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPacketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPacketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPacketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPacketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPacketStatus(ctx, req.(*QueryGetPacketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListOutgoingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListOutgoingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOutgoingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListOutgoingPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOutgoingPackets(ctx, req.(*QueryListOutgoingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetIbcGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIbcGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetIbcGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetIbcGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIbcGame(ctx, req.(*QueryGetIbcGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListIbcGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListIbcGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListIbcGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListIbcGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListIbcGames(ctx, req.(*QueryListIbcGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Channels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Channels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Channels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Channels(ctx, req.(*QueryChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordsByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordsByAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByAuthor(ctx, req.(*QueryGetRecordsByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,14 +805,90 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "buzzing.checkers.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "GetGame",
 			Handler:    _Query_GetGame_Handler,
 		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Query_ListGames_Handler,
+		},
+		{
+			MethodName: "OpenGames",
+			Handler:    _Query_OpenGames_Handler,
+		},
+		{
+			MethodName: "GetPlayerInfo",
+			Handler:    _Query_GetPlayerInfo_Handler,
+		},
+		{
+			MethodName: "GetTournament",
+			Handler:    _Query_GetTournament_Handler,
+		},
+		{
+			MethodName: "ListTournaments",
+			Handler:    _Query_ListTournaments_Handler,
+		},
+		{
+			MethodName: "TournamentStandings",
+			Handler:    _Query_TournamentStandings_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 		{
 			MethodName: "GetRecordList",
 			Handler:    _Query_GetRecordList_Handler,
 		},
+		{
+			MethodName: "GetRecord",
+			Handler:    _Query_GetRecord_Handler,
+		},
+		{
+			MethodName: "RecordLogHead",
+			Handler:    _Query_RecordLogHead_Handler,
+		},
+		{
+			MethodName: "VerifyRecordRange",
+			Handler:    _Query_VerifyRecordRange_Handler,
+		},
+		{
+			MethodName: "GetRemoteRecords",
+			Handler:    _Query_GetRemoteRecords_Handler,
+		},
+		{
+			MethodName: "GetOutgoingPacket",
+			Handler:    _Query_GetOutgoingPacket_Handler,
+		},
+		{
+			MethodName: "GetPacketStatus",
+			Handler:    _Query_GetPacketStatus_Handler,
+		},
+		{
+			MethodName: "ListOutgoingPackets",
+			Handler:    _Query_ListOutgoingPackets_Handler,
+		},
+		{
+			MethodName: "GetIbcGame",
+			Handler:    _Query_GetIbcGame_Handler,
+		},
+		{
+			MethodName: "ListIbcGames",
+			Handler:    _Query_ListIbcGames_Handler,
+		},
+		{
+			MethodName: "Channels",
+			Handler:    _Query_Channels_Handler,
+		},
+		{
+			MethodName: "GetRecordsByAuthor",
+			Handler:    _Query_GetRecordsByAuthor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "buzzing/checkers/v1/query.proto",
@@ -22,8 +22,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateGame_FullMethodName = "/buzzing.checkers.v1.Msg/CreateGame"
-	Msg_AddRecord_FullMethodName  = "/buzzing.checkers.v1.Msg/AddRecord"
+	Msg_CreateGame_FullMethodName          = "/buzzing.checkers.v1.Msg/CreateGame"
+	Msg_CreateOpenGame_FullMethodName      = "/buzzing.checkers.v1.Msg/CreateOpenGame"
+	Msg_JoinGame_FullMethodName            = "/buzzing.checkers.v1.Msg/JoinGame"
+	Msg_UpdateParams_FullMethodName        = "/buzzing.checkers.v1.Msg/UpdateParams"
+	Msg_JoinQueue_FullMethodName           = "/buzzing.checkers.v1.Msg/JoinQueue"
+	Msg_LeaveQueue_FullMethodName          = "/buzzing.checkers.v1.Msg/LeaveQueue"
+	Msg_CreateTournament_FullMethodName    = "/buzzing.checkers.v1.Msg/CreateTournament"
+	Msg_JoinTournament_FullMethodName      = "/buzzing.checkers.v1.Msg/JoinTournament"
+	Msg_AcceptGame_FullMethodName          = "/buzzing.checkers.v1.Msg/AcceptGame"
+	Msg_PlayMove_FullMethodName            = "/buzzing.checkers.v1.Msg/PlayMove"
+	Msg_AddRecord_FullMethodName           = "/buzzing.checkers.v1.Msg/AddRecord"
+	Msg_SendRecord_FullMethodName          = "/buzzing.checkers.v1.Msg/SendRecord"
+	Msg_RetryPacket_FullMethodName         = "/buzzing.checkers.v1.Msg/RetryPacket"
+	Msg_InviteIbcGame_FullMethodName       = "/buzzing.checkers.v1.Msg/InviteIbcGame"
+	Msg_AcceptIbcGame_FullMethodName       = "/buzzing.checkers.v1.Msg/AcceptIbcGame"
+	Msg_PlayIbcMove_FullMethodName         = "/buzzing.checkers.v1.Msg/PlayIbcMove"
+	Msg_ResignIbcGame_FullMethodName       = "/buzzing.checkers.v1.Msg/ResignIbcGame"
+	Msg_ClaimIbcGameTimeout_FullMethodName = "/buzzing.checkers.v1.Msg/ClaimIbcGameTimeout"
+	Msg_DeleteRecord_FullMethodName        = "/buzzing.checkers.v1.Msg/DeleteRecord"
+	Msg_PruneRecords_FullMethodName        = "/buzzing.checkers.v1.Msg/PruneRecords"
+	Msg_SetDefaultChannel_FullMethodName   = "/buzzing.checkers.v1.Msg/SetDefaultChannel"
+	Msg_Resign_FullMethodName              = "/buzzing.checkers.v1.Msg/Resign"
+	Msg_Reject_FullMethodName              = "/buzzing.checkers.v1.Msg/Reject"
+	Msg_OfferDraw_FullMethodName           = "/buzzing.checkers.v1.Msg/OfferDraw"
+	Msg_AcceptDraw_FullMethodName          = "/buzzing.checkers.v1.Msg/AcceptDraw"
+	Msg_DeclineDraw_FullMethodName         = "/buzzing.checkers.v1.Msg/DeclineDraw"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,7 +56,55 @@ const (
 type MsgClient interface {
 	// rpc 服务的方法名，参数和返回值
 	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
+	// CreateOpenGame 创建一局只有一方玩家的游戏，其他玩家可以通过 JoinGame 加入
+	CreateOpenGame(ctx context.Context, in *MsgCreateOpenGame, opts ...grpc.CallOption) (*MsgCreateOpenGameResponse, error)
+	// JoinGame 加入一局大厅中的游戏，先到先得
+	JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error)
+	// UpdateParams 更新模块参数，只能由 authority（通常为 x/gov 模块账户）发送
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// JoinQueue 进入匹配队列，由 EndBlock 自动匹配对手并创建游戏
+	JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error)
+	// LeaveQueue 离开匹配队列
+	LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error)
+	// CreateTournament 创建锦标赛，官方锦标赛只能由 authority 创建
+	CreateTournament(ctx context.Context, in *MsgCreateTournament, opts ...grpc.CallOption) (*MsgCreateTournamentResponse, error)
+	// JoinTournament 报名参加锦标赛并托管报名费
+	JoinTournament(ctx context.Context, in *MsgJoinTournament, opts ...grpc.CallOption) (*MsgJoinTournamentResponse, error)
+	// AcceptGame 接受游戏邀请，双方都接受后游戏开始
+	AcceptGame(ctx context.Context, in *MsgAcceptGame, opts ...grpc.CallOption) (*MsgAcceptGameResponse, error)
+	// PlayMove 在游戏中走一步棋
+	PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error)
 	AddRecord(ctx context.Context, in *MsgAddRecord, opts ...grpc.CallOption) (*MsgAddRecordResponse, error)
+	// SendRecord 通过 IBC 将一条 record 发送到其他链
+	SendRecord(ctx context.Context, in *MsgSendRecord, opts ...grpc.CallOption) (*MsgSendRecordResponse, error)
+	// RetryPacket 重新发送一个超时的数据包，只能由数据包的发送者发送
+	RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error)
+	// InviteIbcGame 邀请另一条链上的玩家进行一局跨链游戏
+	InviteIbcGame(ctx context.Context, in *MsgInviteIbcGame, opts ...grpc.CallOption) (*MsgInviteIbcGameResponse, error)
+	// AcceptIbcGame 接受另一条链上的玩家发来的跨链游戏邀请
+	AcceptIbcGame(ctx context.Context, in *MsgAcceptIbcGame, opts ...grpc.CallOption) (*MsgAcceptIbcGameResponse, error)
+	// PlayIbcMove 在跨链游戏中走一步棋
+	PlayIbcMove(ctx context.Context, in *MsgPlayIbcMove, opts ...grpc.CallOption) (*MsgPlayIbcMoveResponse, error)
+	// ResignIbcGame 在跨链游戏中认输
+	ResignIbcGame(ctx context.Context, in *MsgResignIbcGame, opts ...grpc.CallOption) (*MsgResignIbcGameResponse, error)
+	// ClaimIbcGameTimeout 在对手超时没有走棋时宣布获胜
+	ClaimIbcGameTimeout(ctx context.Context, in *MsgClaimIbcGameTimeout, opts ...grpc.CallOption) (*MsgClaimIbcGameTimeoutResponse, error)
+	// DeleteRecord 删除一条 record，只能由 record 的作者发送
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// PruneRecords 删除指定高度之前的所有 record，只能由 authority 发送
+	PruneRecords(ctx context.Context, in *MsgPruneRecords, opts ...grpc.CallOption) (*MsgPruneRecordsResponse, error)
+	// SetDefaultChannel 设置跨链功能的默认通道，只能由 authority 发送
+	SetDefaultChannel(ctx context.Context, in *MsgSetDefaultChannel, opts ...grpc.CallOption) (*MsgSetDefaultChannelResponse, error)
+	// Resign 认输，结束一局正在进行的游戏，对手获胜
+	Resign(ctx context.Context, in *MsgResign, opts ...grpc.CallOption) (*MsgResignResponse, error)
+	// Reject 拒绝一局还没有任何一方走棋的游戏，游戏将被删除
+	Reject(ctx context.Context, in *MsgReject, opts ...grpc.CallOption) (*MsgRejectResponse, error)
+	// OfferDraw 向对手提出和棋
+	OfferDraw(ctx context.Context, in *MsgOfferDraw, opts ...grpc.CallOption) (*MsgOfferDrawResponse, error)
+	// AcceptDraw 接受对手的和棋提议，游戏以和棋结束
+	AcceptDraw(ctx context.Context, in *MsgAcceptDraw, opts ...grpc.CallOption) (*MsgAcceptDrawResponse, error)
+	// DeclineDraw 拒绝对手的和棋提议
+	DeclineDraw(ctx context.Context, in *MsgDeclineDraw, opts ...grpc.CallOption) (*MsgDeclineDrawResponse, error)
 }
 
 type msgClient struct {
@@ -52,6 +124,87 @@ func (c *msgClient) CreateGame(ctx context.Context, in *MsgCreateGame, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CreateOpenGame(ctx context.Context, in *MsgCreateOpenGame, opts ...grpc.CallOption) (*MsgCreateOpenGameResponse, error) {
+	out := new(MsgCreateOpenGameResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateOpenGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error) {
+	out := new(MsgJoinGameResponse)
+	err := c.cc.Invoke(ctx, Msg_JoinGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinQueue(ctx context.Context, in *MsgJoinQueue, opts ...grpc.CallOption) (*MsgJoinQueueResponse, error) {
+	out := new(MsgJoinQueueResponse)
+	err := c.cc.Invoke(ctx, Msg_JoinQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveQueue(ctx context.Context, in *MsgLeaveQueue, opts ...grpc.CallOption) (*MsgLeaveQueueResponse, error) {
+	out := new(MsgLeaveQueueResponse)
+	err := c.cc.Invoke(ctx, Msg_LeaveQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTournament(ctx context.Context, in *MsgCreateTournament, opts ...grpc.CallOption) (*MsgCreateTournamentResponse, error) {
+	out := new(MsgCreateTournamentResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateTournament_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinTournament(ctx context.Context, in *MsgJoinTournament, opts ...grpc.CallOption) (*MsgJoinTournamentResponse, error) {
+	out := new(MsgJoinTournamentResponse)
+	err := c.cc.Invoke(ctx, Msg_JoinTournament_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptGame(ctx context.Context, in *MsgAcceptGame, opts ...grpc.CallOption) (*MsgAcceptGameResponse, error) {
+	out := new(MsgAcceptGameResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error) {
+	out := new(MsgPlayMoveResponse)
+	err := c.cc.Invoke(ctx, Msg_PlayMove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddRecord(ctx context.Context, in *MsgAddRecord, opts ...grpc.CallOption) (*MsgAddRecordResponse, error) {
 	out := new(MsgAddRecordResponse)
 	err := c.cc.Invoke(ctx, Msg_AddRecord_FullMethodName, in, out, opts...)
@@ -61,13 +214,196 @@ func (c *msgClient) AddRecord(ctx context.Context, in *MsgAddRecord, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SendRecord(ctx context.Context, in *MsgSendRecord, opts ...grpc.CallOption) (*MsgSendRecordResponse, error) {
+	out := new(MsgSendRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_SendRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryPacket(ctx context.Context, in *MsgRetryPacket, opts ...grpc.CallOption) (*MsgRetryPacketResponse, error) {
+	out := new(MsgRetryPacketResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryPacket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InviteIbcGame(ctx context.Context, in *MsgInviteIbcGame, opts ...grpc.CallOption) (*MsgInviteIbcGameResponse, error) {
+	out := new(MsgInviteIbcGameResponse)
+	err := c.cc.Invoke(ctx, Msg_InviteIbcGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptIbcGame(ctx context.Context, in *MsgAcceptIbcGame, opts ...grpc.CallOption) (*MsgAcceptIbcGameResponse, error) {
+	out := new(MsgAcceptIbcGameResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptIbcGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlayIbcMove(ctx context.Context, in *MsgPlayIbcMove, opts ...grpc.CallOption) (*MsgPlayIbcMoveResponse, error) {
+	out := new(MsgPlayIbcMoveResponse)
+	err := c.cc.Invoke(ctx, Msg_PlayIbcMove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResignIbcGame(ctx context.Context, in *MsgResignIbcGame, opts ...grpc.CallOption) (*MsgResignIbcGameResponse, error) {
+	out := new(MsgResignIbcGameResponse)
+	err := c.cc.Invoke(ctx, Msg_ResignIbcGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimIbcGameTimeout(ctx context.Context, in *MsgClaimIbcGameTimeout, opts ...grpc.CallOption) (*MsgClaimIbcGameTimeoutResponse, error) {
+	out := new(MsgClaimIbcGameTimeoutResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimIbcGameTimeout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneRecords(ctx context.Context, in *MsgPruneRecords, opts ...grpc.CallOption) (*MsgPruneRecordsResponse, error) {
+	out := new(MsgPruneRecordsResponse)
+	err := c.cc.Invoke(ctx, Msg_PruneRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDefaultChannel(ctx context.Context, in *MsgSetDefaultChannel, opts ...grpc.CallOption) (*MsgSetDefaultChannelResponse, error) {
+	out := new(MsgSetDefaultChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDefaultChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Resign(ctx context.Context, in *MsgResign, opts ...grpc.CallOption) (*MsgResignResponse, error) {
+	out := new(MsgResignResponse)
+	err := c.cc.Invoke(ctx, Msg_Resign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Reject(ctx context.Context, in *MsgReject, opts ...grpc.CallOption) (*MsgRejectResponse, error) {
+	out := new(MsgRejectResponse)
+	err := c.cc.Invoke(ctx, Msg_Reject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferDraw(ctx context.Context, in *MsgOfferDraw, opts ...grpc.CallOption) (*MsgOfferDrawResponse, error) {
+	out := new(MsgOfferDrawResponse)
+	err := c.cc.Invoke(ctx, Msg_OfferDraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptDraw(ctx context.Context, in *MsgAcceptDraw, opts ...grpc.CallOption) (*MsgAcceptDrawResponse, error) {
+	out := new(MsgAcceptDrawResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptDraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeclineDraw(ctx context.Context, in *MsgDeclineDraw, opts ...grpc.CallOption) (*MsgDeclineDrawResponse, error) {
+	out := new(MsgDeclineDrawResponse)
+	err := c.cc.Invoke(ctx, Msg_DeclineDraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// rpc 服务的方法名，参数和返回值
 	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
+	// CreateOpenGame 创建一局只有一方玩家的游戏，其他玩家可以通过 JoinGame 加入
+	CreateOpenGame(context.Context, *MsgCreateOpenGame) (*MsgCreateOpenGameResponse, error)
+	// JoinGame 加入一局大厅中的游戏，先到先得
+	JoinGame(context.Context, *MsgJoinGame) (*MsgJoinGameResponse, error)
+	// UpdateParams 更新模块参数，只能由 authority（通常为 x/gov 模块账户）发送
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// JoinQueue 进入匹配队列，由 EndBlock 自动匹配对手并创建游戏
+	JoinQueue(context.Context, *MsgJoinQueue) (*MsgJoinQueueResponse, error)
+	// LeaveQueue 离开匹配队列
+	LeaveQueue(context.Context, *MsgLeaveQueue) (*MsgLeaveQueueResponse, error)
+	// CreateTournament 创建锦标赛，官方锦标赛只能由 authority 创建
+	CreateTournament(context.Context, *MsgCreateTournament) (*MsgCreateTournamentResponse, error)
+	// JoinTournament 报名参加锦标赛并托管报名费
+	JoinTournament(context.Context, *MsgJoinTournament) (*MsgJoinTournamentResponse, error)
+	// AcceptGame 接受游戏邀请，双方都接受后游戏开始
+	AcceptGame(context.Context, *MsgAcceptGame) (*MsgAcceptGameResponse, error)
+	// PlayMove 在游戏中走一步棋
+	PlayMove(context.Context, *MsgPlayMove) (*MsgPlayMoveResponse, error)
 	AddRecord(context.Context, *MsgAddRecord) (*MsgAddRecordResponse, error)
+	// SendRecord 通过 IBC 将一条 record 发送到其他链
+	SendRecord(context.Context, *MsgSendRecord) (*MsgSendRecordResponse, error)
+	// RetryPacket 重新发送一个超时的数据包，只能由数据包的发送者发送
+	RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error)
+	// InviteIbcGame 邀请另一条链上的玩家进行一局跨链游戏
+	InviteIbcGame(context.Context, *MsgInviteIbcGame) (*MsgInviteIbcGameResponse, error)
+	// AcceptIbcGame 接受另一条链上的玩家发来的跨链游戏邀请
+	AcceptIbcGame(context.Context, *MsgAcceptIbcGame) (*MsgAcceptIbcGameResponse, error)
+	// PlayIbcMove 在跨链游戏中走一步棋
+	PlayIbcMove(context.Context, *MsgPlayIbcMove) (*MsgPlayIbcMoveResponse, error)
+	// ResignIbcGame 在跨链游戏中认输
+	ResignIbcGame(context.Context, *MsgResignIbcGame) (*MsgResignIbcGameResponse, error)
+	// ClaimIbcGameTimeout 在对手超时没有走棋时宣布获胜
+	ClaimIbcGameTimeout(context.Context, *MsgClaimIbcGameTimeout) (*MsgClaimIbcGameTimeoutResponse, error)
+	// DeleteRecord 删除一条 record，只能由 record 的作者发送
+	DeleteRecord(context.Context, *MsgDeleteRecord) (*MsgDeleteRecordResponse, error)
+	// PruneRecords 删除指定高度之前的所有 record，只能由 authority 发送
+	PruneRecords(context.Context, *MsgPruneRecords) (*MsgPruneRecordsResponse, error)
+	// SetDefaultChannel 设置跨链功能的默认通道，只能由 authority 发送
+	SetDefaultChannel(context.Context, *MsgSetDefaultChannel) (*MsgSetDefaultChannelResponse, error)
+	// Resign 认输，结束一局正在进行的游戏，对手获胜
+	Resign(context.Context, *MsgResign) (*MsgResignResponse, error)
+	// Reject 拒绝一局还没有任何一方走棋的游戏，游戏将被删除
+	Reject(context.Context, *MsgReject) (*MsgRejectResponse, error)
+	// OfferDraw 向对手提出和棋
+	OfferDraw(context.Context, *MsgOfferDraw) (*MsgOfferDrawResponse, error)
+	// AcceptDraw 接受对手的和棋提议，游戏以和棋结束
+	AcceptDraw(context.Context, *MsgAcceptDraw) (*MsgAcceptDrawResponse, error)
+	// DeclineDraw 拒绝对手的和棋提议
+	DeclineDraw(context.Context, *MsgDeclineDraw) (*MsgDeclineDrawResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -78,9 +414,81 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
 }
+func (UnimplementedMsgServer) CreateOpenGame(context.Context, *MsgCreateOpenGame) (*MsgCreateOpenGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOpenGame not implemented")
+}
+func (UnimplementedMsgServer) JoinGame(context.Context, *MsgJoinGame) (*MsgJoinGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) JoinQueue(context.Context, *MsgJoinQueue) (*MsgJoinQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedMsgServer) LeaveQueue(context.Context, *MsgLeaveQueue) (*MsgLeaveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedMsgServer) CreateTournament(context.Context, *MsgCreateTournament) (*MsgCreateTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (UnimplementedMsgServer) JoinTournament(context.Context, *MsgJoinTournament) (*MsgJoinTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTournament not implemented")
+}
+func (UnimplementedMsgServer) AcceptGame(context.Context, *MsgAcceptGame) (*MsgAcceptGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGame not implemented")
+}
+func (UnimplementedMsgServer) PlayMove(context.Context, *MsgPlayMove) (*MsgPlayMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayMove not implemented")
+}
 func (UnimplementedMsgServer) AddRecord(context.Context, *MsgAddRecord) (*MsgAddRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRecord not implemented")
 }
+func (UnimplementedMsgServer) SendRecord(context.Context, *MsgSendRecord) (*MsgSendRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRecord not implemented")
+}
+func (UnimplementedMsgServer) RetryPacket(context.Context, *MsgRetryPacket) (*MsgRetryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPacket not implemented")
+}
+func (UnimplementedMsgServer) InviteIbcGame(context.Context, *MsgInviteIbcGame) (*MsgInviteIbcGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteIbcGame not implemented")
+}
+func (UnimplementedMsgServer) AcceptIbcGame(context.Context, *MsgAcceptIbcGame) (*MsgAcceptIbcGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptIbcGame not implemented")
+}
+func (UnimplementedMsgServer) PlayIbcMove(context.Context, *MsgPlayIbcMove) (*MsgPlayIbcMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayIbcMove not implemented")
+}
+func (UnimplementedMsgServer) ResignIbcGame(context.Context, *MsgResignIbcGame) (*MsgResignIbcGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResignIbcGame not implemented")
+}
+func (UnimplementedMsgServer) ClaimIbcGameTimeout(context.Context, *MsgClaimIbcGameTimeout) (*MsgClaimIbcGameTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimIbcGameTimeout not implemented")
+}
+func (UnimplementedMsgServer) DeleteRecord(context.Context, *MsgDeleteRecord) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (UnimplementedMsgServer) PruneRecords(context.Context, *MsgPruneRecords) (*MsgPruneRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneRecords not implemented")
+}
+func (UnimplementedMsgServer) SetDefaultChannel(context.Context, *MsgSetDefaultChannel) (*MsgSetDefaultChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultChannel not implemented")
+}
+func (UnimplementedMsgServer) Resign(context.Context, *MsgResign) (*MsgResignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resign not implemented")
+}
+func (UnimplementedMsgServer) Reject(context.Context, *MsgReject) (*MsgRejectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
+func (UnimplementedMsgServer) OfferDraw(context.Context, *MsgOfferDraw) (*MsgOfferDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferDraw not implemented")
+}
+func (UnimplementedMsgServer) AcceptDraw(context.Context, *MsgAcceptDraw) (*MsgAcceptDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDraw not implemented")
+}
+func (UnimplementedMsgServer) DeclineDraw(context.Context, *MsgDeclineDraw) (*MsgDeclineDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineDraw not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -112,38 +520,566 @@ func _Msg_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRecord)
+func _Msg_CreateOpenGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOpenGame)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddRecord(ctx, in)
+		return srv.(MsgServer).CreateOpenGame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_AddRecord_FullMethodName,
+		FullMethod: Msg_CreateOpenGame_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddRecord(ctx, req.(*MsgAddRecord))
+		return srv.(MsgServer).CreateOpenGame(ctx, req.(*MsgCreateOpenGame))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "buzzing.checkers.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGame",
-			Handler:    _Msg_CreateGame_Handler,
-		},
-		{
-			MethodName: "AddRecord",
-			Handler:    _Msg_AddRecord_Handler,
+func _Msg_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_JoinGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinGame(ctx, req.(*MsgJoinGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinQueue(ctx, req.(*MsgJoinQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveQueue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LeaveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveQueue(ctx, req.(*MsgLeaveQueue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateTournament_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTournament(ctx, req.(*MsgCreateTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_JoinTournament_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTournament(ctx, req.(*MsgJoinTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptGame(ctx, req.(*MsgAcceptGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlayMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlayMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlayMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlayMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlayMove(ctx, req.(*MsgPlayMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRecord(ctx, req.(*MsgAddRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendRecord(ctx, req.(*MsgSendRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryPacket(ctx, req.(*MsgRetryPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InviteIbcGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInviteIbcGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InviteIbcGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InviteIbcGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InviteIbcGame(ctx, req.(*MsgInviteIbcGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptIbcGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptIbcGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptIbcGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptIbcGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptIbcGame(ctx, req.(*MsgAcceptIbcGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlayIbcMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlayIbcMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlayIbcMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PlayIbcMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlayIbcMove(ctx, req.(*MsgPlayIbcMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResignIbcGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResignIbcGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResignIbcGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResignIbcGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResignIbcGame(ctx, req.(*MsgResignIbcGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimIbcGameTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimIbcGameTimeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimIbcGameTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimIbcGameTimeout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimIbcGameTimeout(ctx, req.(*MsgClaimIbcGameTimeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PruneRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneRecords(ctx, req.(*MsgPruneRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDefaultChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDefaultChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDefaultChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDefaultChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDefaultChannel(ctx, req.(*MsgSetDefaultChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Resign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Resign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Resign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Resign(ctx, req.(*MsgResign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Reject(ctx, req.(*MsgReject))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferDraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OfferDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferDraw(ctx, req.(*MsgOfferDraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDraw(ctx, req.(*MsgAcceptDraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeclineDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclineDraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclineDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeclineDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclineDraw(ctx, req.(*MsgDeclineDraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "buzzing.checkers.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _Msg_CreateGame_Handler,
+		},
+		{
+			MethodName: "CreateOpenGame",
+			Handler:    _Msg_CreateOpenGame_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _Msg_JoinGame_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "JoinQueue",
+			Handler:    _Msg_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _Msg_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _Msg_CreateTournament_Handler,
+		},
+		{
+			MethodName: "JoinTournament",
+			Handler:    _Msg_JoinTournament_Handler,
+		},
+		{
+			MethodName: "AcceptGame",
+			Handler:    _Msg_AcceptGame_Handler,
+		},
+		{
+			MethodName: "PlayMove",
+			Handler:    _Msg_PlayMove_Handler,
+		},
+		{
+			MethodName: "AddRecord",
+			Handler:    _Msg_AddRecord_Handler,
+		},
+		{
+			MethodName: "SendRecord",
+			Handler:    _Msg_SendRecord_Handler,
+		},
+		{
+			MethodName: "RetryPacket",
+			Handler:    _Msg_RetryPacket_Handler,
+		},
+		{
+			MethodName: "InviteIbcGame",
+			Handler:    _Msg_InviteIbcGame_Handler,
+		},
+		{
+			MethodName: "AcceptIbcGame",
+			Handler:    _Msg_AcceptIbcGame_Handler,
+		},
+		{
+			MethodName: "PlayIbcMove",
+			Handler:    _Msg_PlayIbcMove_Handler,
+		},
+		{
+			MethodName: "ResignIbcGame",
+			Handler:    _Msg_ResignIbcGame_Handler,
+		},
+		{
+			MethodName: "ClaimIbcGameTimeout",
+			Handler:    _Msg_ClaimIbcGameTimeout_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "PruneRecords",
+			Handler:    _Msg_PruneRecords_Handler,
+		},
+		{
+			MethodName: "SetDefaultChannel",
+			Handler:    _Msg_SetDefaultChannel_Handler,
+		},
+		{
+			MethodName: "Resign",
+			Handler:    _Msg_Resign_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _Msg_Reject_Handler,
+		},
+		{
+			MethodName: "OfferDraw",
+			Handler:    _Msg_OfferDraw_Handler,
+		},
+		{
+			MethodName: "AcceptDraw",
+			Handler:    _Msg_AcceptDraw_Handler,
+		},
+		{
+			MethodName: "DeclineDraw",
+			Handler:    _Msg_DeclineDraw_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2,6 +2,7 @@
 package checkersv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -9,18 +10,143 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]string
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedWagerDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]string
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field RecordDenylist as it is not of Message kind"))
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_invitation_expiry_blocks  protoreflect.FieldDescriptor
+	fd_Params_matchmaking_rating_window protoreflect.FieldDescriptor
+	fd_Params_matchmaking_window_growth protoreflect.FieldDescriptor
+	fd_Params_max_turn_duration         protoreflect.FieldDescriptor
+	fd_Params_max_active_games          protoreflect.FieldDescriptor
+	fd_Params_min_wager                 protoreflect.FieldDescriptor
+	fd_Params_allowed_wager_denoms      protoreflect.FieldDescriptor
+	fd_Params_record_max_length         protoreflect.FieldDescriptor
+	fd_Params_record_retention          protoreflect.FieldDescriptor
+	fd_Params_record_min_length         protoreflect.FieldDescriptor
+	fd_Params_record_denylist           protoreflect.FieldDescriptor
+	fd_Params_record_rate_limit         protoreflect.FieldDescriptor
+	fd_Params_record_rate_window        protoreflect.FieldDescriptor
+	fd_Params_record_fee                protoreflect.FieldDescriptor
+	fd_Params_record_fee_destination    protoreflect.FieldDescriptor
+	fd_Params_max_queue_size            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_buzzing_checkers_v1_types_proto_init()
 	md_Params = File_buzzing_checkers_v1_types_proto.Messages().ByName("Params")
+	fd_Params_invitation_expiry_blocks = md_Params.Fields().ByName("invitation_expiry_blocks")
+	fd_Params_matchmaking_rating_window = md_Params.Fields().ByName("matchmaking_rating_window")
+	fd_Params_matchmaking_window_growth = md_Params.Fields().ByName("matchmaking_window_growth")
+	fd_Params_max_turn_duration = md_Params.Fields().ByName("max_turn_duration")
+	fd_Params_max_active_games = md_Params.Fields().ByName("max_active_games")
+	fd_Params_min_wager = md_Params.Fields().ByName("min_wager")
+	fd_Params_allowed_wager_denoms = md_Params.Fields().ByName("allowed_wager_denoms")
+	fd_Params_record_max_length = md_Params.Fields().ByName("record_max_length")
+	fd_Params_record_retention = md_Params.Fields().ByName("record_retention")
+	fd_Params_record_min_length = md_Params.Fields().ByName("record_min_length")
+	fd_Params_record_denylist = md_Params.Fields().ByName("record_denylist")
+	fd_Params_record_rate_limit = md_Params.Fields().ByName("record_rate_limit")
+	fd_Params_record_rate_window = md_Params.Fields().ByName("record_rate_window")
+	fd_Params_record_fee = md_Params.Fields().ByName("record_fee")
+	fd_Params_record_fee_destination = md_Params.Fields().ByName("record_fee_destination")
+	fd_Params_max_queue_size = md_Params.Fields().ByName("max_queue_size")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +214,102 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InvitationExpiryBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InvitationExpiryBlocks)
+		if !f(fd_Params_invitation_expiry_blocks, value) {
+			return
+		}
+	}
+	if x.MatchmakingRatingWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MatchmakingRatingWindow)
+		if !f(fd_Params_matchmaking_rating_window, value) {
+			return
+		}
+	}
+	if x.MatchmakingWindowGrowth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MatchmakingWindowGrowth)
+		if !f(fd_Params_matchmaking_window_growth, value) {
+			return
+		}
+	}
+	if x.MaxTurnDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTurnDuration)
+		if !f(fd_Params_max_turn_duration, value) {
+			return
+		}
+	}
+	if x.MaxActiveGames != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActiveGames)
+		if !f(fd_Params_max_active_games, value) {
+			return
+		}
+	}
+	if x.MinWager != "" {
+		value := protoreflect.ValueOfString(x.MinWager)
+		if !f(fd_Params_min_wager, value) {
+			return
+		}
+	}
+	if len(x.AllowedWagerDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.AllowedWagerDenoms})
+		if !f(fd_Params_allowed_wager_denoms, value) {
+			return
+		}
+	}
+	if x.RecordMaxLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordMaxLength)
+		if !f(fd_Params_record_max_length, value) {
+			return
+		}
+	}
+	if x.RecordRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordRetention)
+		if !f(fd_Params_record_retention, value) {
+			return
+		}
+	}
+	if x.RecordMinLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordMinLength)
+		if !f(fd_Params_record_min_length, value) {
+			return
+		}
+	}
+	if len(x.RecordDenylist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.RecordDenylist})
+		if !f(fd_Params_record_denylist, value) {
+			return
+		}
+	}
+	if x.RecordRateLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordRateLimit)
+		if !f(fd_Params_record_rate_limit, value) {
+			return
+		}
+	}
+	if x.RecordRateWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordRateWindow)
+		if !f(fd_Params_record_rate_window, value) {
+			return
+		}
+	}
+	if x.RecordFee != nil {
+		value := protoreflect.ValueOfMessage(x.RecordFee.ProtoReflect())
+		if !f(fd_Params_record_fee, value) {
+			return
+		}
+	}
+	if x.RecordFeeDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RecordFeeDestination))
+		if !f(fd_Params_record_fee_destination, value) {
+			return
+		}
+	}
+	if x.MaxQueueSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxQueueSize)
+		if !f(fd_Params_max_queue_size, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +325,38 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		return x.InvitationExpiryBlocks != uint64(0)
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		return x.MatchmakingRatingWindow != uint64(0)
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		return x.MatchmakingWindowGrowth != uint64(0)
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		return x.MaxTurnDuration != uint64(0)
+	case "buzzing.checkers.v1.Params.max_active_games":
+		return x.MaxActiveGames != uint64(0)
+	case "buzzing.checkers.v1.Params.min_wager":
+		return x.MinWager != ""
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		return len(x.AllowedWagerDenoms) != 0
+	case "buzzing.checkers.v1.Params.record_max_length":
+		return x.RecordMaxLength != uint64(0)
+	case "buzzing.checkers.v1.Params.record_retention":
+		return x.RecordRetention != uint64(0)
+	case "buzzing.checkers.v1.Params.record_min_length":
+		return x.RecordMinLength != uint64(0)
+	case "buzzing.checkers.v1.Params.record_denylist":
+		return len(x.RecordDenylist) != 0
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		return x.RecordRateLimit != uint64(0)
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		return x.RecordRateWindow != uint64(0)
+	case "buzzing.checkers.v1.Params.record_fee":
+		return x.RecordFee != nil
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		return x.RecordFeeDestination != 0
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		return x.MaxQueueSize != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -119,6 +373,38 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		x.InvitationExpiryBlocks = uint64(0)
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		x.MatchmakingRatingWindow = uint64(0)
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		x.MatchmakingWindowGrowth = uint64(0)
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		x.MaxTurnDuration = uint64(0)
+	case "buzzing.checkers.v1.Params.max_active_games":
+		x.MaxActiveGames = uint64(0)
+	case "buzzing.checkers.v1.Params.min_wager":
+		x.MinWager = ""
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		x.AllowedWagerDenoms = nil
+	case "buzzing.checkers.v1.Params.record_max_length":
+		x.RecordMaxLength = uint64(0)
+	case "buzzing.checkers.v1.Params.record_retention":
+		x.RecordRetention = uint64(0)
+	case "buzzing.checkers.v1.Params.record_min_length":
+		x.RecordMinLength = uint64(0)
+	case "buzzing.checkers.v1.Params.record_denylist":
+		x.RecordDenylist = nil
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		x.RecordRateLimit = uint64(0)
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		x.RecordRateWindow = uint64(0)
+	case "buzzing.checkers.v1.Params.record_fee":
+		x.RecordFee = nil
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		x.RecordFeeDestination = 0
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		x.MaxQueueSize = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -135,6 +421,60 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		value := x.InvitationExpiryBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		value := x.MatchmakingRatingWindow
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		value := x.MatchmakingWindowGrowth
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		value := x.MaxTurnDuration
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.max_active_games":
+		value := x.MaxActiveGames
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.min_wager":
+		value := x.MinWager
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		if len(x.AllowedWagerDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.AllowedWagerDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.Params.record_max_length":
+		value := x.RecordMaxLength
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.record_retention":
+		value := x.RecordRetention
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.record_min_length":
+		value := x.RecordMinLength
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.record_denylist":
+		if len(x.RecordDenylist) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.RecordDenylist}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		value := x.RecordRateLimit
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		value := x.RecordRateWindow
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Params.record_fee":
+		value := x.RecordFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		value := x.RecordFeeDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		value := x.MaxQueueSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -155,6 +495,42 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		x.InvitationExpiryBlocks = value.Uint()
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		x.MatchmakingRatingWindow = value.Uint()
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		x.MatchmakingWindowGrowth = value.Uint()
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		x.MaxTurnDuration = value.Uint()
+	case "buzzing.checkers.v1.Params.max_active_games":
+		x.MaxActiveGames = value.Uint()
+	case "buzzing.checkers.v1.Params.min_wager":
+		x.MinWager = value.Interface().(string)
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.AllowedWagerDenoms = *clv.list
+	case "buzzing.checkers.v1.Params.record_max_length":
+		x.RecordMaxLength = value.Uint()
+	case "buzzing.checkers.v1.Params.record_retention":
+		x.RecordRetention = value.Uint()
+	case "buzzing.checkers.v1.Params.record_min_length":
+		x.RecordMinLength = value.Uint()
+	case "buzzing.checkers.v1.Params.record_denylist":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.RecordDenylist = *clv.list
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		x.RecordRateLimit = value.Uint()
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		x.RecordRateWindow = value.Uint()
+	case "buzzing.checkers.v1.Params.record_fee":
+		x.RecordFee = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		x.RecordFeeDestination = (RecordFeeDestination)(value.Enum())
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		x.MaxQueueSize = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -175,6 +551,49 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		if x.AllowedWagerDenoms == nil {
+			x.AllowedWagerDenoms = []string{}
+		}
+		value := &_Params_7_list{list: &x.AllowedWagerDenoms}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Params.record_denylist":
+		if x.RecordDenylist == nil {
+			x.RecordDenylist = []string{}
+		}
+		value := &_Params_11_list{list: &x.RecordDenylist}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Params.record_fee":
+		if x.RecordFee == nil {
+			x.RecordFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RecordFee.ProtoReflect())
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		panic(fmt.Errorf("field invitation_expiry_blocks of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		panic(fmt.Errorf("field matchmaking_rating_window of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		panic(fmt.Errorf("field matchmaking_window_growth of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		panic(fmt.Errorf("field max_turn_duration of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.max_active_games":
+		panic(fmt.Errorf("field max_active_games of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.min_wager":
+		panic(fmt.Errorf("field min_wager of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_max_length":
+		panic(fmt.Errorf("field record_max_length of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_retention":
+		panic(fmt.Errorf("field record_retention of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_min_length":
+		panic(fmt.Errorf("field record_min_length of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		panic(fmt.Errorf("field record_rate_limit of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		panic(fmt.Errorf("field record_rate_window of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		panic(fmt.Errorf("field record_fee_destination of message buzzing.checkers.v1.Params is not mutable"))
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		panic(fmt.Errorf("field max_queue_size of message buzzing.checkers.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -188,6 +607,41 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "buzzing.checkers.v1.Params.invitation_expiry_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.matchmaking_rating_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.matchmaking_window_growth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.max_turn_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.max_active_games":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.min_wager":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.Params.allowed_wager_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "buzzing.checkers.v1.Params.record_max_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.record_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.record_min_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.record_denylist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "buzzing.checkers.v1.Params.record_rate_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.record_rate_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Params.record_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.Params.record_fee_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.Params.max_queue_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Params"))
@@ -257,6 +711,62 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.InvitationExpiryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.InvitationExpiryBlocks))
+		}
+		if x.MatchmakingRatingWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.MatchmakingRatingWindow))
+		}
+		if x.MatchmakingWindowGrowth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MatchmakingWindowGrowth))
+		}
+		if x.MaxTurnDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTurnDuration))
+		}
+		if x.MaxActiveGames != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActiveGames))
+		}
+		l = len(x.MinWager)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedWagerDenoms) > 0 {
+			for _, s := range x.AllowedWagerDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RecordMaxLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordMaxLength))
+		}
+		if x.RecordRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordRetention))
+		}
+		if x.RecordMinLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordMinLength))
+		}
+		if len(x.RecordDenylist) > 0 {
+			for _, s := range x.RecordDenylist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RecordRateLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordRateLimit))
+		}
+		if x.RecordRateWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordRateWindow))
+		}
+		if x.RecordFee != nil {
+			l = options.Size(x.RecordFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecordFeeDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordFeeDestination))
+		}
+		if x.MaxQueueSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxQueueSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +796,107 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxQueueSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxQueueSize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.RecordFeeDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordFeeDestination))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.RecordFee != nil {
+			encoded, err := options.Marshal(x.RecordFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.RecordRateWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordRateWindow))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.RecordRateLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordRateLimit))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.RecordDenylist) > 0 {
+			for iNdEx := len(x.RecordDenylist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RecordDenylist[iNdEx])
+				copy(dAtA[i:], x.RecordDenylist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordDenylist[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.RecordMinLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordMinLength))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.RecordRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordRetention))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RecordMaxLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordMaxLength))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.AllowedWagerDenoms) > 0 {
+			for iNdEx := len(x.AllowedWagerDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedWagerDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedWagerDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedWagerDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.MinWager) > 0 {
+			i -= len(x.MinWager)
+			copy(dAtA[i:], x.MinWager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinWager)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxActiveGames != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActiveGames))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxTurnDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTurnDuration))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MatchmakingWindowGrowth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MatchmakingWindowGrowth))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MatchmakingRatingWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MatchmakingRatingWindow))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.InvitationExpiryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvitationExpiryBlocks))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,2048 +946,17308 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvitationExpiryBlocks", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.InvitationExpiryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvitationExpiryBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchmakingRatingWindow", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MatchmakingRatingWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MatchmakingRatingWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchmakingWindowGrowth", wireType)
+				}
+				x.MatchmakingWindowGrowth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MatchmakingWindowGrowth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+				}
+				x.MaxTurnDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTurnDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGames", wireType)
+				}
+				x.MaxActiveGames = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActiveGames |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinWager", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinWager = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedWagerDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedWagerDenoms = append(x.AllowedWagerDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordMaxLength", wireType)
+				}
+				x.RecordMaxLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordMaxLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordRetention", wireType)
+				}
+				x.RecordRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordMinLength", wireType)
+				}
+				x.RecordMinLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordMinLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordDenylist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordDenylist = append(x.RecordDenylist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordRateLimit", wireType)
+				}
+				x.RecordRateLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordRateLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordRateWindow", wireType)
+				}
+				x.RecordRateWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordRateWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecordFee == nil {
+					x.RecordFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecordFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordFeeDestination", wireType)
+				}
+				x.RecordFeeDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordFeeDestination |= RecordFeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+				}
+				x.MaxQueueSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxQueueSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*IndexedStoredGame
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexedStoredGame)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexedStoredGame)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(IndexedStoredGame)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(IndexedStoredGame)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field RecordList as it is not of Message kind"))
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*IndexedPlayerInfo
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexedPlayerInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IndexedPlayerInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(IndexedPlayerInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(IndexedPlayerInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*QueueEntry
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueueEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueueEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(QueueEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(QueueEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*Tournament
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tournament)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tournament)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Tournament)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(Tournament)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*TournamentPlayer
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TournamentPlayer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TournamentPlayer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(TournamentPlayer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(TournamentPlayer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*Record
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Record)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Record)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(Record)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(Record)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*RecordRateCounter
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RecordRateCounter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RecordRateCounter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(RecordRateCounter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(RecordRateCounter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*RemoteRecord
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RemoteRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(RemoteRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(RemoteRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*OutgoingPacket
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutgoingPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutgoingPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(OutgoingPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(OutgoingPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*IbcGame
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IbcGame)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IbcGame)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(IbcGame)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(IbcGame)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*IbcChannel
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IbcChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IbcChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(IbcChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(IbcChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                       protoreflect.MessageDescriptor
+	fd_GenesisState_params                protoreflect.FieldDescriptor
+	fd_GenesisState_indexedStoredGameList protoreflect.FieldDescriptor
+	fd_GenesisState_recordList            protoreflect.FieldDescriptor
+	fd_GenesisState_indexedPlayerInfoList protoreflect.FieldDescriptor
+	fd_GenesisState_queueEntryList        protoreflect.FieldDescriptor
+	fd_GenesisState_tournamentList        protoreflect.FieldDescriptor
+	fd_GenesisState_tournamentPlayerList  protoreflect.FieldDescriptor
+	fd_GenesisState_tournamentCount       protoreflect.FieldDescriptor
+	fd_GenesisState_records               protoreflect.FieldDescriptor
+	fd_GenesisState_recordCount           protoreflect.FieldDescriptor
+	fd_GenesisState_recordRateCounters    protoreflect.FieldDescriptor
+	fd_GenesisState_recordLogHead         protoreflect.FieldDescriptor
+	fd_GenesisState_remoteRecordList      protoreflect.FieldDescriptor
+	fd_GenesisState_outgoingPacketList    protoreflect.FieldDescriptor
+	fd_GenesisState_ibcGameList           protoreflect.FieldDescriptor
+	fd_GenesisState_ibcGameCount          protoreflect.FieldDescriptor
+	fd_GenesisState_ibcChannelList        protoreflect.FieldDescriptor
+	fd_GenesisState_defaultChannel        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_GenesisState = File_buzzing_checkers_v1_types_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_indexedStoredGameList = md_GenesisState.Fields().ByName("indexedStoredGameList")
+	fd_GenesisState_recordList = md_GenesisState.Fields().ByName("recordList")
+	fd_GenesisState_indexedPlayerInfoList = md_GenesisState.Fields().ByName("indexedPlayerInfoList")
+	fd_GenesisState_queueEntryList = md_GenesisState.Fields().ByName("queueEntryList")
+	fd_GenesisState_tournamentList = md_GenesisState.Fields().ByName("tournamentList")
+	fd_GenesisState_tournamentPlayerList = md_GenesisState.Fields().ByName("tournamentPlayerList")
+	fd_GenesisState_tournamentCount = md_GenesisState.Fields().ByName("tournamentCount")
+	fd_GenesisState_records = md_GenesisState.Fields().ByName("records")
+	fd_GenesisState_recordCount = md_GenesisState.Fields().ByName("recordCount")
+	fd_GenesisState_recordRateCounters = md_GenesisState.Fields().ByName("recordRateCounters")
+	fd_GenesisState_recordLogHead = md_GenesisState.Fields().ByName("recordLogHead")
+	fd_GenesisState_remoteRecordList = md_GenesisState.Fields().ByName("remoteRecordList")
+	fd_GenesisState_outgoingPacketList = md_GenesisState.Fields().ByName("outgoingPacketList")
+	fd_GenesisState_ibcGameList = md_GenesisState.Fields().ByName("ibcGameList")
+	fd_GenesisState_ibcGameCount = md_GenesisState.Fields().ByName("ibcGameCount")
+	fd_GenesisState_ibcChannelList = md_GenesisState.Fields().ByName("ibcChannelList")
+	fd_GenesisState_defaultChannel = md_GenesisState.Fields().ByName("defaultChannel")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.IndexedStoredGameList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.IndexedStoredGameList})
+		if !f(fd_GenesisState_indexedStoredGameList, value) {
+			return
+		}
+	}
+	if len(x.RecordList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.RecordList})
+		if !f(fd_GenesisState_recordList, value) {
+			return
+		}
+	}
+	if len(x.IndexedPlayerInfoList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.IndexedPlayerInfoList})
+		if !f(fd_GenesisState_indexedPlayerInfoList, value) {
+			return
+		}
+	}
+	if len(x.QueueEntryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.QueueEntryList})
+		if !f(fd_GenesisState_queueEntryList, value) {
+			return
+		}
+	}
+	if len(x.TournamentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.TournamentList})
+		if !f(fd_GenesisState_tournamentList, value) {
+			return
+		}
+	}
+	if len(x.TournamentPlayerList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TournamentPlayerList})
+		if !f(fd_GenesisState_tournamentPlayerList, value) {
+			return
+		}
+	}
+	if x.TournamentCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TournamentCount)
+		if !f(fd_GenesisState_tournamentCount, value) {
+			return
+		}
+	}
+	if len(x.Records) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Records})
+		if !f(fd_GenesisState_records, value) {
+			return
+		}
+	}
+	if x.RecordCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RecordCount)
+		if !f(fd_GenesisState_recordCount, value) {
+			return
+		}
+	}
+	if len(x.RecordRateCounters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.RecordRateCounters})
+		if !f(fd_GenesisState_recordRateCounters, value) {
+			return
+		}
+	}
+	if x.RecordLogHead != nil {
+		value := protoreflect.ValueOfMessage(x.RecordLogHead.ProtoReflect())
+		if !f(fd_GenesisState_recordLogHead, value) {
+			return
+		}
+	}
+	if len(x.RemoteRecordList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.RemoteRecordList})
+		if !f(fd_GenesisState_remoteRecordList, value) {
+			return
+		}
+	}
+	if len(x.OutgoingPacketList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.OutgoingPacketList})
+		if !f(fd_GenesisState_outgoingPacketList, value) {
+			return
+		}
+	}
+	if len(x.IbcGameList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.IbcGameList})
+		if !f(fd_GenesisState_ibcGameList, value) {
+			return
+		}
+	}
+	if x.IbcGameCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IbcGameCount)
+		if !f(fd_GenesisState_ibcGameCount, value) {
+			return
+		}
+	}
+	if len(x.IbcChannelList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.IbcChannelList})
+		if !f(fd_GenesisState_ibcChannelList, value) {
+			return
+		}
+	}
+	if x.DefaultChannel != "" {
+		value := protoreflect.ValueOfString(x.DefaultChannel)
+		if !f(fd_GenesisState_defaultChannel, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		return x.Params != nil
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		return len(x.IndexedStoredGameList) != 0
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		return len(x.RecordList) != 0
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		return len(x.IndexedPlayerInfoList) != 0
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		return len(x.QueueEntryList) != 0
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		return len(x.TournamentList) != 0
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		return len(x.TournamentPlayerList) != 0
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		return x.TournamentCount != uint64(0)
+	case "buzzing.checkers.v1.GenesisState.records":
+		return len(x.Records) != 0
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		return x.RecordCount != uint64(0)
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		return len(x.RecordRateCounters) != 0
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		return x.RecordLogHead != nil
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		return len(x.RemoteRecordList) != 0
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		return len(x.OutgoingPacketList) != 0
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		return len(x.IbcGameList) != 0
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		return x.IbcGameCount != uint64(0)
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		return len(x.IbcChannelList) != 0
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		return x.DefaultChannel != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		x.Params = nil
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		x.IndexedStoredGameList = nil
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		x.RecordList = nil
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		x.IndexedPlayerInfoList = nil
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		x.QueueEntryList = nil
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		x.TournamentList = nil
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		x.TournamentPlayerList = nil
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		x.TournamentCount = uint64(0)
+	case "buzzing.checkers.v1.GenesisState.records":
+		x.Records = nil
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		x.RecordCount = uint64(0)
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		x.RecordRateCounters = nil
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		x.RecordLogHead = nil
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		x.RemoteRecordList = nil
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		x.OutgoingPacketList = nil
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		x.IbcGameList = nil
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		x.IbcGameCount = uint64(0)
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		x.IbcChannelList = nil
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		x.DefaultChannel = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		if len(x.IndexedStoredGameList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.IndexedStoredGameList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		if len(x.RecordList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.RecordList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		if len(x.IndexedPlayerInfoList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.IndexedPlayerInfoList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		if len(x.QueueEntryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.QueueEntryList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		if len(x.TournamentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.TournamentList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		if len(x.TournamentPlayerList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TournamentPlayerList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		value := x.TournamentCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GenesisState.records":
+		if len(x.Records) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Records}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		value := x.RecordCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		if len(x.RecordRateCounters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.RecordRateCounters}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		value := x.RecordLogHead
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		if len(x.RemoteRecordList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.RemoteRecordList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		if len(x.OutgoingPacketList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.OutgoingPacketList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		if len(x.IbcGameList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.IbcGameList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		value := x.IbcGameCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		if len(x.IbcChannelList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.IbcChannelList}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		value := x.DefaultChannel
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.IndexedStoredGameList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.RecordList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.IndexedPlayerInfoList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.QueueEntryList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.TournamentList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TournamentPlayerList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		x.TournamentCount = value.Uint()
+	case "buzzing.checkers.v1.GenesisState.records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Records = *clv.list
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		x.RecordCount = value.Uint()
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.RecordRateCounters = *clv.list
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		x.RecordLogHead = value.Message().Interface().(*RecordLogHead)
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.RemoteRecordList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.OutgoingPacketList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.IbcGameList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		x.IbcGameCount = value.Uint()
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.IbcChannelList = *clv.list
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		x.DefaultChannel = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		if x.IndexedStoredGameList == nil {
+			x.IndexedStoredGameList = []*IndexedStoredGame{}
+		}
+		value := &_GenesisState_2_list{list: &x.IndexedStoredGameList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		if x.RecordList == nil {
+			x.RecordList = []string{}
+		}
+		value := &_GenesisState_3_list{list: &x.RecordList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		if x.IndexedPlayerInfoList == nil {
+			x.IndexedPlayerInfoList = []*IndexedPlayerInfo{}
+		}
+		value := &_GenesisState_4_list{list: &x.IndexedPlayerInfoList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		if x.QueueEntryList == nil {
+			x.QueueEntryList = []*QueueEntry{}
+		}
+		value := &_GenesisState_5_list{list: &x.QueueEntryList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		if x.TournamentList == nil {
+			x.TournamentList = []*Tournament{}
+		}
+		value := &_GenesisState_6_list{list: &x.TournamentList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		if x.TournamentPlayerList == nil {
+			x.TournamentPlayerList = []*TournamentPlayer{}
+		}
+		value := &_GenesisState_7_list{list: &x.TournamentPlayerList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.records":
+		if x.Records == nil {
+			x.Records = []*Record{}
+		}
+		value := &_GenesisState_9_list{list: &x.Records}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		if x.RecordRateCounters == nil {
+			x.RecordRateCounters = []*RecordRateCounter{}
+		}
+		value := &_GenesisState_11_list{list: &x.RecordRateCounters}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		if x.RecordLogHead == nil {
+			x.RecordLogHead = new(RecordLogHead)
+		}
+		return protoreflect.ValueOfMessage(x.RecordLogHead.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		if x.RemoteRecordList == nil {
+			x.RemoteRecordList = []*RemoteRecord{}
+		}
+		value := &_GenesisState_13_list{list: &x.RemoteRecordList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		if x.OutgoingPacketList == nil {
+			x.OutgoingPacketList = []*OutgoingPacket{}
+		}
+		value := &_GenesisState_14_list{list: &x.OutgoingPacketList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		if x.IbcGameList == nil {
+			x.IbcGameList = []*IbcGame{}
+		}
+		value := &_GenesisState_15_list{list: &x.IbcGameList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		if x.IbcChannelList == nil {
+			x.IbcChannelList = []*IbcChannel{}
+		}
+		value := &_GenesisState_17_list{list: &x.IbcChannelList}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		panic(fmt.Errorf("field tournamentCount of message buzzing.checkers.v1.GenesisState is not mutable"))
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		panic(fmt.Errorf("field recordCount of message buzzing.checkers.v1.GenesisState is not mutable"))
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		panic(fmt.Errorf("field ibcGameCount of message buzzing.checkers.v1.GenesisState is not mutable"))
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		panic(fmt.Errorf("field defaultChannel of message buzzing.checkers.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
+		list := []*IndexedStoredGame{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.recordList":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.indexedPlayerInfoList":
+		list := []*IndexedPlayerInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.queueEntryList":
+		list := []*QueueEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.tournamentList":
+		list := []*Tournament{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.tournamentPlayerList":
+		list := []*TournamentPlayer{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.tournamentCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GenesisState.records":
+		list := []*Record{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.recordCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GenesisState.recordRateCounters":
+		list := []*RecordRateCounter{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.recordLogHead":
+		m := new(RecordLogHead)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.GenesisState.remoteRecordList":
+		list := []*RemoteRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.outgoingPacketList":
+		list := []*OutgoingPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.ibcGameList":
+		list := []*IbcGame{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.ibcGameCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.GenesisState.ibcChannelList":
+		list := []*IbcChannel{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "buzzing.checkers.v1.GenesisState.defaultChannel":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.IndexedStoredGameList) > 0 {
+			for _, e := range x.IndexedStoredGameList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RecordList) > 0 {
+			for _, s := range x.RecordList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IndexedPlayerInfoList) > 0 {
+			for _, e := range x.IndexedPlayerInfoList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueueEntryList) > 0 {
+			for _, e := range x.QueueEntryList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TournamentList) > 0 {
+			for _, e := range x.TournamentList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TournamentPlayerList) > 0 {
+			for _, e := range x.TournamentPlayerList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TournamentCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TournamentCount))
+		}
+		if len(x.Records) > 0 {
+			for _, e := range x.Records {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RecordCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecordCount))
+		}
+		if len(x.RecordRateCounters) > 0 {
+			for _, e := range x.RecordRateCounters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RecordLogHead != nil {
+			l = options.Size(x.RecordLogHead)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RemoteRecordList) > 0 {
+			for _, e := range x.RemoteRecordList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OutgoingPacketList) > 0 {
+			for _, e := range x.OutgoingPacketList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IbcGameList) > 0 {
+			for _, e := range x.IbcGameList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.IbcGameCount != 0 {
+			n += 2 + runtime.Sov(uint64(x.IbcGameCount))
+		}
+		if len(x.IbcChannelList) > 0 {
+			for _, e := range x.IbcChannelList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.DefaultChannel)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DefaultChannel) > 0 {
+			i -= len(x.DefaultChannel)
+			copy(dAtA[i:], x.DefaultChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DefaultChannel)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.IbcChannelList) > 0 {
+			for iNdEx := len(x.IbcChannelList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IbcChannelList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.IbcGameCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IbcGameCount))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.IbcGameList) > 0 {
+			for iNdEx := len(x.IbcGameList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IbcGameList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.OutgoingPacketList) > 0 {
+			for iNdEx := len(x.OutgoingPacketList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OutgoingPacketList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.RemoteRecordList) > 0 {
+			for iNdEx := len(x.RemoteRecordList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RemoteRecordList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.RecordLogHead != nil {
+			encoded, err := options.Marshal(x.RecordLogHead)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.RecordRateCounters) > 0 {
+			for iNdEx := len(x.RecordRateCounters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RecordRateCounters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.RecordCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecordCount))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Records) > 0 {
+			for iNdEx := len(x.Records) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Records[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.TournamentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TournamentCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.TournamentPlayerList) > 0 {
+			for iNdEx := len(x.TournamentPlayerList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TournamentPlayerList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TournamentList) > 0 {
+			for iNdEx := len(x.TournamentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TournamentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.QueueEntryList) > 0 {
+			for iNdEx := len(x.QueueEntryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueueEntryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.IndexedPlayerInfoList) > 0 {
+			for iNdEx := len(x.IndexedPlayerInfoList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IndexedPlayerInfoList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RecordList) > 0 {
+			for iNdEx := len(x.RecordList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RecordList[iNdEx])
+				copy(dAtA[i:], x.RecordList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordList[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.IndexedStoredGameList) > 0 {
+			for iNdEx := len(x.IndexedStoredGameList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IndexedStoredGameList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexedStoredGameList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IndexedStoredGameList = append(x.IndexedStoredGameList, &IndexedStoredGame{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndexedStoredGameList[len(x.IndexedStoredGameList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordList", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordList = append(x.RecordList, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexedPlayerInfoList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IndexedPlayerInfoList = append(x.IndexedPlayerInfoList, &IndexedPlayerInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndexedPlayerInfoList[len(x.IndexedPlayerInfoList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueueEntryList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueueEntryList = append(x.QueueEntryList, &QueueEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueueEntryList[len(x.QueueEntryList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TournamentList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TournamentList = append(x.TournamentList, &Tournament{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TournamentList[len(x.TournamentList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TournamentPlayerList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TournamentPlayerList = append(x.TournamentPlayerList, &TournamentPlayer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TournamentPlayerList[len(x.TournamentPlayerList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TournamentCount", wireType)
+				}
+				x.TournamentCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TournamentCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Records = append(x.Records, &Record{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Records[len(x.Records)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+				}
+				x.RecordCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecordCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordRateCounters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordRateCounters = append(x.RecordRateCounters, &RecordRateCounter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecordRateCounters[len(x.RecordRateCounters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordLogHead", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecordLogHead == nil {
+					x.RecordLogHead = &RecordLogHead{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecordLogHead); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteRecordList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemoteRecordList = append(x.RemoteRecordList, &RemoteRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemoteRecordList[len(x.RemoteRecordList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutgoingPacketList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutgoingPacketList = append(x.OutgoingPacketList, &OutgoingPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutgoingPacketList[len(x.OutgoingPacketList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcGameList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcGameList = append(x.IbcGameList, &IbcGame{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcGameList[len(x.IbcGameList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcGameCount", wireType)
+				}
+				x.IbcGameCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IbcGameCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcChannelList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcChannelList = append(x.IbcChannelList, &IbcChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcChannelList[len(x.IbcChannelList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DefaultChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StoredGame                     protoreflect.MessageDescriptor
+	fd_StoredGame_board               protoreflect.FieldDescriptor
+	fd_StoredGame_turn                protoreflect.FieldDescriptor
+	fd_StoredGame_black               protoreflect.FieldDescriptor
+	fd_StoredGame_red                 protoreflect.FieldDescriptor
+	fd_StoredGame_status              protoreflect.FieldDescriptor
+	fd_StoredGame_winner              protoreflect.FieldDescriptor
+	fd_StoredGame_move_count          protoreflect.FieldDescriptor
+	fd_StoredGame_draw_offer          protoreflect.FieldDescriptor
+	fd_StoredGame_black_accepted      protoreflect.FieldDescriptor
+	fd_StoredGame_red_accepted        protoreflect.FieldDescriptor
+	fd_StoredGame_invitation_deadline protoreflect.FieldDescriptor
+	fd_StoredGame_wager               protoreflect.FieldDescriptor
+	fd_StoredGame_min_rating          protoreflect.FieldDescriptor
+	fd_StoredGame_turn_duration       protoreflect.FieldDescriptor
+	fd_StoredGame_deadline            protoreflect.FieldDescriptor
+	fd_StoredGame_tournament_id       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_StoredGame = File_buzzing_checkers_v1_types_proto.Messages().ByName("StoredGame")
+	fd_StoredGame_board = md_StoredGame.Fields().ByName("board")
+	fd_StoredGame_turn = md_StoredGame.Fields().ByName("turn")
+	fd_StoredGame_black = md_StoredGame.Fields().ByName("black")
+	fd_StoredGame_red = md_StoredGame.Fields().ByName("red")
+	fd_StoredGame_status = md_StoredGame.Fields().ByName("status")
+	fd_StoredGame_winner = md_StoredGame.Fields().ByName("winner")
+	fd_StoredGame_move_count = md_StoredGame.Fields().ByName("move_count")
+	fd_StoredGame_draw_offer = md_StoredGame.Fields().ByName("draw_offer")
+	fd_StoredGame_black_accepted = md_StoredGame.Fields().ByName("black_accepted")
+	fd_StoredGame_red_accepted = md_StoredGame.Fields().ByName("red_accepted")
+	fd_StoredGame_invitation_deadline = md_StoredGame.Fields().ByName("invitation_deadline")
+	fd_StoredGame_wager = md_StoredGame.Fields().ByName("wager")
+	fd_StoredGame_min_rating = md_StoredGame.Fields().ByName("min_rating")
+	fd_StoredGame_turn_duration = md_StoredGame.Fields().ByName("turn_duration")
+	fd_StoredGame_deadline = md_StoredGame.Fields().ByName("deadline")
+	fd_StoredGame_tournament_id = md_StoredGame.Fields().ByName("tournament_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoredGame)(nil)
+
+type fastReflection_StoredGame StoredGame
+
+func (x *StoredGame) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoredGame)(x)
+}
+
+func (x *StoredGame) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoredGame_messageType fastReflection_StoredGame_messageType
+var _ protoreflect.MessageType = fastReflection_StoredGame_messageType{}
+
+type fastReflection_StoredGame_messageType struct{}
+
+func (x fastReflection_StoredGame_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoredGame)(nil)
+}
+func (x fastReflection_StoredGame_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoredGame)
+}
+func (x fastReflection_StoredGame_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoredGame
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoredGame) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoredGame
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoredGame) Type() protoreflect.MessageType {
+	return _fastReflection_StoredGame_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoredGame) New() protoreflect.Message {
+	return new(fastReflection_StoredGame)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoredGame) Interface() protoreflect.ProtoMessage {
+	return (*StoredGame)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoredGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Board != "" {
+		value := protoreflect.ValueOfString(x.Board)
+		if !f(fd_StoredGame_board, value) {
+			return
+		}
+	}
+	if x.Turn != "" {
+		value := protoreflect.ValueOfString(x.Turn)
+		if !f(fd_StoredGame_turn, value) {
+			return
+		}
+	}
+	if x.Black != "" {
+		value := protoreflect.ValueOfString(x.Black)
+		if !f(fd_StoredGame_black, value) {
+			return
+		}
+	}
+	if x.Red != "" {
+		value := protoreflect.ValueOfString(x.Red)
+		if !f(fd_StoredGame_red, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_StoredGame_status, value) {
+			return
+		}
+	}
+	if x.Winner != "" {
+		value := protoreflect.ValueOfString(x.Winner)
+		if !f(fd_StoredGame_winner, value) {
+			return
+		}
+	}
+	if x.MoveCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MoveCount)
+		if !f(fd_StoredGame_move_count, value) {
+			return
+		}
+	}
+	if x.DrawOffer != "" {
+		value := protoreflect.ValueOfString(x.DrawOffer)
+		if !f(fd_StoredGame_draw_offer, value) {
+			return
+		}
+	}
+	if x.BlackAccepted != false {
+		value := protoreflect.ValueOfBool(x.BlackAccepted)
+		if !f(fd_StoredGame_black_accepted, value) {
+			return
+		}
+	}
+	if x.RedAccepted != false {
+		value := protoreflect.ValueOfBool(x.RedAccepted)
+		if !f(fd_StoredGame_red_accepted, value) {
+			return
+		}
+	}
+	if x.InvitationDeadline != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InvitationDeadline)
+		if !f(fd_StoredGame_invitation_deadline, value) {
+			return
+		}
+	}
+	if x.Wager != nil {
+		value := protoreflect.ValueOfMessage(x.Wager.ProtoReflect())
+		if !f(fd_StoredGame_wager, value) {
+			return
+		}
+	}
+	if x.MinRating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinRating)
+		if !f(fd_StoredGame_min_rating, value) {
+			return
+		}
+	}
+	if x.TurnDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TurnDuration)
+		if !f(fd_StoredGame_turn_duration, value) {
+			return
+		}
+	}
+	if x.Deadline != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Deadline)
+		if !f(fd_StoredGame_deadline, value) {
+			return
+		}
+	}
+	if x.TournamentId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TournamentId)
+		if !f(fd_StoredGame_tournament_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoredGame) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.StoredGame.board":
+		return x.Board != ""
+	case "buzzing.checkers.v1.StoredGame.turn":
+		return x.Turn != ""
+	case "buzzing.checkers.v1.StoredGame.black":
+		return x.Black != ""
+	case "buzzing.checkers.v1.StoredGame.red":
+		return x.Red != ""
+	case "buzzing.checkers.v1.StoredGame.status":
+		return x.Status != 0
+	case "buzzing.checkers.v1.StoredGame.winner":
+		return x.Winner != ""
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		return x.MoveCount != uint64(0)
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		return x.DrawOffer != ""
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		return x.BlackAccepted != false
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		return x.RedAccepted != false
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		return x.InvitationDeadline != int64(0)
+	case "buzzing.checkers.v1.StoredGame.wager":
+		return x.Wager != nil
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		return x.MinRating != uint64(0)
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		return x.TurnDuration != uint64(0)
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		return x.Deadline != int64(0)
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		return x.TournamentId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoredGame) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.StoredGame.board":
+		x.Board = ""
+	case "buzzing.checkers.v1.StoredGame.turn":
+		x.Turn = ""
+	case "buzzing.checkers.v1.StoredGame.black":
+		x.Black = ""
+	case "buzzing.checkers.v1.StoredGame.red":
+		x.Red = ""
+	case "buzzing.checkers.v1.StoredGame.status":
+		x.Status = 0
+	case "buzzing.checkers.v1.StoredGame.winner":
+		x.Winner = ""
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		x.MoveCount = uint64(0)
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		x.DrawOffer = ""
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		x.BlackAccepted = false
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		x.RedAccepted = false
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		x.InvitationDeadline = int64(0)
+	case "buzzing.checkers.v1.StoredGame.wager":
+		x.Wager = nil
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		x.MinRating = uint64(0)
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		x.TurnDuration = uint64(0)
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		x.Deadline = int64(0)
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		x.TournamentId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoredGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.StoredGame.board":
+		value := x.Board
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.turn":
+		value := x.Turn
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.black":
+		value := x.Black
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.red":
+		value := x.Red
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.StoredGame.winner":
+		value := x.Winner
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		value := x.MoveCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		value := x.DrawOffer
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		value := x.BlackAccepted
+		return protoreflect.ValueOfBool(value)
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		value := x.RedAccepted
+		return protoreflect.ValueOfBool(value)
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		value := x.InvitationDeadline
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.StoredGame.wager":
+		value := x.Wager
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		value := x.MinRating
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		value := x.TurnDuration
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		value := x.Deadline
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		value := x.TournamentId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoredGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.StoredGame.board":
+		x.Board = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.turn":
+		x.Turn = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.black":
+		x.Black = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.red":
+		x.Red = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.status":
+		x.Status = (GameStatus)(value.Enum())
+	case "buzzing.checkers.v1.StoredGame.winner":
+		x.Winner = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		x.MoveCount = value.Uint()
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		x.DrawOffer = value.Interface().(string)
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		x.BlackAccepted = value.Bool()
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		x.RedAccepted = value.Bool()
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		x.InvitationDeadline = value.Int()
+	case "buzzing.checkers.v1.StoredGame.wager":
+		x.Wager = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		x.MinRating = value.Uint()
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		x.TurnDuration = value.Uint()
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		x.Deadline = value.Int()
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		x.TournamentId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoredGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.StoredGame.wager":
+		if x.Wager == nil {
+			x.Wager = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Wager.ProtoReflect())
+	case "buzzing.checkers.v1.StoredGame.board":
+		panic(fmt.Errorf("field board of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.turn":
+		panic(fmt.Errorf("field turn of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.black":
+		panic(fmt.Errorf("field black of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.red":
+		panic(fmt.Errorf("field red of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.status":
+		panic(fmt.Errorf("field status of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.winner":
+		panic(fmt.Errorf("field winner of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		panic(fmt.Errorf("field move_count of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		panic(fmt.Errorf("field draw_offer of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		panic(fmt.Errorf("field black_accepted of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		panic(fmt.Errorf("field red_accepted of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		panic(fmt.Errorf("field invitation_deadline of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		panic(fmt.Errorf("field min_rating of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		panic(fmt.Errorf("field turn_duration of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		panic(fmt.Errorf("field deadline of message buzzing.checkers.v1.StoredGame is not mutable"))
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		panic(fmt.Errorf("field tournament_id of message buzzing.checkers.v1.StoredGame is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoredGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.StoredGame.board":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.turn":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.black":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.red":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.status":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.StoredGame.winner":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.move_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.StoredGame.draw_offer":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.StoredGame.black_accepted":
+		return protoreflect.ValueOfBool(false)
+	case "buzzing.checkers.v1.StoredGame.red_accepted":
+		return protoreflect.ValueOfBool(false)
+	case "buzzing.checkers.v1.StoredGame.invitation_deadline":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.StoredGame.wager":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.StoredGame.min_rating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.StoredGame.turn_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.StoredGame.deadline":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.StoredGame.tournament_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoredGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.StoredGame", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoredGame) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoredGame) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoredGame) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoredGame) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoredGame)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Board)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Turn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Black)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Red)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Winner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MoveCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MoveCount))
+		}
+		l = len(x.DrawOffer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlackAccepted {
+			n += 2
+		}
+		if x.RedAccepted {
+			n += 2
+		}
+		if x.InvitationDeadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.InvitationDeadline))
+		}
+		if x.Wager != nil {
+			l = options.Size(x.Wager)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinRating != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinRating))
+		}
+		if x.TurnDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.TurnDuration))
+		}
+		if x.Deadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.Deadline))
+		}
+		if x.TournamentId != 0 {
+			n += 2 + runtime.Sov(uint64(x.TournamentId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoredGame)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TournamentId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TournamentId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.Deadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deadline))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.TurnDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TurnDuration))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MinRating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinRating))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Wager != nil {
+			encoded, err := options.Marshal(x.Wager)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.InvitationDeadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InvitationDeadline))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.RedAccepted {
+			i--
+			if x.RedAccepted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.BlackAccepted {
+			i--
+			if x.BlackAccepted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.DrawOffer) > 0 {
+			i -= len(x.DrawOffer)
+			copy(dAtA[i:], x.DrawOffer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DrawOffer)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MoveCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MoveCount))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Winner) > 0 {
+			i -= len(x.Winner)
+			copy(dAtA[i:], x.Winner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Winner)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Red) > 0 {
+			i -= len(x.Red)
+			copy(dAtA[i:], x.Red)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Red)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Black) > 0 {
+			i -= len(x.Black)
+			copy(dAtA[i:], x.Black)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Black)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Turn) > 0 {
+			i -= len(x.Turn)
+			copy(dAtA[i:], x.Turn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Turn)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Board) > 0 {
+			i -= len(x.Board)
+			copy(dAtA[i:], x.Board)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Board)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoredGame)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoredGame: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Board = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Turn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Black = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Red = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= GameStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Winner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+				}
+				x.MoveCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MoveCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrawOffer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DrawOffer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlackAccepted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BlackAccepted = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedAccepted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RedAccepted = bool(v != 0)
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvitationDeadline", wireType)
+				}
+				x.InvitationDeadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InvitationDeadline |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Wager == nil {
+					x.Wager = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wager); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRating", wireType)
+				}
+				x.MinRating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinRating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+				}
+				x.TurnDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TurnDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				}
+				x.Deadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deadline |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+				}
+				x.TournamentId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TournamentId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PlayerInfo             protoreflect.MessageDescriptor
+	fd_PlayerInfo_won_count   protoreflect.FieldDescriptor
+	fd_PlayerInfo_lost_count  protoreflect.FieldDescriptor
+	fd_PlayerInfo_drawn_count protoreflect.FieldDescriptor
+	fd_PlayerInfo_rating      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_PlayerInfo = File_buzzing_checkers_v1_types_proto.Messages().ByName("PlayerInfo")
+	fd_PlayerInfo_won_count = md_PlayerInfo.Fields().ByName("won_count")
+	fd_PlayerInfo_lost_count = md_PlayerInfo.Fields().ByName("lost_count")
+	fd_PlayerInfo_drawn_count = md_PlayerInfo.Fields().ByName("drawn_count")
+	fd_PlayerInfo_rating = md_PlayerInfo.Fields().ByName("rating")
+}
+
+var _ protoreflect.Message = (*fastReflection_PlayerInfo)(nil)
+
+type fastReflection_PlayerInfo PlayerInfo
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PlayerInfo)(x)
+}
+
+func (x *PlayerInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PlayerInfo_messageType fastReflection_PlayerInfo_messageType
+var _ protoreflect.MessageType = fastReflection_PlayerInfo_messageType{}
+
+type fastReflection_PlayerInfo_messageType struct{}
+
+func (x fastReflection_PlayerInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PlayerInfo)(nil)
+}
+func (x fastReflection_PlayerInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_PlayerInfo)
+}
+func (x fastReflection_PlayerInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlayerInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PlayerInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_PlayerInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PlayerInfo) Type() protoreflect.MessageType {
+	return _fastReflection_PlayerInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PlayerInfo) New() protoreflect.Message {
+	return new(fastReflection_PlayerInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PlayerInfo) Interface() protoreflect.ProtoMessage {
+	return (*PlayerInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PlayerInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WonCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WonCount)
+		if !f(fd_PlayerInfo_won_count, value) {
+			return
+		}
+	}
+	if x.LostCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LostCount)
+		if !f(fd_PlayerInfo_lost_count, value) {
+			return
+		}
+	}
+	if x.DrawnCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DrawnCount)
+		if !f(fd_PlayerInfo_drawn_count, value) {
+			return
+		}
+	}
+	if x.Rating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Rating)
+		if !f(fd_PlayerInfo_rating, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PlayerInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		return x.WonCount != uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		return x.LostCount != uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		return x.DrawnCount != uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		return x.Rating != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		x.WonCount = uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		x.LostCount = uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		x.DrawnCount = uint64(0)
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		x.Rating = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PlayerInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		value := x.WonCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		value := x.LostCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		value := x.DrawnCount
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		value := x.Rating
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		x.WonCount = value.Uint()
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		x.LostCount = value.Uint()
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		x.DrawnCount = value.Uint()
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		x.Rating = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		panic(fmt.Errorf("field won_count of message buzzing.checkers.v1.PlayerInfo is not mutable"))
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		panic(fmt.Errorf("field lost_count of message buzzing.checkers.v1.PlayerInfo is not mutable"))
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		panic(fmt.Errorf("field drawn_count of message buzzing.checkers.v1.PlayerInfo is not mutable"))
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		panic(fmt.Errorf("field rating of message buzzing.checkers.v1.PlayerInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PlayerInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.PlayerInfo.won_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.PlayerInfo.lost_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.PlayerInfo.drawn_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.PlayerInfo.rating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.PlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.PlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PlayerInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.PlayerInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PlayerInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PlayerInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PlayerInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PlayerInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PlayerInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.WonCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.WonCount))
+		}
+		if x.LostCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.LostCount))
+		}
+		if x.DrawnCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DrawnCount))
+		}
+		if x.Rating != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rating))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PlayerInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rating))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.DrawnCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DrawnCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LostCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LostCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.WonCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WonCount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PlayerInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlayerInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WonCount", wireType)
+				}
+				x.WonCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WonCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LostCount", wireType)
+				}
+				x.LostCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LostCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DrawnCount", wireType)
+				}
+				x.DrawnCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DrawnCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+				}
+				x.Rating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IndexedPlayerInfo            protoreflect.MessageDescriptor
+	fd_IndexedPlayerInfo_player     protoreflect.FieldDescriptor
+	fd_IndexedPlayerInfo_playerInfo protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_IndexedPlayerInfo = File_buzzing_checkers_v1_types_proto.Messages().ByName("IndexedPlayerInfo")
+	fd_IndexedPlayerInfo_player = md_IndexedPlayerInfo.Fields().ByName("player")
+	fd_IndexedPlayerInfo_playerInfo = md_IndexedPlayerInfo.Fields().ByName("playerInfo")
+}
+
+var _ protoreflect.Message = (*fastReflection_IndexedPlayerInfo)(nil)
+
+type fastReflection_IndexedPlayerInfo IndexedPlayerInfo
+
+func (x *IndexedPlayerInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IndexedPlayerInfo)(x)
+}
+
+func (x *IndexedPlayerInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IndexedPlayerInfo_messageType fastReflection_IndexedPlayerInfo_messageType
+var _ protoreflect.MessageType = fastReflection_IndexedPlayerInfo_messageType{}
+
+type fastReflection_IndexedPlayerInfo_messageType struct{}
+
+func (x fastReflection_IndexedPlayerInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IndexedPlayerInfo)(nil)
+}
+func (x fastReflection_IndexedPlayerInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_IndexedPlayerInfo)
+}
+func (x fastReflection_IndexedPlayerInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IndexedPlayerInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IndexedPlayerInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_IndexedPlayerInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IndexedPlayerInfo) Type() protoreflect.MessageType {
+	return _fastReflection_IndexedPlayerInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IndexedPlayerInfo) New() protoreflect.Message {
+	return new(fastReflection_IndexedPlayerInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IndexedPlayerInfo) Interface() protoreflect.ProtoMessage {
+	return (*IndexedPlayerInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IndexedPlayerInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_IndexedPlayerInfo_player, value) {
+			return
+		}
+	}
+	if x.PlayerInfo != nil {
+		value := protoreflect.ValueOfMessage(x.PlayerInfo.ProtoReflect())
+		if !f(fd_IndexedPlayerInfo_playerInfo, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IndexedPlayerInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		return x.PlayerInfo != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedPlayerInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		x.PlayerInfo = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IndexedPlayerInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		value := x.PlayerInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedPlayerInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		x.PlayerInfo = value.Message().Interface().(*PlayerInfo)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedPlayerInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		if x.PlayerInfo == nil {
+			x.PlayerInfo = new(PlayerInfo)
+		}
+		return protoreflect.ValueOfMessage(x.PlayerInfo.ProtoReflect())
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.IndexedPlayerInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IndexedPlayerInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedPlayerInfo.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IndexedPlayerInfo.playerInfo":
+		m := new(PlayerInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedPlayerInfo"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedPlayerInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IndexedPlayerInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.IndexedPlayerInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IndexedPlayerInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedPlayerInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IndexedPlayerInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IndexedPlayerInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IndexedPlayerInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PlayerInfo != nil {
+			l = options.Size(x.PlayerInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IndexedPlayerInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PlayerInfo != nil {
+			encoded, err := options.Marshal(x.PlayerInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IndexedPlayerInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedPlayerInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedPlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PlayerInfo == nil {
+					x.PlayerInfo = &PlayerInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PlayerInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueueEntry               protoreflect.MessageDescriptor
+	fd_QueueEntry_player        protoreflect.FieldDescriptor
+	fd_QueueEntry_rating        protoreflect.FieldDescriptor
+	fd_QueueEntry_turn_duration protoreflect.FieldDescriptor
+	fd_QueueEntry_wager         protoreflect.FieldDescriptor
+	fd_QueueEntry_joined_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_QueueEntry = File_buzzing_checkers_v1_types_proto.Messages().ByName("QueueEntry")
+	fd_QueueEntry_player = md_QueueEntry.Fields().ByName("player")
+	fd_QueueEntry_rating = md_QueueEntry.Fields().ByName("rating")
+	fd_QueueEntry_turn_duration = md_QueueEntry.Fields().ByName("turn_duration")
+	fd_QueueEntry_wager = md_QueueEntry.Fields().ByName("wager")
+	fd_QueueEntry_joined_height = md_QueueEntry.Fields().ByName("joined_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueueEntry)(nil)
+
+type fastReflection_QueueEntry QueueEntry
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueueEntry)(x)
+}
+
+func (x *QueueEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueueEntry_messageType fastReflection_QueueEntry_messageType
+var _ protoreflect.MessageType = fastReflection_QueueEntry_messageType{}
+
+type fastReflection_QueueEntry_messageType struct{}
+
+func (x fastReflection_QueueEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueueEntry)(nil)
+}
+func (x fastReflection_QueueEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueueEntry)
+}
+func (x fastReflection_QueueEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueueEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueueEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueueEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueueEntry) Type() protoreflect.MessageType {
+	return _fastReflection_QueueEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueueEntry) New() protoreflect.Message {
+	return new(fastReflection_QueueEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueueEntry) Interface() protoreflect.ProtoMessage {
+	return (*QueueEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueueEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_QueueEntry_player, value) {
+			return
+		}
+	}
+	if x.Rating != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Rating)
+		if !f(fd_QueueEntry_rating, value) {
+			return
+		}
+	}
+	if x.TurnDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TurnDuration)
+		if !f(fd_QueueEntry_turn_duration, value) {
+			return
+		}
+	}
+	if x.Wager != nil {
+		value := protoreflect.ValueOfMessage(x.Wager.ProtoReflect())
+		if !f(fd_QueueEntry_wager, value) {
+			return
+		}
+	}
+	if x.JoinedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JoinedHeight)
+		if !f(fd_QueueEntry_joined_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueueEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		return x.Rating != uint64(0)
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		return x.TurnDuration != uint64(0)
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		return x.Wager != nil
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		return x.JoinedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		x.Rating = uint64(0)
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		x.TurnDuration = uint64(0)
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		x.Wager = nil
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		x.JoinedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueueEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		value := x.Rating
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		value := x.TurnDuration
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		value := x.Wager
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		value := x.JoinedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		x.Rating = value.Uint()
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		x.TurnDuration = value.Uint()
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		x.Wager = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		x.JoinedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		if x.Wager == nil {
+			x.Wager = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Wager.ProtoReflect())
+	case "buzzing.checkers.v1.QueueEntry.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.QueueEntry is not mutable"))
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		panic(fmt.Errorf("field rating of message buzzing.checkers.v1.QueueEntry is not mutable"))
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		panic(fmt.Errorf("field turn_duration of message buzzing.checkers.v1.QueueEntry is not mutable"))
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		panic(fmt.Errorf("field joined_height of message buzzing.checkers.v1.QueueEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueueEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.QueueEntry.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.QueueEntry.rating":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.QueueEntry.turn_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.QueueEntry.wager":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.QueueEntry.joined_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.QueueEntry"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.QueueEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueueEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.QueueEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueueEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueueEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueueEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueueEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rating != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rating))
+		}
+		if x.TurnDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.TurnDuration))
+		}
+		if x.Wager != nil {
+			l = options.Size(x.Wager)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.JoinedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JoinedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.JoinedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JoinedHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Wager != nil {
+			encoded, err := options.Marshal(x.Wager)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TurnDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TurnDuration))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Rating != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rating))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueueEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+				}
+				x.Rating = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rating |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+				}
+				x.TurnDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TurnDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Wager == nil {
+					x.Wager = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Wager); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JoinedHeight", wireType)
+				}
+				x.JoinedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JoinedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IndexedStoredGame            protoreflect.MessageDescriptor
+	fd_IndexedStoredGame_index      protoreflect.FieldDescriptor
+	fd_IndexedStoredGame_storedGame protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_IndexedStoredGame = File_buzzing_checkers_v1_types_proto.Messages().ByName("IndexedStoredGame")
+	fd_IndexedStoredGame_index = md_IndexedStoredGame.Fields().ByName("index")
+	fd_IndexedStoredGame_storedGame = md_IndexedStoredGame.Fields().ByName("storedGame")
+}
+
+var _ protoreflect.Message = (*fastReflection_IndexedStoredGame)(nil)
+
+type fastReflection_IndexedStoredGame IndexedStoredGame
+
+func (x *IndexedStoredGame) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IndexedStoredGame)(x)
+}
+
+func (x *IndexedStoredGame) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IndexedStoredGame_messageType fastReflection_IndexedStoredGame_messageType
+var _ protoreflect.MessageType = fastReflection_IndexedStoredGame_messageType{}
+
+type fastReflection_IndexedStoredGame_messageType struct{}
+
+func (x fastReflection_IndexedStoredGame_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IndexedStoredGame)(nil)
+}
+func (x fastReflection_IndexedStoredGame_messageType) New() protoreflect.Message {
+	return new(fastReflection_IndexedStoredGame)
+}
+func (x fastReflection_IndexedStoredGame_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IndexedStoredGame
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IndexedStoredGame) Descriptor() protoreflect.MessageDescriptor {
+	return md_IndexedStoredGame
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IndexedStoredGame) Type() protoreflect.MessageType {
+	return _fastReflection_IndexedStoredGame_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IndexedStoredGame) New() protoreflect.Message {
+	return new(fastReflection_IndexedStoredGame)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IndexedStoredGame) Interface() protoreflect.ProtoMessage {
+	return (*IndexedStoredGame)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IndexedStoredGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_IndexedStoredGame_index, value) {
+			return
+		}
+	}
+	if x.StoredGame != nil {
+		value := protoreflect.ValueOfMessage(x.StoredGame.ProtoReflect())
+		if !f(fd_IndexedStoredGame_storedGame, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IndexedStoredGame) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		return x.Index != ""
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		return x.StoredGame != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedStoredGame) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		x.Index = ""
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		x.StoredGame = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IndexedStoredGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		value := x.StoredGame
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedStoredGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		x.Index = value.Interface().(string)
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		x.StoredGame = value.Message().Interface().(*StoredGame)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedStoredGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		if x.StoredGame == nil {
+			x.StoredGame = new(StoredGame)
+		}
+		return protoreflect.ValueOfMessage(x.StoredGame.ProtoReflect())
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		panic(fmt.Errorf("field index of message buzzing.checkers.v1.IndexedStoredGame is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IndexedStoredGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IndexedStoredGame.index":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
+		m := new(StoredGame)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IndexedStoredGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.IndexedStoredGame", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IndexedStoredGame) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IndexedStoredGame) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IndexedStoredGame) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IndexedStoredGame) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IndexedStoredGame)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StoredGame != nil {
+			l = options.Size(x.StoredGame)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IndexedStoredGame)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StoredGame != nil {
+			encoded, err := options.Marshal(x.StoredGame)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IndexedStoredGame)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedStoredGame: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedStoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StoredGame == nil {
+					x.StoredGame = &StoredGame{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StoredGame); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Tournament_9_list)(nil)
+
+type _Tournament_9_list struct {
+	list *[]uint32
+}
+
+func (x *_Tournament_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tournament_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_Tournament_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tournament_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tournament_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Tournament at list field PrizeShares as it is not of Message kind"))
+}
+
+func (x *_Tournament_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tournament_9_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_Tournament_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Tournament_15_list)(nil)
+
+type _Tournament_15_list struct {
+	list *[]string
+}
+
+func (x *_Tournament_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tournament_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Tournament_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tournament_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tournament_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Tournament at list field RoundGames as it is not of Message kind"))
+}
+
+func (x *_Tournament_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tournament_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Tournament_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Tournament_16_list)(nil)
+
+type _Tournament_16_list struct {
+	list *[]string
+}
+
+func (x *_Tournament_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Tournament_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Tournament_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Tournament_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Tournament_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Tournament at list field Players as it is not of Message kind"))
+}
+
+func (x *_Tournament_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Tournament_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Tournament_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Tournament               protoreflect.MessageDescriptor
+	fd_Tournament_id            protoreflect.FieldDescriptor
+	fd_Tournament_creator       protoreflect.FieldDescriptor
+	fd_Tournament_name          protoreflect.FieldDescriptor
+	fd_Tournament_format        protoreflect.FieldDescriptor
+	fd_Tournament_status        protoreflect.FieldDescriptor
+	fd_Tournament_sanctioned    protoreflect.FieldDescriptor
+	fd_Tournament_entry_fee     protoreflect.FieldDescriptor
+	fd_Tournament_prize_pool    protoreflect.FieldDescriptor
+	fd_Tournament_prize_shares  protoreflect.FieldDescriptor
+	fd_Tournament_max_players   protoreflect.FieldDescriptor
+	fd_Tournament_start_height  protoreflect.FieldDescriptor
+	fd_Tournament_turn_duration protoreflect.FieldDescriptor
+	fd_Tournament_rounds        protoreflect.FieldDescriptor
+	fd_Tournament_current_round protoreflect.FieldDescriptor
+	fd_Tournament_round_games   protoreflect.FieldDescriptor
+	fd_Tournament_players       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_Tournament = File_buzzing_checkers_v1_types_proto.Messages().ByName("Tournament")
+	fd_Tournament_id = md_Tournament.Fields().ByName("id")
+	fd_Tournament_creator = md_Tournament.Fields().ByName("creator")
+	fd_Tournament_name = md_Tournament.Fields().ByName("name")
+	fd_Tournament_format = md_Tournament.Fields().ByName("format")
+	fd_Tournament_status = md_Tournament.Fields().ByName("status")
+	fd_Tournament_sanctioned = md_Tournament.Fields().ByName("sanctioned")
+	fd_Tournament_entry_fee = md_Tournament.Fields().ByName("entry_fee")
+	fd_Tournament_prize_pool = md_Tournament.Fields().ByName("prize_pool")
+	fd_Tournament_prize_shares = md_Tournament.Fields().ByName("prize_shares")
+	fd_Tournament_max_players = md_Tournament.Fields().ByName("max_players")
+	fd_Tournament_start_height = md_Tournament.Fields().ByName("start_height")
+	fd_Tournament_turn_duration = md_Tournament.Fields().ByName("turn_duration")
+	fd_Tournament_rounds = md_Tournament.Fields().ByName("rounds")
+	fd_Tournament_current_round = md_Tournament.Fields().ByName("current_round")
+	fd_Tournament_round_games = md_Tournament.Fields().ByName("round_games")
+	fd_Tournament_players = md_Tournament.Fields().ByName("players")
+}
+
+var _ protoreflect.Message = (*fastReflection_Tournament)(nil)
+
+type fastReflection_Tournament Tournament
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Tournament)(x)
+}
+
+func (x *Tournament) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Tournament_messageType fastReflection_Tournament_messageType
+var _ protoreflect.MessageType = fastReflection_Tournament_messageType{}
+
+type fastReflection_Tournament_messageType struct{}
+
+func (x fastReflection_Tournament_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Tournament)(nil)
+}
+func (x fastReflection_Tournament_messageType) New() protoreflect.Message {
+	return new(fastReflection_Tournament)
+}
+func (x fastReflection_Tournament_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Tournament
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Tournament) Descriptor() protoreflect.MessageDescriptor {
+	return md_Tournament
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Tournament) Type() protoreflect.MessageType {
+	return _fastReflection_Tournament_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Tournament) New() protoreflect.Message {
+	return new(fastReflection_Tournament)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Tournament) Interface() protoreflect.ProtoMessage {
+	return (*Tournament)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Tournament) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Tournament_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_Tournament_creator, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Tournament_name, value) {
+			return
+		}
+	}
+	if x.Format != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Format))
+		if !f(fd_Tournament_format, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Tournament_status, value) {
+			return
+		}
+	}
+	if x.Sanctioned != false {
+		value := protoreflect.ValueOfBool(x.Sanctioned)
+		if !f(fd_Tournament_sanctioned, value) {
+			return
+		}
+	}
+	if x.EntryFee != nil {
+		value := protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+		if !f(fd_Tournament_entry_fee, value) {
+			return
+		}
+	}
+	if x.PrizePool != nil {
+		value := protoreflect.ValueOfMessage(x.PrizePool.ProtoReflect())
+		if !f(fd_Tournament_prize_pool, value) {
+			return
+		}
+	}
+	if len(x.PrizeShares) != 0 {
+		value := protoreflect.ValueOfList(&_Tournament_9_list{list: &x.PrizeShares})
+		if !f(fd_Tournament_prize_shares, value) {
+			return
+		}
+	}
+	if x.MaxPlayers != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPlayers)
+		if !f(fd_Tournament_max_players, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_Tournament_start_height, value) {
+			return
+		}
+	}
+	if x.TurnDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TurnDuration)
+		if !f(fd_Tournament_turn_duration, value) {
+			return
+		}
+	}
+	if x.Rounds != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Rounds)
+		if !f(fd_Tournament_rounds, value) {
+			return
+		}
+	}
+	if x.CurrentRound != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CurrentRound)
+		if !f(fd_Tournament_current_round, value) {
+			return
+		}
+	}
+	if len(x.RoundGames) != 0 {
+		value := protoreflect.ValueOfList(&_Tournament_15_list{list: &x.RoundGames})
+		if !f(fd_Tournament_round_games, value) {
+			return
+		}
+	}
+	if len(x.Players) != 0 {
+		value := protoreflect.ValueOfList(&_Tournament_16_list{list: &x.Players})
+		if !f(fd_Tournament_players, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Tournament) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Tournament.id":
+		return x.Id != uint64(0)
+	case "buzzing.checkers.v1.Tournament.creator":
+		return x.Creator != ""
+	case "buzzing.checkers.v1.Tournament.name":
+		return x.Name != ""
+	case "buzzing.checkers.v1.Tournament.format":
+		return x.Format != 0
+	case "buzzing.checkers.v1.Tournament.status":
+		return x.Status != 0
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		return x.Sanctioned != false
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		return x.EntryFee != nil
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		return x.PrizePool != nil
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		return len(x.PrizeShares) != 0
+	case "buzzing.checkers.v1.Tournament.max_players":
+		return x.MaxPlayers != uint64(0)
+	case "buzzing.checkers.v1.Tournament.start_height":
+		return x.StartHeight != int64(0)
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		return x.TurnDuration != uint64(0)
+	case "buzzing.checkers.v1.Tournament.rounds":
+		return x.Rounds != uint32(0)
+	case "buzzing.checkers.v1.Tournament.current_round":
+		return x.CurrentRound != uint32(0)
+	case "buzzing.checkers.v1.Tournament.round_games":
+		return len(x.RoundGames) != 0
+	case "buzzing.checkers.v1.Tournament.players":
+		return len(x.Players) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Tournament) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Tournament.id":
+		x.Id = uint64(0)
+	case "buzzing.checkers.v1.Tournament.creator":
+		x.Creator = ""
+	case "buzzing.checkers.v1.Tournament.name":
+		x.Name = ""
+	case "buzzing.checkers.v1.Tournament.format":
+		x.Format = 0
+	case "buzzing.checkers.v1.Tournament.status":
+		x.Status = 0
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		x.Sanctioned = false
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		x.EntryFee = nil
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		x.PrizePool = nil
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		x.PrizeShares = nil
+	case "buzzing.checkers.v1.Tournament.max_players":
+		x.MaxPlayers = uint64(0)
+	case "buzzing.checkers.v1.Tournament.start_height":
+		x.StartHeight = int64(0)
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		x.TurnDuration = uint64(0)
+	case "buzzing.checkers.v1.Tournament.rounds":
+		x.Rounds = uint32(0)
+	case "buzzing.checkers.v1.Tournament.current_round":
+		x.CurrentRound = uint32(0)
+	case "buzzing.checkers.v1.Tournament.round_games":
+		x.RoundGames = nil
+	case "buzzing.checkers.v1.Tournament.players":
+		x.Players = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Tournament) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.Tournament.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Tournament.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.Tournament.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.Tournament.format":
+		value := x.Format
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.Tournament.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		value := x.Sanctioned
+		return protoreflect.ValueOfBool(value)
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		value := x.EntryFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		value := x.PrizePool
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		if len(x.PrizeShares) == 0 {
+			return protoreflect.ValueOfList(&_Tournament_9_list{})
+		}
+		listValue := &_Tournament_9_list{list: &x.PrizeShares}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.Tournament.max_players":
+		value := x.MaxPlayers
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Tournament.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		value := x.TurnDuration
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Tournament.rounds":
+		value := x.Rounds
+		return protoreflect.ValueOfUint32(value)
+	case "buzzing.checkers.v1.Tournament.current_round":
+		value := x.CurrentRound
+		return protoreflect.ValueOfUint32(value)
+	case "buzzing.checkers.v1.Tournament.round_games":
+		if len(x.RoundGames) == 0 {
+			return protoreflect.ValueOfList(&_Tournament_15_list{})
+		}
+		listValue := &_Tournament_15_list{list: &x.RoundGames}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.Tournament.players":
+		if len(x.Players) == 0 {
+			return protoreflect.ValueOfList(&_Tournament_16_list{})
+		}
+		listValue := &_Tournament_16_list{list: &x.Players}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Tournament) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Tournament.id":
+		x.Id = value.Uint()
+	case "buzzing.checkers.v1.Tournament.creator":
+		x.Creator = value.Interface().(string)
+	case "buzzing.checkers.v1.Tournament.name":
+		x.Name = value.Interface().(string)
+	case "buzzing.checkers.v1.Tournament.format":
+		x.Format = (TournamentFormat)(value.Enum())
+	case "buzzing.checkers.v1.Tournament.status":
+		x.Status = (TournamentStatus)(value.Enum())
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		x.Sanctioned = value.Bool()
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		x.EntryFee = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		x.PrizePool = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		lv := value.List()
+		clv := lv.(*_Tournament_9_list)
+		x.PrizeShares = *clv.list
+	case "buzzing.checkers.v1.Tournament.max_players":
+		x.MaxPlayers = value.Uint()
+	case "buzzing.checkers.v1.Tournament.start_height":
+		x.StartHeight = value.Int()
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		x.TurnDuration = value.Uint()
+	case "buzzing.checkers.v1.Tournament.rounds":
+		x.Rounds = uint32(value.Uint())
+	case "buzzing.checkers.v1.Tournament.current_round":
+		x.CurrentRound = uint32(value.Uint())
+	case "buzzing.checkers.v1.Tournament.round_games":
+		lv := value.List()
+		clv := lv.(*_Tournament_15_list)
+		x.RoundGames = *clv.list
+	case "buzzing.checkers.v1.Tournament.players":
+		lv := value.List()
+		clv := lv.(*_Tournament_16_list)
+		x.Players = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Tournament) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		if x.EntryFee == nil {
+			x.EntryFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.EntryFee.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		if x.PrizePool == nil {
+			x.PrizePool = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PrizePool.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		if x.PrizeShares == nil {
+			x.PrizeShares = []uint32{}
+		}
+		value := &_Tournament_9_list{list: &x.PrizeShares}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Tournament.round_games":
+		if x.RoundGames == nil {
+			x.RoundGames = []string{}
+		}
+		value := &_Tournament_15_list{list: &x.RoundGames}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Tournament.players":
+		if x.Players == nil {
+			x.Players = []string{}
+		}
+		value := &_Tournament_16_list{list: &x.Players}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Tournament.id":
+		panic(fmt.Errorf("field id of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.creator":
+		panic(fmt.Errorf("field creator of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.name":
+		panic(fmt.Errorf("field name of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.format":
+		panic(fmt.Errorf("field format of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.status":
+		panic(fmt.Errorf("field status of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		panic(fmt.Errorf("field sanctioned of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.max_players":
+		panic(fmt.Errorf("field max_players of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.start_height":
+		panic(fmt.Errorf("field start_height of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		panic(fmt.Errorf("field turn_duration of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.rounds":
+		panic(fmt.Errorf("field rounds of message buzzing.checkers.v1.Tournament is not mutable"))
+	case "buzzing.checkers.v1.Tournament.current_round":
+		panic(fmt.Errorf("field current_round of message buzzing.checkers.v1.Tournament is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Tournament) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Tournament.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Tournament.creator":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.Tournament.name":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.Tournament.format":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.Tournament.status":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.Tournament.sanctioned":
+		return protoreflect.ValueOfBool(false)
+	case "buzzing.checkers.v1.Tournament.entry_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_pool":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.Tournament.prize_shares":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_Tournament_9_list{list: &list})
+	case "buzzing.checkers.v1.Tournament.max_players":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Tournament.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.Tournament.turn_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Tournament.rounds":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "buzzing.checkers.v1.Tournament.current_round":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "buzzing.checkers.v1.Tournament.round_games":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Tournament_15_list{list: &list})
+	case "buzzing.checkers.v1.Tournament.players":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Tournament_16_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Tournament"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Tournament does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Tournament) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.Tournament", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Tournament) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Tournament) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Tournament) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Tournament) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Tournament)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Format != 0 {
+			n += 1 + runtime.Sov(uint64(x.Format))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.Sanctioned {
+			n += 2
+		}
+		if x.EntryFee != nil {
+			l = options.Size(x.EntryFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrizePool != nil {
+			l = options.Size(x.PrizePool)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PrizeShares) > 0 {
+			l = 0
+			for _, e := range x.PrizeShares {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.MaxPlayers != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPlayers))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.TurnDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.TurnDuration))
+		}
+		if x.Rounds != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rounds))
+		}
+		if x.CurrentRound != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentRound))
+		}
+		if len(x.RoundGames) > 0 {
+			for _, s := range x.RoundGames {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Players) > 0 {
+			for _, s := range x.Players {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Tournament)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Players) > 0 {
+			for iNdEx := len(x.Players) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Players[iNdEx])
+				copy(dAtA[i:], x.Players[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Players[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.RoundGames) > 0 {
+			for iNdEx := len(x.RoundGames) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RoundGames[iNdEx])
+				copy(dAtA[i:], x.RoundGames[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RoundGames[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.CurrentRound != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentRound))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Rounds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rounds))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.TurnDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TurnDuration))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxPlayers != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPlayers))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.PrizeShares) > 0 {
+			var pksize2 int
+			for _, num := range x.PrizeShares {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PrizeShares {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.PrizePool != nil {
+			encoded, err := options.Marshal(x.PrizePool)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.EntryFee != nil {
+			encoded, err := options.Marshal(x.EntryFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Sanctioned {
+			i--
+			if x.Sanctioned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Format != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Format))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Tournament)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				}
+				x.Format = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Format |= TournamentFormat(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TournamentStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sanctioned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Sanctioned = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EntryFee == nil {
+					x.EntryFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntryFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrizePool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrizePool == nil {
+					x.PrizePool = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrizePool); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PrizeShares = append(x.PrizeShares, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PrizeShares) == 0 {
+						x.PrizeShares = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PrizeShares = append(x.PrizeShares, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrizeShares", wireType)
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+				}
+				x.MaxPlayers = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPlayers |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+				}
+				x.TurnDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TurnDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+				}
+				x.Rounds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rounds |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentRound", wireType)
+				}
+				x.CurrentRound = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentRound |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundGames", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RoundGames = append(x.RoundGames, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Players = append(x.Players, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TournamentResult            protoreflect.MessageDescriptor
+	fd_TournamentResult_round      protoreflect.FieldDescriptor
+	fd_TournamentResult_opponent   protoreflect.FieldDescriptor
+	fd_TournamentResult_color      protoreflect.FieldDescriptor
+	fd_TournamentResult_points     protoreflect.FieldDescriptor
+	fd_TournamentResult_game_index protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_TournamentResult = File_buzzing_checkers_v1_types_proto.Messages().ByName("TournamentResult")
+	fd_TournamentResult_round = md_TournamentResult.Fields().ByName("round")
+	fd_TournamentResult_opponent = md_TournamentResult.Fields().ByName("opponent")
+	fd_TournamentResult_color = md_TournamentResult.Fields().ByName("color")
+	fd_TournamentResult_points = md_TournamentResult.Fields().ByName("points")
+	fd_TournamentResult_game_index = md_TournamentResult.Fields().ByName("game_index")
+}
+
+var _ protoreflect.Message = (*fastReflection_TournamentResult)(nil)
+
+type fastReflection_TournamentResult TournamentResult
+
+func (x *TournamentResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TournamentResult)(x)
+}
+
+func (x *TournamentResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TournamentResult_messageType fastReflection_TournamentResult_messageType
+var _ protoreflect.MessageType = fastReflection_TournamentResult_messageType{}
+
+type fastReflection_TournamentResult_messageType struct{}
+
+func (x fastReflection_TournamentResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TournamentResult)(nil)
+}
+func (x fastReflection_TournamentResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TournamentResult)
+}
+func (x fastReflection_TournamentResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TournamentResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TournamentResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TournamentResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TournamentResult) Type() protoreflect.MessageType {
+	return _fastReflection_TournamentResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TournamentResult) New() protoreflect.Message {
+	return new(fastReflection_TournamentResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TournamentResult) Interface() protoreflect.ProtoMessage {
+	return (*TournamentResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TournamentResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Round != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Round)
+		if !f(fd_TournamentResult_round, value) {
+			return
+		}
+	}
+	if x.Opponent != "" {
+		value := protoreflect.ValueOfString(x.Opponent)
+		if !f(fd_TournamentResult_opponent, value) {
+			return
+		}
+	}
+	if x.Color != "" {
+		value := protoreflect.ValueOfString(x.Color)
+		if !f(fd_TournamentResult_color, value) {
+			return
+		}
+	}
+	if x.Points != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Points)
+		if !f(fd_TournamentResult_points, value) {
+			return
+		}
+	}
+	if x.GameIndex != "" {
+		value := protoreflect.ValueOfString(x.GameIndex)
+		if !f(fd_TournamentResult_game_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TournamentResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		return x.Round != uint32(0)
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		return x.Opponent != ""
+	case "buzzing.checkers.v1.TournamentResult.color":
+		return x.Color != ""
+	case "buzzing.checkers.v1.TournamentResult.points":
+		return x.Points != uint32(0)
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		return x.GameIndex != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		x.Round = uint32(0)
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		x.Opponent = ""
+	case "buzzing.checkers.v1.TournamentResult.color":
+		x.Color = ""
+	case "buzzing.checkers.v1.TournamentResult.points":
+		x.Points = uint32(0)
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		x.GameIndex = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TournamentResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		value := x.Round
+		return protoreflect.ValueOfUint32(value)
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		value := x.Opponent
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.TournamentResult.color":
+		value := x.Color
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.TournamentResult.points":
+		value := x.Points
+		return protoreflect.ValueOfUint32(value)
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		value := x.GameIndex
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		x.Round = uint32(value.Uint())
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		x.Opponent = value.Interface().(string)
+	case "buzzing.checkers.v1.TournamentResult.color":
+		x.Color = value.Interface().(string)
+	case "buzzing.checkers.v1.TournamentResult.points":
+		x.Points = uint32(value.Uint())
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		x.GameIndex = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		panic(fmt.Errorf("field round of message buzzing.checkers.v1.TournamentResult is not mutable"))
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		panic(fmt.Errorf("field opponent of message buzzing.checkers.v1.TournamentResult is not mutable"))
+	case "buzzing.checkers.v1.TournamentResult.color":
+		panic(fmt.Errorf("field color of message buzzing.checkers.v1.TournamentResult is not mutable"))
+	case "buzzing.checkers.v1.TournamentResult.points":
+		panic(fmt.Errorf("field points of message buzzing.checkers.v1.TournamentResult is not mutable"))
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		panic(fmt.Errorf("field game_index of message buzzing.checkers.v1.TournamentResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TournamentResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentResult.round":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "buzzing.checkers.v1.TournamentResult.opponent":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.TournamentResult.color":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.TournamentResult.points":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "buzzing.checkers.v1.TournamentResult.game_index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentResult"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TournamentResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.TournamentResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TournamentResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TournamentResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TournamentResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TournamentResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Round != 0 {
+			n += 1 + runtime.Sov(uint64(x.Round))
+		}
+		l = len(x.Opponent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Color)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Points != 0 {
+			n += 1 + runtime.Sov(uint64(x.Points))
+		}
+		l = len(x.GameIndex)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TournamentResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.GameIndex) > 0 {
+			i -= len(x.GameIndex)
+			copy(dAtA[i:], x.GameIndex)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GameIndex)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Points != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Points))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Color) > 0 {
+			i -= len(x.Color)
+			copy(dAtA[i:], x.Color)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Color)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Opponent) > 0 {
+			i -= len(x.Opponent)
+			copy(dAtA[i:], x.Opponent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Opponent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Round != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Round))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TournamentResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TournamentResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TournamentResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				}
+				x.Round = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Round |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Opponent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Opponent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Color = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				}
+				x.Points = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Points |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GameIndex = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TournamentPlayer_6_list)(nil)
+
+type _TournamentPlayer_6_list struct {
+	list *[]*TournamentResult
+}
+
+func (x *_TournamentPlayer_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TournamentPlayer_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TournamentPlayer_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TournamentResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TournamentPlayer_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TournamentResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TournamentPlayer_6_list) AppendMutable() protoreflect.Value {
+	v := new(TournamentResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TournamentPlayer_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TournamentPlayer_6_list) NewElement() protoreflect.Value {
+	v := new(TournamentResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TournamentPlayer_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TournamentPlayer                  protoreflect.MessageDescriptor
+	fd_TournamentPlayer_tournament_id    protoreflect.FieldDescriptor
+	fd_TournamentPlayer_player           protoreflect.FieldDescriptor
+	fd_TournamentPlayer_score            protoreflect.FieldDescriptor
+	fd_TournamentPlayer_buchholz         protoreflect.FieldDescriptor
+	fd_TournamentPlayer_sonneborn_berger protoreflect.FieldDescriptor
+	fd_TournamentPlayer_results          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_TournamentPlayer = File_buzzing_checkers_v1_types_proto.Messages().ByName("TournamentPlayer")
+	fd_TournamentPlayer_tournament_id = md_TournamentPlayer.Fields().ByName("tournament_id")
+	fd_TournamentPlayer_player = md_TournamentPlayer.Fields().ByName("player")
+	fd_TournamentPlayer_score = md_TournamentPlayer.Fields().ByName("score")
+	fd_TournamentPlayer_buchholz = md_TournamentPlayer.Fields().ByName("buchholz")
+	fd_TournamentPlayer_sonneborn_berger = md_TournamentPlayer.Fields().ByName("sonneborn_berger")
+	fd_TournamentPlayer_results = md_TournamentPlayer.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_TournamentPlayer)(nil)
+
+type fastReflection_TournamentPlayer TournamentPlayer
+
+func (x *TournamentPlayer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TournamentPlayer)(x)
+}
+
+func (x *TournamentPlayer) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TournamentPlayer_messageType fastReflection_TournamentPlayer_messageType
+var _ protoreflect.MessageType = fastReflection_TournamentPlayer_messageType{}
+
+type fastReflection_TournamentPlayer_messageType struct{}
+
+func (x fastReflection_TournamentPlayer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TournamentPlayer)(nil)
+}
+func (x fastReflection_TournamentPlayer_messageType) New() protoreflect.Message {
+	return new(fastReflection_TournamentPlayer)
+}
+func (x fastReflection_TournamentPlayer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TournamentPlayer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TournamentPlayer) Descriptor() protoreflect.MessageDescriptor {
+	return md_TournamentPlayer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TournamentPlayer) Type() protoreflect.MessageType {
+	return _fastReflection_TournamentPlayer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TournamentPlayer) New() protoreflect.Message {
+	return new(fastReflection_TournamentPlayer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TournamentPlayer) Interface() protoreflect.ProtoMessage {
+	return (*TournamentPlayer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TournamentPlayer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TournamentId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TournamentId)
+		if !f(fd_TournamentPlayer_tournament_id, value) {
+			return
+		}
+	}
+	if x.Player != "" {
+		value := protoreflect.ValueOfString(x.Player)
+		if !f(fd_TournamentPlayer_player, value) {
+			return
+		}
+	}
+	if x.Score != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Score)
+		if !f(fd_TournamentPlayer_score, value) {
+			return
+		}
+	}
+	if x.Buchholz != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Buchholz)
+		if !f(fd_TournamentPlayer_buchholz, value) {
+			return
+		}
+	}
+	if x.SonnebornBerger != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SonnebornBerger)
+		if !f(fd_TournamentPlayer_sonneborn_berger, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_TournamentPlayer_6_list{list: &x.Results})
+		if !f(fd_TournamentPlayer_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TournamentPlayer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		return x.TournamentId != uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		return x.Player != ""
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		return x.Score != uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		return x.Buchholz != uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		return x.SonnebornBerger != uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentPlayer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		x.TournamentId = uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		x.Player = ""
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		x.Score = uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		x.Buchholz = uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		x.SonnebornBerger = uint64(0)
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TournamentPlayer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		value := x.TournamentId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		value := x.Player
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		value := x.Score
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		value := x.Buchholz
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		value := x.SonnebornBerger
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_TournamentPlayer_6_list{})
+		}
+		listValue := &_TournamentPlayer_6_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentPlayer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		x.TournamentId = value.Uint()
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		x.Player = value.Interface().(string)
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		x.Score = value.Uint()
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		x.Buchholz = value.Uint()
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		x.SonnebornBerger = value.Uint()
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		lv := value.List()
+		clv := lv.(*_TournamentPlayer_6_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentPlayer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		if x.Results == nil {
+			x.Results = []*TournamentResult{}
+		}
+		value := &_TournamentPlayer_6_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		panic(fmt.Errorf("field tournament_id of message buzzing.checkers.v1.TournamentPlayer is not mutable"))
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		panic(fmt.Errorf("field player of message buzzing.checkers.v1.TournamentPlayer is not mutable"))
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		panic(fmt.Errorf("field score of message buzzing.checkers.v1.TournamentPlayer is not mutable"))
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		panic(fmt.Errorf("field buchholz of message buzzing.checkers.v1.TournamentPlayer is not mutable"))
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		panic(fmt.Errorf("field sonneborn_berger of message buzzing.checkers.v1.TournamentPlayer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TournamentPlayer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.TournamentPlayer.tournament_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.TournamentPlayer.player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.TournamentPlayer.score":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.TournamentPlayer.buchholz":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.TournamentPlayer.sonneborn_berger":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.TournamentPlayer.results":
+		list := []*TournamentResult{}
+		return protoreflect.ValueOfList(&_TournamentPlayer_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.TournamentPlayer"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.TournamentPlayer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TournamentPlayer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.TournamentPlayer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TournamentPlayer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TournamentPlayer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TournamentPlayer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TournamentPlayer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TournamentPlayer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TournamentId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TournamentId))
+		}
+		l = len(x.Player)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Score != 0 {
+			n += 1 + runtime.Sov(uint64(x.Score))
+		}
+		if x.Buchholz != 0 {
+			n += 1 + runtime.Sov(uint64(x.Buchholz))
+		}
+		if x.SonnebornBerger != 0 {
+			n += 1 + runtime.Sov(uint64(x.SonnebornBerger))
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TournamentPlayer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.SonnebornBerger != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SonnebornBerger))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Buchholz != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Buchholz))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Score != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Score))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Player) > 0 {
+			i -= len(x.Player)
+			copy(dAtA[i:], x.Player)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Player)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TournamentId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TournamentId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TournamentPlayer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TournamentPlayer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TournamentPlayer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+				}
+				x.TournamentId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TournamentId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Player = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				x.Score = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Score |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buchholz", wireType)
+				}
+				x.Buchholz = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Buchholz |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SonnebornBerger", wireType)
+				}
+				x.SonnebornBerger = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SonnebornBerger |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &TournamentResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Record_7_list)(nil)
+
+type _Record_7_list struct {
+	list *[]string
+}
+
+func (x *_Record_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Record_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Record_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Record_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Record_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Record at list field Tags as it is not of Message kind"))
+}
+
+func (x *_Record_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Record_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Record_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Record           protoreflect.MessageDescriptor
+	fd_Record_id        protoreflect.FieldDescriptor
+	fd_Record_height    protoreflect.FieldDescriptor
+	fd_Record_time      protoreflect.FieldDescriptor
+	fd_Record_source    protoreflect.FieldDescriptor
+	fd_Record_text      protoreflect.FieldDescriptor
+	fd_Record_creator   protoreflect.FieldDescriptor
+	fd_Record_tags      protoreflect.FieldDescriptor
+	fd_Record_prev_hash protoreflect.FieldDescriptor
+	fd_Record_hash      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_Record = File_buzzing_checkers_v1_types_proto.Messages().ByName("Record")
+	fd_Record_id = md_Record.Fields().ByName("id")
+	fd_Record_height = md_Record.Fields().ByName("height")
+	fd_Record_time = md_Record.Fields().ByName("time")
+	fd_Record_source = md_Record.Fields().ByName("source")
+	fd_Record_text = md_Record.Fields().ByName("text")
+	fd_Record_creator = md_Record.Fields().ByName("creator")
+	fd_Record_tags = md_Record.Fields().ByName("tags")
+	fd_Record_prev_hash = md_Record.Fields().ByName("prev_hash")
+	fd_Record_hash = md_Record.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_Record)(nil)
+
+type fastReflection_Record Record
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Record)(x)
+}
+
+func (x *Record) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Record_messageType fastReflection_Record_messageType
+var _ protoreflect.MessageType = fastReflection_Record_messageType{}
+
+type fastReflection_Record_messageType struct{}
+
+func (x fastReflection_Record_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Record)(nil)
+}
+func (x fastReflection_Record_messageType) New() protoreflect.Message {
+	return new(fastReflection_Record)
+}
+func (x fastReflection_Record_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Record) Descriptor() protoreflect.MessageDescriptor {
+	return md_Record
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Record) Type() protoreflect.MessageType {
+	return _fastReflection_Record_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Record) New() protoreflect.Message {
+	return new(fastReflection_Record)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Record) Interface() protoreflect.ProtoMessage {
+	return (*Record)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Record) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Record_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_Record_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_Record_time, value) {
+			return
+		}
+	}
+	if x.Source != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Source))
+		if !f(fd_Record_source, value) {
+			return
+		}
+	}
+	if x.Text != "" {
+		value := protoreflect.ValueOfString(x.Text)
+		if !f(fd_Record_text, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_Record_creator, value) {
+			return
+		}
+	}
+	if len(x.Tags) != 0 {
+		value := protoreflect.ValueOfList(&_Record_7_list{list: &x.Tags})
+		if !f(fd_Record_tags, value) {
+			return
+		}
+	}
+	if len(x.PrevHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.PrevHash)
+		if !f(fd_Record_prev_hash, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_Record_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Record) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Record.id":
+		return x.Id != uint64(0)
+	case "buzzing.checkers.v1.Record.height":
+		return x.Height != int64(0)
+	case "buzzing.checkers.v1.Record.time":
+		return x.Time != nil
+	case "buzzing.checkers.v1.Record.source":
+		return x.Source != 0
+	case "buzzing.checkers.v1.Record.text":
+		return x.Text != ""
+	case "buzzing.checkers.v1.Record.creator":
+		return x.Creator != ""
+	case "buzzing.checkers.v1.Record.tags":
+		return len(x.Tags) != 0
+	case "buzzing.checkers.v1.Record.prev_hash":
+		return len(x.PrevHash) != 0
+	case "buzzing.checkers.v1.Record.hash":
+		return len(x.Hash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Record.id":
+		x.Id = uint64(0)
+	case "buzzing.checkers.v1.Record.height":
+		x.Height = int64(0)
+	case "buzzing.checkers.v1.Record.time":
+		x.Time = nil
+	case "buzzing.checkers.v1.Record.source":
+		x.Source = 0
+	case "buzzing.checkers.v1.Record.text":
+		x.Text = ""
+	case "buzzing.checkers.v1.Record.creator":
+		x.Creator = ""
+	case "buzzing.checkers.v1.Record.tags":
+		x.Tags = nil
+	case "buzzing.checkers.v1.Record.prev_hash":
+		x.PrevHash = nil
+	case "buzzing.checkers.v1.Record.hash":
+		x.Hash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Record) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.Record.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.Record.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.Record.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.Record.source":
+		value := x.Source
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.Record.text":
+		value := x.Text
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.Record.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.Record.tags":
+		if len(x.Tags) == 0 {
+			return protoreflect.ValueOfList(&_Record_7_list{})
+		}
+		listValue := &_Record_7_list{list: &x.Tags}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.Record.prev_hash":
+		value := x.PrevHash
+		return protoreflect.ValueOfBytes(value)
+	case "buzzing.checkers.v1.Record.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Record.id":
+		x.Id = value.Uint()
+	case "buzzing.checkers.v1.Record.height":
+		x.Height = value.Int()
+	case "buzzing.checkers.v1.Record.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "buzzing.checkers.v1.Record.source":
+		x.Source = (RecordSource)(value.Enum())
+	case "buzzing.checkers.v1.Record.text":
+		x.Text = value.Interface().(string)
+	case "buzzing.checkers.v1.Record.creator":
+		x.Creator = value.Interface().(string)
+	case "buzzing.checkers.v1.Record.tags":
+		lv := value.List()
+		clv := lv.(*_Record_7_list)
+		x.Tags = *clv.list
+	case "buzzing.checkers.v1.Record.prev_hash":
+		x.PrevHash = value.Bytes()
+	case "buzzing.checkers.v1.Record.hash":
+		x.Hash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Record.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "buzzing.checkers.v1.Record.tags":
+		if x.Tags == nil {
+			x.Tags = []string{}
+		}
+		value := &_Record_7_list{list: &x.Tags}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.Record.id":
+		panic(fmt.Errorf("field id of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.height":
+		panic(fmt.Errorf("field height of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.source":
+		panic(fmt.Errorf("field source of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.text":
+		panic(fmt.Errorf("field text of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.creator":
+		panic(fmt.Errorf("field creator of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.prev_hash":
+		panic(fmt.Errorf("field prev_hash of message buzzing.checkers.v1.Record is not mutable"))
+	case "buzzing.checkers.v1.Record.hash":
+		panic(fmt.Errorf("field hash of message buzzing.checkers.v1.Record is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Record) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.Record.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.Record.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.Record.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.Record.source":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.Record.text":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.Record.creator":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.Record.tags":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Record_7_list{list: &list})
+	case "buzzing.checkers.v1.Record.prev_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "buzzing.checkers.v1.Record.hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.Record"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.Record does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Record) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.Record", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Record) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Record) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Record) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Record)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Source != 0 {
+			n += 1 + runtime.Sov(uint64(x.Source))
+		}
+		l = len(x.Text)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Tags) > 0 {
+			for _, s := range x.Tags {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PrevHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Record)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.PrevHash) > 0 {
+			i -= len(x.PrevHash)
+			copy(dAtA[i:], x.PrevHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PrevHash)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Tags) > 0 {
+			for iNdEx := len(x.Tags) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tags[iNdEx])
+				copy(dAtA[i:], x.Tags[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tags[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Text) > 0 {
+			i -= len(x.Text)
+			copy(dAtA[i:], x.Text)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Text)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Source != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Source))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Record)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				x.Source = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Source |= RecordSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Text = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tags = append(x.Tags, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PrevHash = append(x.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.PrevHash == nil {
+					x.PrevHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RecordLogHead      protoreflect.MessageDescriptor
+	fd_RecordLogHead_id   protoreflect.FieldDescriptor
+	fd_RecordLogHead_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_RecordLogHead = File_buzzing_checkers_v1_types_proto.Messages().ByName("RecordLogHead")
+	fd_RecordLogHead_id = md_RecordLogHead.Fields().ByName("id")
+	fd_RecordLogHead_hash = md_RecordLogHead.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_RecordLogHead)(nil)
+
+type fastReflection_RecordLogHead RecordLogHead
+
+func (x *RecordLogHead) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordLogHead)(x)
+}
+
+func (x *RecordLogHead) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RecordLogHead_messageType fastReflection_RecordLogHead_messageType
+var _ protoreflect.MessageType = fastReflection_RecordLogHead_messageType{}
+
+type fastReflection_RecordLogHead_messageType struct{}
+
+func (x fastReflection_RecordLogHead_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordLogHead)(nil)
+}
+func (x fastReflection_RecordLogHead_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordLogHead)
+}
+func (x fastReflection_RecordLogHead_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordLogHead
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RecordLogHead) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordLogHead
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RecordLogHead) Type() protoreflect.MessageType {
+	return _fastReflection_RecordLogHead_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RecordLogHead) New() protoreflect.Message {
+	return new(fastReflection_RecordLogHead)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RecordLogHead) Interface() protoreflect.ProtoMessage {
+	return (*RecordLogHead)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RecordLogHead) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_RecordLogHead_id, value) {
+			return
+		}
+	}
+	if len(x.Hash) != 0 {
+		value := protoreflect.ValueOfBytes(x.Hash)
+		if !f(fd_RecordLogHead_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RecordLogHead) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		return x.Id != uint64(0)
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		return len(x.Hash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordLogHead) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		x.Id = uint64(0)
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		x.Hash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RecordLogHead) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		value := x.Hash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordLogHead) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		x.Id = value.Uint()
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		x.Hash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordLogHead) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		panic(fmt.Errorf("field id of message buzzing.checkers.v1.RecordLogHead is not mutable"))
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		panic(fmt.Errorf("field hash of message buzzing.checkers.v1.RecordLogHead is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RecordLogHead) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordLogHead.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.RecordLogHead.hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordLogHead"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordLogHead does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RecordLogHead) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordLogHead", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RecordLogHead) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordLogHead) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RecordLogHead) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RecordLogHead) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RecordLogHead)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RecordLogHead)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RecordLogHead)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordLogHead: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordLogHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = append(x.Hash[:0], dAtA[iNdEx:postIndex]...)
+				if x.Hash == nil {
+					x.Hash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RecordRateCounter              protoreflect.MessageDescriptor
+	fd_RecordRateCounter_author       protoreflect.FieldDescriptor
+	fd_RecordRateCounter_window_start protoreflect.FieldDescriptor
+	fd_RecordRateCounter_count        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_RecordRateCounter = File_buzzing_checkers_v1_types_proto.Messages().ByName("RecordRateCounter")
+	fd_RecordRateCounter_author = md_RecordRateCounter.Fields().ByName("author")
+	fd_RecordRateCounter_window_start = md_RecordRateCounter.Fields().ByName("window_start")
+	fd_RecordRateCounter_count = md_RecordRateCounter.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_RecordRateCounter)(nil)
+
+type fastReflection_RecordRateCounter RecordRateCounter
+
+func (x *RecordRateCounter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordRateCounter)(x)
+}
+
+func (x *RecordRateCounter) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RecordRateCounter_messageType fastReflection_RecordRateCounter_messageType
+var _ protoreflect.MessageType = fastReflection_RecordRateCounter_messageType{}
+
+type fastReflection_RecordRateCounter_messageType struct{}
+
+func (x fastReflection_RecordRateCounter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordRateCounter)(nil)
+}
+func (x fastReflection_RecordRateCounter_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordRateCounter)
+}
+func (x fastReflection_RecordRateCounter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordRateCounter
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RecordRateCounter) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordRateCounter
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RecordRateCounter) Type() protoreflect.MessageType {
+	return _fastReflection_RecordRateCounter_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RecordRateCounter) New() protoreflect.Message {
+	return new(fastReflection_RecordRateCounter)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RecordRateCounter) Interface() protoreflect.ProtoMessage {
+	return (*RecordRateCounter)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RecordRateCounter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Author != "" {
+		value := protoreflect.ValueOfString(x.Author)
+		if !f(fd_RecordRateCounter_author, value) {
+			return
+		}
+	}
+	if x.WindowStart != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowStart)
+		if !f(fd_RecordRateCounter_window_start, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_RecordRateCounter_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RecordRateCounter) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		return x.Author != ""
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		return x.WindowStart != int64(0)
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		return x.Count != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordRateCounter) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		x.Author = ""
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		x.WindowStart = int64(0)
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		x.Count = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RecordRateCounter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		value := x.Author
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		value := x.WindowStart
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordRateCounter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		x.Author = value.Interface().(string)
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		x.WindowStart = value.Int()
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		x.Count = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordRateCounter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		panic(fmt.Errorf("field author of message buzzing.checkers.v1.RecordRateCounter is not mutable"))
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		panic(fmt.Errorf("field window_start of message buzzing.checkers.v1.RecordRateCounter is not mutable"))
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		panic(fmt.Errorf("field count of message buzzing.checkers.v1.RecordRateCounter is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RecordRateCounter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RecordRateCounter.author":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RecordRateCounter.window_start":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.RecordRateCounter.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RecordRateCounter"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RecordRateCounter does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RecordRateCounter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RecordRateCounter", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RecordRateCounter) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RecordRateCounter) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RecordRateCounter) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RecordRateCounter) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RecordRateCounter)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Author)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowStart != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowStart))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RecordRateCounter)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowStart != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowStart))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Author) > 0 {
+			i -= len(x.Author)
+			copy(dAtA[i:], x.Author)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Author)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RecordRateCounter)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordRateCounter: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordRateCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Author = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+				}
+				x.WindowStart = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowStart |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RemoteRecord                protoreflect.MessageDescriptor
+	fd_RemoteRecord_channel        protoreflect.FieldDescriptor
+	fd_RemoteRecord_sequence       protoreflect.FieldDescriptor
+	fd_RemoteRecord_source_port    protoreflect.FieldDescriptor
+	fd_RemoteRecord_source_channel protoreflect.FieldDescriptor
+	fd_RemoteRecord_sender         protoreflect.FieldDescriptor
+	fd_RemoteRecord_record_data    protoreflect.FieldDescriptor
+	fd_RemoteRecord_height         protoreflect.FieldDescriptor
+	fd_RemoteRecord_time           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_RemoteRecord = File_buzzing_checkers_v1_types_proto.Messages().ByName("RemoteRecord")
+	fd_RemoteRecord_channel = md_RemoteRecord.Fields().ByName("channel")
+	fd_RemoteRecord_sequence = md_RemoteRecord.Fields().ByName("sequence")
+	fd_RemoteRecord_source_port = md_RemoteRecord.Fields().ByName("source_port")
+	fd_RemoteRecord_source_channel = md_RemoteRecord.Fields().ByName("source_channel")
+	fd_RemoteRecord_sender = md_RemoteRecord.Fields().ByName("sender")
+	fd_RemoteRecord_record_data = md_RemoteRecord.Fields().ByName("record_data")
+	fd_RemoteRecord_height = md_RemoteRecord.Fields().ByName("height")
+	fd_RemoteRecord_time = md_RemoteRecord.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_RemoteRecord)(nil)
+
+type fastReflection_RemoteRecord RemoteRecord
+
+func (x *RemoteRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RemoteRecord)(x)
+}
+
+func (x *RemoteRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RemoteRecord_messageType fastReflection_RemoteRecord_messageType
+var _ protoreflect.MessageType = fastReflection_RemoteRecord_messageType{}
+
+type fastReflection_RemoteRecord_messageType struct{}
+
+func (x fastReflection_RemoteRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RemoteRecord)(nil)
+}
+func (x fastReflection_RemoteRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_RemoteRecord)
+}
+func (x fastReflection_RemoteRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RemoteRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RemoteRecord) Type() protoreflect.MessageType {
+	return _fastReflection_RemoteRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RemoteRecord) New() protoreflect.Message {
+	return new(fastReflection_RemoteRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RemoteRecord) Interface() protoreflect.ProtoMessage {
+	return (*RemoteRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RemoteRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_RemoteRecord_channel, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_RemoteRecord_sequence, value) {
+			return
+		}
+	}
+	if x.SourcePort != "" {
+		value := protoreflect.ValueOfString(x.SourcePort)
+		if !f(fd_RemoteRecord_source_port, value) {
+			return
+		}
+	}
+	if x.SourceChannel != "" {
+		value := protoreflect.ValueOfString(x.SourceChannel)
+		if !f(fd_RemoteRecord_source_channel, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_RemoteRecord_sender, value) {
+			return
+		}
+	}
+	if x.RecordData != "" {
+		value := protoreflect.ValueOfString(x.RecordData)
+		if !f(fd_RemoteRecord_record_data, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_RemoteRecord_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_RemoteRecord_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RemoteRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		return x.Channel != ""
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		return x.Sequence != uint64(0)
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		return x.SourcePort != ""
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		return x.SourceChannel != ""
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		return x.Sender != ""
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		return x.RecordData != ""
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		return x.Height != int64(0)
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		return x.Time != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		x.Channel = ""
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		x.Sequence = uint64(0)
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		x.SourcePort = ""
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		x.SourceChannel = ""
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		x.Sender = ""
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		x.RecordData = ""
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		x.Height = int64(0)
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		x.Time = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RemoteRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		value := x.SourcePort
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		value := x.SourceChannel
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		value := x.RecordData
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		x.Channel = value.Interface().(string)
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		x.Sequence = value.Uint()
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		x.SourcePort = value.Interface().(string)
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		x.SourceChannel = value.Interface().(string)
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		x.Sender = value.Interface().(string)
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		x.RecordData = value.Interface().(string)
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		x.Height = value.Int()
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		panic(fmt.Errorf("field channel of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		panic(fmt.Errorf("field sequence of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		panic(fmt.Errorf("field source_port of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		panic(fmt.Errorf("field source_channel of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		panic(fmt.Errorf("field sender of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		panic(fmt.Errorf("field record_data of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		panic(fmt.Errorf("field height of message buzzing.checkers.v1.RemoteRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RemoteRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.RemoteRecord.channel":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RemoteRecord.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.RemoteRecord.source_port":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RemoteRecord.source_channel":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RemoteRecord.sender":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RemoteRecord.record_data":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.RemoteRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.RemoteRecord.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.RemoteRecord"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.RemoteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RemoteRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.RemoteRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RemoteRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RemoteRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RemoteRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RemoteRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.SourcePort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RecordData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.RecordData) > 0 {
+			i -= len(x.RecordData)
+			copy(dAtA[i:], x.RecordData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordData)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SourceChannel) > 0 {
+			i -= len(x.SourceChannel)
+			copy(dAtA[i:], x.SourceChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceChannel)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SourcePort) > 0 {
+			i -= len(x.SourcePort)
+			copy(dAtA[i:], x.SourcePort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourcePort)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourcePort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordData = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OutgoingPacket                         protoreflect.MessageDescriptor
+	fd_OutgoingPacket_port                    protoreflect.FieldDescriptor
+	fd_OutgoingPacket_channel                 protoreflect.FieldDescriptor
+	fd_OutgoingPacket_sequence                protoreflect.FieldDescriptor
+	fd_OutgoingPacket_sender                  protoreflect.FieldDescriptor
+	fd_OutgoingPacket_sent_height             protoreflect.FieldDescriptor
+	fd_OutgoingPacket_timeout_revision_number protoreflect.FieldDescriptor
+	fd_OutgoingPacket_timeout_revision_height protoreflect.FieldDescriptor
+	fd_OutgoingPacket_timeout_timestamp       protoreflect.FieldDescriptor
+	fd_OutgoingPacket_status                  protoreflect.FieldDescriptor
+	fd_OutgoingPacket_result                  protoreflect.FieldDescriptor
+	fd_OutgoingPacket_resolved_height         protoreflect.FieldDescriptor
+	fd_OutgoingPacket_data                    protoreflect.FieldDescriptor
+	fd_OutgoingPacket_escrowed_fee            protoreflect.FieldDescriptor
+	fd_OutgoingPacket_fee_destination         protoreflect.FieldDescriptor
+	fd_OutgoingPacket_retry_sequence          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_OutgoingPacket = File_buzzing_checkers_v1_types_proto.Messages().ByName("OutgoingPacket")
+	fd_OutgoingPacket_port = md_OutgoingPacket.Fields().ByName("port")
+	fd_OutgoingPacket_channel = md_OutgoingPacket.Fields().ByName("channel")
+	fd_OutgoingPacket_sequence = md_OutgoingPacket.Fields().ByName("sequence")
+	fd_OutgoingPacket_sender = md_OutgoingPacket.Fields().ByName("sender")
+	fd_OutgoingPacket_sent_height = md_OutgoingPacket.Fields().ByName("sent_height")
+	fd_OutgoingPacket_timeout_revision_number = md_OutgoingPacket.Fields().ByName("timeout_revision_number")
+	fd_OutgoingPacket_timeout_revision_height = md_OutgoingPacket.Fields().ByName("timeout_revision_height")
+	fd_OutgoingPacket_timeout_timestamp = md_OutgoingPacket.Fields().ByName("timeout_timestamp")
+	fd_OutgoingPacket_status = md_OutgoingPacket.Fields().ByName("status")
+	fd_OutgoingPacket_result = md_OutgoingPacket.Fields().ByName("result")
+	fd_OutgoingPacket_resolved_height = md_OutgoingPacket.Fields().ByName("resolved_height")
+	fd_OutgoingPacket_data = md_OutgoingPacket.Fields().ByName("data")
+	fd_OutgoingPacket_escrowed_fee = md_OutgoingPacket.Fields().ByName("escrowed_fee")
+	fd_OutgoingPacket_fee_destination = md_OutgoingPacket.Fields().ByName("fee_destination")
+	fd_OutgoingPacket_retry_sequence = md_OutgoingPacket.Fields().ByName("retry_sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_OutgoingPacket)(nil)
+
+type fastReflection_OutgoingPacket OutgoingPacket
+
+func (x *OutgoingPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OutgoingPacket)(x)
+}
+
+func (x *OutgoingPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OutgoingPacket_messageType fastReflection_OutgoingPacket_messageType
+var _ protoreflect.MessageType = fastReflection_OutgoingPacket_messageType{}
+
+type fastReflection_OutgoingPacket_messageType struct{}
+
+func (x fastReflection_OutgoingPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OutgoingPacket)(nil)
+}
+func (x fastReflection_OutgoingPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_OutgoingPacket)
+}
+func (x fastReflection_OutgoingPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutgoingPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OutgoingPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_OutgoingPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OutgoingPacket) Type() protoreflect.MessageType {
+	return _fastReflection_OutgoingPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OutgoingPacket) New() protoreflect.Message {
+	return new(fastReflection_OutgoingPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OutgoingPacket) Interface() protoreflect.ProtoMessage {
+	return (*OutgoingPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OutgoingPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Port != "" {
+		value := protoreflect.ValueOfString(x.Port)
+		if !f(fd_OutgoingPacket_port, value) {
+			return
+		}
+	}
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_OutgoingPacket_channel, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_OutgoingPacket_sequence, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_OutgoingPacket_sender, value) {
+			return
+		}
+	}
+	if x.SentHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SentHeight)
+		if !f(fd_OutgoingPacket_sent_height, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionNumber)
+		if !f(fd_OutgoingPacket_timeout_revision_number, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionHeight)
+		if !f(fd_OutgoingPacket_timeout_revision_height, value) {
+			return
+		}
+	}
+	if x.TimeoutTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutTimestamp)
+		if !f(fd_OutgoingPacket_timeout_timestamp, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_OutgoingPacket_status, value) {
+			return
+		}
+	}
+	if x.Result != "" {
+		value := protoreflect.ValueOfString(x.Result)
+		if !f(fd_OutgoingPacket_result, value) {
+			return
+		}
+	}
+	if x.ResolvedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolvedHeight)
+		if !f(fd_OutgoingPacket_resolved_height, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_OutgoingPacket_data, value) {
+			return
+		}
+	}
+	if x.EscrowedFee != nil {
+		value := protoreflect.ValueOfMessage(x.EscrowedFee.ProtoReflect())
+		if !f(fd_OutgoingPacket_escrowed_fee, value) {
+			return
+		}
+	}
+	if x.FeeDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FeeDestination))
+		if !f(fd_OutgoingPacket_fee_destination, value) {
+			return
+		}
+	}
+	if x.RetrySequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetrySequence)
+		if !f(fd_OutgoingPacket_retry_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OutgoingPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		return x.Port != ""
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		return x.Channel != ""
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		return x.Sender != ""
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		return x.SentHeight != int64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		return x.TimeoutRevisionNumber != uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		return x.TimeoutRevisionHeight != uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		return x.TimeoutTimestamp != uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		return x.Status != 0
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		return x.Result != ""
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		return x.ResolvedHeight != int64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		return len(x.Data) != 0
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		return x.EscrowedFee != nil
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		return x.FeeDestination != 0
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		return x.RetrySequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutgoingPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		x.Port = ""
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		x.Channel = ""
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		x.Sequence = uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		x.Sender = ""
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		x.SentHeight = int64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		x.TimeoutTimestamp = uint64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		x.Status = 0
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		x.Result = ""
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		x.ResolvedHeight = int64(0)
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		x.Data = nil
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		x.EscrowedFee = nil
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		x.FeeDestination = 0
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		x.RetrySequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OutgoingPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		value := x.Port
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		value := x.SentHeight
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		value := x.TimeoutRevisionNumber
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		value := x.TimeoutRevisionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		value := x.TimeoutTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		value := x.Result
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		value := x.ResolvedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		value := x.EscrowedFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		value := x.FeeDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		value := x.RetrySequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutgoingPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		x.Port = value.Interface().(string)
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		x.Channel = value.Interface().(string)
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		x.Sequence = value.Uint()
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		x.Sender = value.Interface().(string)
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		x.SentHeight = value.Int()
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		x.TimeoutRevisionNumber = value.Uint()
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		x.TimeoutRevisionHeight = value.Uint()
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		x.TimeoutTimestamp = value.Uint()
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		x.Status = (OutgoingPacketStatus)(value.Enum())
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		x.Result = value.Interface().(string)
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		x.ResolvedHeight = value.Int()
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		x.Data = value.Bytes()
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		x.EscrowedFee = value.Message().Interface().(*v1beta1.Coin)
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		x.FeeDestination = (RecordFeeDestination)(value.Enum())
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		x.RetrySequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutgoingPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		if x.EscrowedFee == nil {
+			x.EscrowedFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.EscrowedFee.ProtoReflect())
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		panic(fmt.Errorf("field port of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		panic(fmt.Errorf("field channel of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		panic(fmt.Errorf("field sequence of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		panic(fmt.Errorf("field sender of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		panic(fmt.Errorf("field sent_height of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		panic(fmt.Errorf("field timeout_revision_number of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		panic(fmt.Errorf("field timeout_revision_height of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		panic(fmt.Errorf("field timeout_timestamp of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		panic(fmt.Errorf("field status of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		panic(fmt.Errorf("field result of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		panic(fmt.Errorf("field resolved_height of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		panic(fmt.Errorf("field data of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		panic(fmt.Errorf("field fee_destination of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		panic(fmt.Errorf("field retry_sequence of message buzzing.checkers.v1.OutgoingPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OutgoingPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.OutgoingPacket.port":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.OutgoingPacket.channel":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.OutgoingPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.sender":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.OutgoingPacket.sent_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_revision_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.timeout_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.status":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.OutgoingPacket.result":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.OutgoingPacket.resolved_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.OutgoingPacket.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "buzzing.checkers.v1.OutgoingPacket.escrowed_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.OutgoingPacket.fee_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "buzzing.checkers.v1.OutgoingPacket.retry_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.OutgoingPacket"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.OutgoingPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OutgoingPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.OutgoingPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OutgoingPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OutgoingPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OutgoingPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OutgoingPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OutgoingPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Port)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SentHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SentHeight))
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionNumber))
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionHeight))
+		}
+		if x.TimeoutTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutTimestamp))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ResolvedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolvedHeight))
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowedFee != nil {
+			l = options.Size(x.EscrowedFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeDestination))
+		}
+		if x.RetrySequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.RetrySequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OutgoingPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetrySequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetrySequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.FeeDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeDestination))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.EscrowedFee != nil {
+			encoded, err := options.Marshal(x.EscrowedFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.ResolvedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolvedHeight))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.TimeoutTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutTimestamp))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionNumber))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SentHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SentHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Port) > 0 {
+			i -= len(x.Port)
+			copy(dAtA[i:], x.Port)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Port)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OutgoingPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutgoingPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OutgoingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Port = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+				}
+				x.SentHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SentHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+				}
+				x.TimeoutRevisionNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+				}
+				x.TimeoutRevisionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				}
+				x.TimeoutTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= OutgoingPacketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+				}
+				x.ResolvedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolvedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EscrowedFee == nil {
+					x.EscrowedFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowedFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+				}
+				x.FeeDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeDestination |= RecordFeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetrySequence", wireType)
+				}
+				x.RetrySequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetrySequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_IbcChannel_5_list)(nil)
+
+type _IbcChannel_5_list struct {
+	list *[]string
+}
+
+func (x *_IbcChannel_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_IbcChannel_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_IbcChannel_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_IbcChannel_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_IbcChannel_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message IbcChannel at list field ConnectionHops as it is not of Message kind"))
+}
+
+func (x *_IbcChannel_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_IbcChannel_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_IbcChannel_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_IbcChannel                         protoreflect.MessageDescriptor
+	fd_IbcChannel_channel_id              protoreflect.FieldDescriptor
+	fd_IbcChannel_port_id                 protoreflect.FieldDescriptor
+	fd_IbcChannel_counterparty_port_id    protoreflect.FieldDescriptor
+	fd_IbcChannel_counterparty_channel_id protoreflect.FieldDescriptor
+	fd_IbcChannel_connection_hops         protoreflect.FieldDescriptor
+	fd_IbcChannel_version                 protoreflect.FieldDescriptor
+	fd_IbcChannel_opened_height           protoreflect.FieldDescriptor
+	fd_IbcChannel_packets_sent            protoreflect.FieldDescriptor
+	fd_IbcChannel_packets_received        protoreflect.FieldDescriptor
+	fd_IbcChannel_packets_failed          protoreflect.FieldDescriptor
+	fd_IbcChannel_closed                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_IbcChannel = File_buzzing_checkers_v1_types_proto.Messages().ByName("IbcChannel")
+	fd_IbcChannel_channel_id = md_IbcChannel.Fields().ByName("channel_id")
+	fd_IbcChannel_port_id = md_IbcChannel.Fields().ByName("port_id")
+	fd_IbcChannel_counterparty_port_id = md_IbcChannel.Fields().ByName("counterparty_port_id")
+	fd_IbcChannel_counterparty_channel_id = md_IbcChannel.Fields().ByName("counterparty_channel_id")
+	fd_IbcChannel_connection_hops = md_IbcChannel.Fields().ByName("connection_hops")
+	fd_IbcChannel_version = md_IbcChannel.Fields().ByName("version")
+	fd_IbcChannel_opened_height = md_IbcChannel.Fields().ByName("opened_height")
+	fd_IbcChannel_packets_sent = md_IbcChannel.Fields().ByName("packets_sent")
+	fd_IbcChannel_packets_received = md_IbcChannel.Fields().ByName("packets_received")
+	fd_IbcChannel_packets_failed = md_IbcChannel.Fields().ByName("packets_failed")
+	fd_IbcChannel_closed = md_IbcChannel.Fields().ByName("closed")
+}
+
+var _ protoreflect.Message = (*fastReflection_IbcChannel)(nil)
+
+type fastReflection_IbcChannel IbcChannel
+
+func (x *IbcChannel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IbcChannel)(x)
+}
+
+func (x *IbcChannel) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IbcChannel_messageType fastReflection_IbcChannel_messageType
+var _ protoreflect.MessageType = fastReflection_IbcChannel_messageType{}
+
+type fastReflection_IbcChannel_messageType struct{}
+
+func (x fastReflection_IbcChannel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IbcChannel)(nil)
+}
+func (x fastReflection_IbcChannel_messageType) New() protoreflect.Message {
+	return new(fastReflection_IbcChannel)
+}
+func (x fastReflection_IbcChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcChannel
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IbcChannel) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcChannel
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IbcChannel) Type() protoreflect.MessageType {
+	return _fastReflection_IbcChannel_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IbcChannel) New() protoreflect.Message {
+	return new(fastReflection_IbcChannel)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IbcChannel) Interface() protoreflect.ProtoMessage {
+	return (*IbcChannel)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IbcChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IbcChannel_channel_id, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_IbcChannel_port_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyPortId != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyPortId)
+		if !f(fd_IbcChannel_counterparty_port_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyChannelId != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyChannelId)
+		if !f(fd_IbcChannel_counterparty_channel_id, value) {
+			return
+		}
+	}
+	if len(x.ConnectionHops) != 0 {
+		value := protoreflect.ValueOfList(&_IbcChannel_5_list{list: &x.ConnectionHops})
+		if !f(fd_IbcChannel_connection_hops, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_IbcChannel_version, value) {
+			return
+		}
+	}
+	if x.OpenedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OpenedHeight)
+		if !f(fd_IbcChannel_opened_height, value) {
+			return
+		}
+	}
+	if x.PacketsSent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketsSent)
+		if !f(fd_IbcChannel_packets_sent, value) {
+			return
+		}
+	}
+	if x.PacketsReceived != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketsReceived)
+		if !f(fd_IbcChannel_packets_received, value) {
+			return
+		}
+	}
+	if x.PacketsFailed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketsFailed)
+		if !f(fd_IbcChannel_packets_failed, value) {
+			return
+		}
+	}
+	if x.Closed != false {
+		value := protoreflect.ValueOfBool(x.Closed)
+		if !f(fd_IbcChannel_closed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IbcChannel) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		return x.ChannelId != ""
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		return x.PortId != ""
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		return x.CounterpartyPortId != ""
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		return x.CounterpartyChannelId != ""
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		return len(x.ConnectionHops) != 0
+	case "buzzing.checkers.v1.IbcChannel.version":
+		return x.Version != ""
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		return x.OpenedHeight != int64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		return x.PacketsSent != uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		return x.PacketsReceived != uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		return x.PacketsFailed != uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		return x.Closed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcChannel) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		x.ChannelId = ""
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		x.PortId = ""
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		x.CounterpartyPortId = ""
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		x.CounterpartyChannelId = ""
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		x.ConnectionHops = nil
+	case "buzzing.checkers.v1.IbcChannel.version":
+		x.Version = ""
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		x.OpenedHeight = int64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		x.PacketsSent = uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		x.PacketsReceived = uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		x.PacketsFailed = uint64(0)
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		x.Closed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IbcChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		value := x.CounterpartyPortId
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		value := x.CounterpartyChannelId
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		if len(x.ConnectionHops) == 0 {
+			return protoreflect.ValueOfList(&_IbcChannel_5_list{})
+		}
+		listValue := &_IbcChannel_5_list{list: &x.ConnectionHops}
+		return protoreflect.ValueOfList(listValue)
+	case "buzzing.checkers.v1.IbcChannel.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		value := x.OpenedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		value := x.PacketsSent
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		value := x.PacketsReceived
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		value := x.PacketsFailed
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		value := x.Closed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		x.PortId = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		x.CounterpartyPortId = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		x.CounterpartyChannelId = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		lv := value.List()
+		clv := lv.(*_IbcChannel_5_list)
+		x.ConnectionHops = *clv.list
+	case "buzzing.checkers.v1.IbcChannel.version":
+		x.Version = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		x.OpenedHeight = value.Int()
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		x.PacketsSent = value.Uint()
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		x.PacketsReceived = value.Uint()
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		x.PacketsFailed = value.Uint()
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		x.Closed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		if x.ConnectionHops == nil {
+			x.ConnectionHops = []string{}
+		}
+		value := &_IbcChannel_5_list{list: &x.ConnectionHops}
+		return protoreflect.ValueOfList(value)
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		panic(fmt.Errorf("field channel_id of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		panic(fmt.Errorf("field port_id of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		panic(fmt.Errorf("field counterparty_port_id of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		panic(fmt.Errorf("field counterparty_channel_id of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.version":
+		panic(fmt.Errorf("field version of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		panic(fmt.Errorf("field opened_height of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		panic(fmt.Errorf("field packets_sent of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		panic(fmt.Errorf("field packets_received of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		panic(fmt.Errorf("field packets_failed of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		panic(fmt.Errorf("field closed of message buzzing.checkers.v1.IbcChannel is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IbcChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcChannel.channel_id":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcChannel.port_id":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcChannel.counterparty_port_id":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcChannel.counterparty_channel_id":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcChannel.connection_hops":
+		list := []string{}
+		return protoreflect.ValueOfList(&_IbcChannel_5_list{list: &list})
+	case "buzzing.checkers.v1.IbcChannel.version":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcChannel.opened_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "buzzing.checkers.v1.IbcChannel.packets_sent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcChannel.packets_received":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcChannel.packets_failed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcChannel.closed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcChannel"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcChannel does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IbcChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.IbcChannel", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IbcChannel) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcChannel) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IbcChannel) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IbcChannel) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IbcChannel)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterpartyPortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterpartyChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ConnectionHops) > 0 {
+			for _, s := range x.ConnectionHops {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OpenedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.OpenedHeight))
+		}
+		if x.PacketsSent != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketsSent))
+		}
+		if x.PacketsReceived != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketsReceived))
+		}
+		if x.PacketsFailed != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketsFailed))
+		}
+		if x.Closed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IbcChannel)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Closed {
+			i--
+			if x.Closed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.PacketsFailed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketsFailed))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.PacketsReceived != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketsReceived))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PacketsSent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketsSent))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.OpenedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpenedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ConnectionHops) > 0 {
+			for iNdEx := len(x.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ConnectionHops[iNdEx])
+				copy(dAtA[i:], x.ConnectionHops[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionHops[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CounterpartyChannelId) > 0 {
+			i -= len(x.CounterpartyChannelId)
+			copy(dAtA[i:], x.CounterpartyChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyChannelId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CounterpartyPortId) > 0 {
+			i -= len(x.CounterpartyPortId)
+			copy(dAtA[i:], x.CounterpartyPortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyPortId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IbcChannel)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcChannel: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyPortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionHops = append(x.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+				}
+				x.OpenedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OpenedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketsSent", wireType)
+				}
+				x.PacketsSent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketsSent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketsReceived", wireType)
+				}
+				x.PacketsReceived = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketsReceived |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketsFailed", wireType)
+				}
+				x.PacketsFailed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketsFailed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Closed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IbcGame                  protoreflect.MessageDescriptor
+	fd_IbcGame_channel          protoreflect.FieldDescriptor
+	fd_IbcGame_id               protoreflect.FieldDescriptor
+	fd_IbcGame_remote_id        protoreflect.FieldDescriptor
+	fd_IbcGame_local_player     protoreflect.FieldDescriptor
+	fd_IbcGame_remote_player    protoreflect.FieldDescriptor
+	fd_IbcGame_local_color      protoreflect.FieldDescriptor
+	fd_IbcGame_game             protoreflect.FieldDescriptor
+	fd_IbcGame_pending_sequence protoreflect.FieldDescriptor
+	fd_IbcGame_rollback         protoreflect.FieldDescriptor
+	fd_IbcGame_updated_time     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_buzzing_checkers_v1_types_proto_init()
+	md_IbcGame = File_buzzing_checkers_v1_types_proto.Messages().ByName("IbcGame")
+	fd_IbcGame_channel = md_IbcGame.Fields().ByName("channel")
+	fd_IbcGame_id = md_IbcGame.Fields().ByName("id")
+	fd_IbcGame_remote_id = md_IbcGame.Fields().ByName("remote_id")
+	fd_IbcGame_local_player = md_IbcGame.Fields().ByName("local_player")
+	fd_IbcGame_remote_player = md_IbcGame.Fields().ByName("remote_player")
+	fd_IbcGame_local_color = md_IbcGame.Fields().ByName("local_color")
+	fd_IbcGame_game = md_IbcGame.Fields().ByName("game")
+	fd_IbcGame_pending_sequence = md_IbcGame.Fields().ByName("pending_sequence")
+	fd_IbcGame_rollback = md_IbcGame.Fields().ByName("rollback")
+	fd_IbcGame_updated_time = md_IbcGame.Fields().ByName("updated_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_IbcGame)(nil)
+
+type fastReflection_IbcGame IbcGame
+
+func (x *IbcGame) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IbcGame)(x)
+}
+
+func (x *IbcGame) slowProtoReflect() protoreflect.Message {
+	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IbcGame_messageType fastReflection_IbcGame_messageType
+var _ protoreflect.MessageType = fastReflection_IbcGame_messageType{}
+
+type fastReflection_IbcGame_messageType struct{}
+
+func (x fastReflection_IbcGame_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IbcGame)(nil)
+}
+func (x fastReflection_IbcGame_messageType) New() protoreflect.Message {
+	return new(fastReflection_IbcGame)
+}
+func (x fastReflection_IbcGame_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcGame
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IbcGame) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcGame
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IbcGame) Type() protoreflect.MessageType {
+	return _fastReflection_IbcGame_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IbcGame) New() protoreflect.Message {
+	return new(fastReflection_IbcGame)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IbcGame) Interface() protoreflect.ProtoMessage {
+	return (*IbcGame)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IbcGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Channel != "" {
+		value := protoreflect.ValueOfString(x.Channel)
+		if !f(fd_IbcGame_channel, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_IbcGame_id, value) {
+			return
+		}
+	}
+	if x.RemoteId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemoteId)
+		if !f(fd_IbcGame_remote_id, value) {
+			return
+		}
+	}
+	if x.LocalPlayer != "" {
+		value := protoreflect.ValueOfString(x.LocalPlayer)
+		if !f(fd_IbcGame_local_player, value) {
+			return
+		}
+	}
+	if x.RemotePlayer != "" {
+		value := protoreflect.ValueOfString(x.RemotePlayer)
+		if !f(fd_IbcGame_remote_player, value) {
+			return
+		}
+	}
+	if x.LocalColor != "" {
+		value := protoreflect.ValueOfString(x.LocalColor)
+		if !f(fd_IbcGame_local_color, value) {
+			return
+		}
+	}
+	if x.Game != nil {
+		value := protoreflect.ValueOfMessage(x.Game.ProtoReflect())
+		if !f(fd_IbcGame_game, value) {
+			return
+		}
+	}
+	if x.PendingSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingSequence)
+		if !f(fd_IbcGame_pending_sequence, value) {
+			return
+		}
+	}
+	if x.Rollback != nil {
+		value := protoreflect.ValueOfMessage(x.Rollback.ProtoReflect())
+		if !f(fd_IbcGame_rollback, value) {
+			return
+		}
+	}
+	if x.UpdatedTime != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+		if !f(fd_IbcGame_updated_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IbcGame) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcGame.channel":
+		return x.Channel != ""
+	case "buzzing.checkers.v1.IbcGame.id":
+		return x.Id != uint64(0)
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		return x.RemoteId != uint64(0)
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		return x.LocalPlayer != ""
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		return x.RemotePlayer != ""
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		return x.LocalColor != ""
+	case "buzzing.checkers.v1.IbcGame.game":
+		return x.Game != nil
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		return x.PendingSequence != uint64(0)
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		return x.Rollback != nil
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		return x.UpdatedTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcGame) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcGame.channel":
+		x.Channel = ""
+	case "buzzing.checkers.v1.IbcGame.id":
+		x.Id = uint64(0)
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		x.RemoteId = uint64(0)
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		x.LocalPlayer = ""
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		x.RemotePlayer = ""
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		x.LocalColor = ""
+	case "buzzing.checkers.v1.IbcGame.game":
+		x.Game = nil
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		x.PendingSequence = uint64(0)
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		x.Rollback = nil
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		x.UpdatedTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IbcGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "buzzing.checkers.v1.IbcGame.channel":
+		value := x.Channel
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcGame.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		value := x.RemoteId
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		value := x.LocalPlayer
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		value := x.RemotePlayer
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		value := x.LocalColor
+		return protoreflect.ValueOfString(value)
+	case "buzzing.checkers.v1.IbcGame.game":
+		value := x.Game
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		value := x.PendingSequence
+		return protoreflect.ValueOfUint64(value)
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		value := x.Rollback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		value := x.UpdatedTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcGame.channel":
+		x.Channel = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcGame.id":
+		x.Id = value.Uint()
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		x.RemoteId = value.Uint()
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		x.LocalPlayer = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		x.RemotePlayer = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		x.LocalColor = value.Interface().(string)
+	case "buzzing.checkers.v1.IbcGame.game":
+		x.Game = value.Message().Interface().(*StoredGame)
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		x.PendingSequence = value.Uint()
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		x.Rollback = value.Message().Interface().(*StoredGame)
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		x.UpdatedTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcGame.game":
+		if x.Game == nil {
+			x.Game = new(StoredGame)
+		}
+		return protoreflect.ValueOfMessage(x.Game.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		if x.Rollback == nil {
+			x.Rollback = new(StoredGame)
+		}
+		return protoreflect.ValueOfMessage(x.Rollback.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		if x.UpdatedTime == nil {
+			x.UpdatedTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedTime.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.channel":
+		panic(fmt.Errorf("field channel of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.id":
+		panic(fmt.Errorf("field id of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		panic(fmt.Errorf("field remote_id of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		panic(fmt.Errorf("field local_player of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		panic(fmt.Errorf("field remote_player of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		panic(fmt.Errorf("field local_color of message buzzing.checkers.v1.IbcGame is not mutable"))
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		panic(fmt.Errorf("field pending_sequence of message buzzing.checkers.v1.IbcGame is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IbcGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "buzzing.checkers.v1.IbcGame.channel":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcGame.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcGame.remote_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcGame.local_player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcGame.remote_player":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcGame.local_color":
+		return protoreflect.ValueOfString("")
+	case "buzzing.checkers.v1.IbcGame.game":
+		m := new(StoredGame)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.pending_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "buzzing.checkers.v1.IbcGame.rollback":
+		m := new(StoredGame)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "buzzing.checkers.v1.IbcGame.updated_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IbcGame"))
+		}
+		panic(fmt.Errorf("message buzzing.checkers.v1.IbcGame does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IbcGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.IbcGame", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IbcGame) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcGame) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IbcGame) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IbcGame) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IbcGame)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Channel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.RemoteId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemoteId))
+		}
+		l = len(x.LocalPlayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RemotePlayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LocalColor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Game != nil {
+			l = options.Size(x.Game)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingSequence))
+		}
+		if x.Rollback != nil {
+			l = options.Size(x.Rollback)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedTime != nil {
+			l = options.Size(x.UpdatedTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IbcGame)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedTime != nil {
+			encoded, err := options.Marshal(x.UpdatedTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Rollback != nil {
+			encoded, err := options.Marshal(x.Rollback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.PendingSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingSequence))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Game != nil {
+			encoded, err := options.Marshal(x.Game)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.LocalColor) > 0 {
+			i -= len(x.LocalColor)
+			copy(dAtA[i:], x.LocalColor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalColor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.RemotePlayer) > 0 {
+			i -= len(x.RemotePlayer)
+			copy(dAtA[i:], x.RemotePlayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemotePlayer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.LocalPlayer) > 0 {
+			i -= len(x.LocalPlayer)
+			copy(dAtA[i:], x.LocalPlayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalPlayer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.RemoteId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoteId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Channel) > 0 {
+			i -= len(x.Channel)
+			copy(dAtA[i:], x.Channel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Channel)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IbcGame)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcGame: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteId", wireType)
+				}
+				x.RemoteId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemoteId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalPlayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LocalPlayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemotePlayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemotePlayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalColor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LocalColor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Game == nil {
+					x.Game = &StoredGame{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Game); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSequence", wireType)
+				}
+				x.PendingSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Rollback == nil {
+					x.Rollback = &StoredGame{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rollback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedTime == nil {
+					x.UpdatedTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
 		Merge:             nil,
 		CheckInitialized:  nil,
 	}
 }
 
-var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: buzzing/checkers/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecordFeeDestination 定义了 record 费用的去向
+type RecordFeeDestination int32
+
+const (
+	RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED RecordFeeDestination = 0
+	// 费用被销毁，模块账户需要 burner 权限
+	RecordFeeDestination_RECORD_FEE_DESTINATION_BURN RecordFeeDestination = 1
+	// 费用被转入社区池，需要 distribution 模块
+	RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL RecordFeeDestination = 2
+)
+
+// Enum value maps for RecordFeeDestination.
+var (
+	RecordFeeDestination_name = map[int32]string{
+		0: "RECORD_FEE_DESTINATION_UNSPECIFIED",
+		1: "RECORD_FEE_DESTINATION_BURN",
+		2: "RECORD_FEE_DESTINATION_COMMUNITY_POOL",
+	}
+	RecordFeeDestination_value = map[string]int32{
+		"RECORD_FEE_DESTINATION_UNSPECIFIED":    0,
+		"RECORD_FEE_DESTINATION_BURN":           1,
+		"RECORD_FEE_DESTINATION_COMMUNITY_POOL": 2,
+	}
+)
+
+func (x RecordFeeDestination) Enum() *RecordFeeDestination {
+	p := new(RecordFeeDestination)
+	*p = x
+	return p
+}
+
+func (x RecordFeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecordFeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (RecordFeeDestination) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[0]
+}
+
+func (x RecordFeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecordFeeDestination.Descriptor instead.
+func (RecordFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// GameStatus 定义了游戏对局所处的阶段
+type GameStatus int32
+
+const (
+	// GAME_STATUS_UNSPECIFIED 为未指定的状态，不应出现在存储的游戏中
+	GameStatus_GAME_STATUS_UNSPECIFIED GameStatus = 0
+	// GAME_STATUS_ACTIVE 表示游戏正在进行中
+	GameStatus_GAME_STATUS_ACTIVE GameStatus = 1
+	// GAME_STATUS_FINISHED 表示游戏已经结束
+	GameStatus_GAME_STATUS_FINISHED GameStatus = 2
+	// GAME_STATUS_PENDING 表示游戏邀请还在等待玩家接受
+	GameStatus_GAME_STATUS_PENDING GameStatus = 3
+	// GAME_STATUS_OPEN 表示游戏只有一方玩家，在大厅中等待任意玩家加入
+	GameStatus_GAME_STATUS_OPEN GameStatus = 4
+)
+
+// Enum value maps for GameStatus.
+var (
+	GameStatus_name = map[int32]string{
+		0: "GAME_STATUS_UNSPECIFIED",
+		1: "GAME_STATUS_ACTIVE",
+		2: "GAME_STATUS_FINISHED",
+		3: "GAME_STATUS_PENDING",
+		4: "GAME_STATUS_OPEN",
+	}
+	GameStatus_value = map[string]int32{
+		"GAME_STATUS_UNSPECIFIED": 0,
+		"GAME_STATUS_ACTIVE":      1,
+		"GAME_STATUS_FINISHED":    2,
+		"GAME_STATUS_PENDING":     3,
+		"GAME_STATUS_OPEN":        4,
+	}
+)
+
+func (x GameStatus) Enum() *GameStatus {
+	p := new(GameStatus)
+	*p = x
+	return p
+}
+
+func (x GameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (GameStatus) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[1]
+}
+
+func (x GameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameStatus.Descriptor instead.
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// TournamentFormat 定义了锦标赛的赛制
+type TournamentFormat int32
+
+const (
+	TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED TournamentFormat = 0
+	// 循环赛，每位玩家与其他所有玩家各对局一次
+	TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN TournamentFormat = 1
+	// 瑞士制，每轮将积分相近、尚未对局过的玩家配对
+	TournamentFormat_TOURNAMENT_FORMAT_SWISS TournamentFormat = 2
+)
+
+// Enum value maps for TournamentFormat.
+var (
+	TournamentFormat_name = map[int32]string{
+		0: "TOURNAMENT_FORMAT_UNSPECIFIED",
+		1: "TOURNAMENT_FORMAT_ROUND_ROBIN",
+		2: "TOURNAMENT_FORMAT_SWISS",
+	}
+	TournamentFormat_value = map[string]int32{
+		"TOURNAMENT_FORMAT_UNSPECIFIED": 0,
+		"TOURNAMENT_FORMAT_ROUND_ROBIN": 1,
+		"TOURNAMENT_FORMAT_SWISS":       2,
+	}
+)
+
+func (x TournamentFormat) Enum() *TournamentFormat {
+	p := new(TournamentFormat)
+	*p = x
+	return p
+}
+
+func (x TournamentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (TournamentFormat) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[2]
+}
+
+func (x TournamentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TournamentFormat.Descriptor instead.
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// TournamentStatus 定义了锦标赛的状态
+type TournamentStatus int32
+
+const (
+	TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED TournamentStatus = 0
+	// 报名中，到达 start_height 后开始第一轮
+	TournamentStatus_TOURNAMENT_STATUS_REGISTRATION TournamentStatus = 1
+	// 进行中
+	TournamentStatus_TOURNAMENT_STATUS_RUNNING TournamentStatus = 2
+	// 已结束，奖金已经发放
+	TournamentStatus_TOURNAMENT_STATUS_FINISHED TournamentStatus = 3
+	// 报名人数不足而取消，报名费已经退回
+	TournamentStatus_TOURNAMENT_STATUS_CANCELLED TournamentStatus = 4
+)
+
+// Enum value maps for TournamentStatus.
+var (
+	TournamentStatus_name = map[int32]string{
+		0: "TOURNAMENT_STATUS_UNSPECIFIED",
+		1: "TOURNAMENT_STATUS_REGISTRATION",
+		2: "TOURNAMENT_STATUS_RUNNING",
+		3: "TOURNAMENT_STATUS_FINISHED",
+		4: "TOURNAMENT_STATUS_CANCELLED",
+	}
+	TournamentStatus_value = map[string]int32{
+		"TOURNAMENT_STATUS_UNSPECIFIED":  0,
+		"TOURNAMENT_STATUS_REGISTRATION": 1,
+		"TOURNAMENT_STATUS_RUNNING":      2,
+		"TOURNAMENT_STATUS_FINISHED":     3,
+		"TOURNAMENT_STATUS_CANCELLED":    4,
+	}
+)
+
+func (x TournamentStatus) Enum() *TournamentStatus {
+	p := new(TournamentStatus)
+	*p = x
+	return p
+}
+
+func (x TournamentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TournamentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (TournamentStatus) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[3]
+}
+
+func (x TournamentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-type _GenesisState_2_list struct {
-	list *[]*IndexedStoredGame
+// Deprecated: Use TournamentStatus.Descriptor instead.
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *_GenesisState_2_list) Len() int {
-	if x.list == nil {
-		return 0
+// RecordSource 定义了 record 的来源
+type RecordSource int32
+
+const (
+	RecordSource_RECORD_SOURCE_UNSPECIFIED RecordSource = 0
+	// 创世时由 InitGenesis 添加
+	RecordSource_RECORD_SOURCE_GENESIS RecordSource = 1
+	// 由 BeginBlock 添加
+	RecordSource_RECORD_SOURCE_BEGIN_BLOCK RecordSource = 2
+	// 由 EndBlock 添加
+	RecordSource_RECORD_SOURCE_END_BLOCK RecordSource = 3
+	// 由 MsgAddRecord 添加
+	RecordSource_RECORD_SOURCE_TX RecordSource = 4
+	// 迁移自共识版本 1 的 RecordList，原有的高度和时间未知，使用迁移时的区块
+	RecordSource_RECORD_SOURCE_LEGACY RecordSource = 5
+)
+
+// Enum value maps for RecordSource.
+var (
+	RecordSource_name = map[int32]string{
+		0: "RECORD_SOURCE_UNSPECIFIED",
+		1: "RECORD_SOURCE_GENESIS",
+		2: "RECORD_SOURCE_BEGIN_BLOCK",
+		3: "RECORD_SOURCE_END_BLOCK",
+		4: "RECORD_SOURCE_TX",
+		5: "RECORD_SOURCE_LEGACY",
 	}
-	return len(*x.list)
+	RecordSource_value = map[string]int32{
+		"RECORD_SOURCE_UNSPECIFIED": 0,
+		"RECORD_SOURCE_GENESIS":     1,
+		"RECORD_SOURCE_BEGIN_BLOCK": 2,
+		"RECORD_SOURCE_END_BLOCK":   3,
+		"RECORD_SOURCE_TX":          4,
+		"RECORD_SOURCE_LEGACY":      5,
+	}
+)
+
+func (x RecordSource) Enum() *RecordSource {
+	p := new(RecordSource)
+	*p = x
+	return p
 }
 
-func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x RecordSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IndexedStoredGame)
-	(*x.list)[i] = concreteValue
+func (RecordSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[4].Descriptor()
 }
 
-func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*IndexedStoredGame)
-	*x.list = append(*x.list, concreteValue)
+func (RecordSource) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[4]
 }
 
-func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
-	v := new(IndexedStoredGame)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x RecordSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *_GenesisState_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// Deprecated: Use RecordSource.Descriptor instead.
+func (RecordSource) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+// OutgoingPacketStatus 定义了发送的数据包的状态
+type OutgoingPacketStatus int32
+
+const (
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_UNSPECIFIED OutgoingPacketStatus = 0
+	// 已发送，还没有收到确认
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_PENDING OutgoingPacketStatus = 1
+	// 对方链成功处理了数据包
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_ACKNOWLEDGED OutgoingPacketStatus = 2
+	// 对方链返回了错误的确认
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_FAILED OutgoingPacketStatus = 3
+	// 数据包超时
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_TIMED_OUT OutgoingPacketStatus = 4
+)
+
+// Enum value maps for OutgoingPacketStatus.
+var (
+	OutgoingPacketStatus_name = map[int32]string{
+		0: "OUTGOING_PACKET_STATUS_UNSPECIFIED",
+		1: "OUTGOING_PACKET_STATUS_PENDING",
+		2: "OUTGOING_PACKET_STATUS_ACKNOWLEDGED",
+		3: "OUTGOING_PACKET_STATUS_FAILED",
+		4: "OUTGOING_PACKET_STATUS_TIMED_OUT",
 	}
-	*x.list = (*x.list)[:n]
+	OutgoingPacketStatus_value = map[string]int32{
+		"OUTGOING_PACKET_STATUS_UNSPECIFIED":  0,
+		"OUTGOING_PACKET_STATUS_PENDING":      1,
+		"OUTGOING_PACKET_STATUS_ACKNOWLEDGED": 2,
+		"OUTGOING_PACKET_STATUS_FAILED":       3,
+		"OUTGOING_PACKET_STATUS_TIMED_OUT":    4,
+	}
+)
+
+func (x OutgoingPacketStatus) Enum() *OutgoingPacketStatus {
+	p := new(OutgoingPacketStatus)
+	*p = x
+	return p
 }
 
-func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
-	v := new(IndexedStoredGame)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x OutgoingPacketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *_GenesisState_2_list) IsValid() bool {
-	return x.list != nil
+func (OutgoingPacketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_buzzing_checkers_v1_types_proto_enumTypes[5].Descriptor()
 }
 
-var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+func (OutgoingPacketStatus) Type() protoreflect.EnumType {
+	return &file_buzzing_checkers_v1_types_proto_enumTypes[5]
+}
 
-type _GenesisState_3_list struct {
-	list *[]string
+func (x OutgoingPacketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *_GenesisState_3_list) Len() int {
-	if x.list == nil {
-		return 0
+// Deprecated: Use OutgoingPacketStatus.Descriptor instead.
+func (OutgoingPacketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+// Params 定义了 checkers 模块的参数
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invitation_expiry_blocks 定义了游戏邀请的有效区块数
+	// 超过该区块数仍未被双方接受的游戏邀请将在 EndBlock 中被删除
+	InvitationExpiryBlocks uint64 `protobuf:"varint,1,opt,name=invitation_expiry_blocks,json=invitationExpiryBlocks,proto3" json:"invitation_expiry_blocks,omitempty"`
+	// matchmaking_rating_window 定义了匹配队列中两位玩家初始允许的最大等级分差
+	MatchmakingRatingWindow uint64 `protobuf:"varint,2,opt,name=matchmaking_rating_window,json=matchmakingRatingWindow,proto3" json:"matchmaking_rating_window,omitempty"`
+	// matchmaking_window_growth 定义了玩家在匹配队列中每等待一个区块，允许的等级分差增加的数值
+	MatchmakingWindowGrowth uint64 `protobuf:"varint,3,opt,name=matchmaking_window_growth,json=matchmakingWindowGrowth,proto3" json:"matchmaking_window_growth,omitempty"`
+	// max_turn_duration 定义了每一步棋的最长时限（区块数）
+	// 没有指定时限的游戏使用该时限
+	MaxTurnDuration uint64 `protobuf:"varint,4,opt,name=max_turn_duration,json=maxTurnDuration,proto3" json:"max_turn_duration,omitempty"`
+	// max_active_games 定义了每位玩家已经接受的、尚未结束的游戏数量上限，锦标赛的游戏不受限制
+	// 收到但还没有接受的邀请不计入
+	MaxActiveGames uint64 `protobuf:"varint,5,opt,name=max_active_games,json=maxActiveGames,proto3" json:"max_active_games,omitempty"`
+	// min_wager 定义了赌注的最小数量，不设置赌注的游戏不受限制
+	MinWager string `protobuf:"bytes,6,opt,name=min_wager,json=minWager,proto3" json:"min_wager,omitempty"`
+	// allowed_wager_denoms 定义了允许作为赌注的代币，为空时不限制
+	AllowedWagerDenoms []string `protobuf:"bytes,7,rep,name=allowed_wager_denoms,json=allowedWagerDenoms,proto3" json:"allowed_wager_denoms,omitempty"`
+	// record_max_length 定义了 MsgAddRecord 和通过 IBC 收到的 record 的最大字节数
+	// 模块在 BeginBlock、EndBlock 等处自己添加的 record 不受限制
+	RecordMaxLength uint64 `protobuf:"varint,8,opt,name=record_max_length,json=recordMaxLength,proto3" json:"record_max_length,omitempty"`
+	// record_retention 定义了 record 保留的区块数，EndBlock 删除更早的 record
+	RecordRetention uint64 `protobuf:"varint,9,opt,name=record_retention,json=recordRetention,proto3" json:"record_retention,omitempty"`
+	// record_min_length 定义了 MsgAddRecord 中 record 的最小字节数，为 0 时只要求非空
+	RecordMinLength uint64 `protobuf:"varint,10,opt,name=record_min_length,json=recordMinLength,proto3" json:"record_min_length,omitempty"`
+	// record_denylist 定义了 MsgAddRecord 不接受的 record 的正则表达式（RE2 语法）
+	RecordDenylist []string `protobuf:"bytes,11,rep,name=record_denylist,json=recordDenylist,proto3" json:"record_denylist,omitempty"`
+	// record_rate_limit 定义了每个账户在 record_rate_window 个区块内最多添加的 record 数量，为 0 时不限制
+	RecordRateLimit uint64 `protobuf:"varint,12,opt,name=record_rate_limit,json=recordRateLimit,proto3" json:"record_rate_limit,omitempty"`
+	// record_rate_window 定义了限制 record 数量的区块窗口，窗口从高度为其整数倍的区块开始
+	RecordRateWindow uint64 `protobuf:"varint,13,opt,name=record_rate_window,json=recordRateWindow,proto3" json:"record_rate_window,omitempty"`
+	// record_fee 定义了每条 record 的费用，没有设置时不收取费用
+	RecordFee *v1beta1.Coin `protobuf:"bytes,14,opt,name=record_fee,json=recordFee,proto3" json:"record_fee,omitempty"`
+	// record_fee_destination 定义了 record 费用的去向，设置了 record_fee 时必须指定
+	RecordFeeDestination RecordFeeDestination `protobuf:"varint,15,opt,name=record_fee_destination,json=recordFeeDestination,proto3,enum=buzzing.checkers.v1.RecordFeeDestination" json:"record_fee_destination,omitempty"`
+	// max_queue_size 定义了匹配队列中最多的玩家数量，队列已满时不能再加入
+	// EndBlock 中配对的开销随队列长度的平方增长，因此需要限制队列的长度
+	MaxQueueSize uint64 `protobuf:"varint,16,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return len(*x.list)
 }
 
-func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *Params) GetInvitationExpiryBlocks() uint64 {
+	if x != nil {
+		return x.InvitationExpiryBlocks
+	}
+	return 0
 }
 
-func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field RecordList as it is not of Message kind"))
+func (x *Params) GetMatchmakingRatingWindow() uint64 {
+	if x != nil {
+		return x.MatchmakingRatingWindow
+	}
+	return 0
 }
 
-func (x *_GenesisState_3_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
+func (x *Params) GetMatchmakingWindowGrowth() uint64 {
+	if x != nil {
+		return x.MatchmakingWindowGrowth
+	}
+	return 0
 }
 
-func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *Params) GetMaxTurnDuration() uint64 {
+	if x != nil {
+		return x.MaxTurnDuration
+	}
+	return 0
 }
 
-func (x *_GenesisState_3_list) IsValid() bool {
-	return x.list != nil
+func (x *Params) GetMaxActiveGames() uint64 {
+	if x != nil {
+		return x.MaxActiveGames
+	}
+	return 0
 }
 
-var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_params                protoreflect.FieldDescriptor
-	fd_GenesisState_indexedStoredGameList protoreflect.FieldDescriptor
-	fd_GenesisState_recordList            protoreflect.FieldDescriptor
-)
+func (x *Params) GetMinWager() string {
+	if x != nil {
+		return x.MinWager
+	}
+	return ""
+}
 
-func init() {
-	file_buzzing_checkers_v1_types_proto_init()
-	md_GenesisState = File_buzzing_checkers_v1_types_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_indexedStoredGameList = md_GenesisState.Fields().ByName("indexedStoredGameList")
-	fd_GenesisState_recordList = md_GenesisState.Fields().ByName("recordList")
+func (x *Params) GetAllowedWagerDenoms() []string {
+	if x != nil {
+		return x.AllowedWagerDenoms
+	}
+	return nil
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *Params) GetRecordMaxLength() uint64 {
+	if x != nil {
+		return x.RecordMaxLength
+	}
+	return 0
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *Params) GetRecordRetention() uint64 {
+	if x != nil {
+		return x.RecordRetention
+	}
+	return 0
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *Params) GetRecordMinLength() uint64 {
+	if x != nil {
+		return x.RecordMinLength
+	}
+	return 0
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Params) GetRecordDenylist() []string {
+	if x != nil {
+		return x.RecordDenylist
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *Params) GetRecordRateLimit() uint64 {
+	if x != nil {
+		return x.RecordRateLimit
+	}
+	return 0
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *Params) GetRecordRateWindow() uint64 {
+	if x != nil {
+		return x.RecordRateWindow
+	}
+	return 0
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *Params) GetRecordFee() *v1beta1.Coin {
+	if x != nil {
+		return x.RecordFee
+	}
+	return nil
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *Params) GetRecordFeeDestination() RecordFeeDestination {
+	if x != nil {
+		return x.RecordFeeDestination
+	}
+	return RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *Params) GetMaxQueueSize() uint64 {
+	if x != nil {
+		return x.MaxQueueSize
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+// GenesisState 为 checkers 模块的创世状态
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params 定义了模块的所有参数
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// indexedStoredGameList 定义了所有的 StoredGame
+	IndexedStoredGameList []*IndexedStoredGame `protobuf:"bytes,2,rep,name=indexedStoredGameList,proto3" json:"indexedStoredGameList,omitempty"`
+	// recordList 为共识版本 1 中以字符串保存的 record，只在导入旧的创世状态时使用，
+	// 由 migrations/v2 的 MigrateGenesis 转换为 records
+	//
+	// Deprecated: Do not use.
+	RecordList []string `protobuf:"bytes,3,rep,name=recordList,proto3" json:"recordList,omitempty"`
+	// indexedPlayerInfoList 定义了所有玩家的对局统计
+	IndexedPlayerInfoList []*IndexedPlayerInfo `protobuf:"bytes,4,rep,name=indexedPlayerInfoList,proto3" json:"indexedPlayerInfoList,omitempty"`
+	// queueEntryList 定义了匹配队列中的所有玩家
+	QueueEntryList []*QueueEntry `protobuf:"bytes,5,rep,name=queueEntryList,proto3" json:"queueEntryList,omitempty"`
+	// tournamentList 定义了所有锦标赛
+	TournamentList []*Tournament `protobuf:"bytes,6,rep,name=tournamentList,proto3" json:"tournamentList,omitempty"`
+	// tournamentPlayerList 定义了所有锦标赛的参赛玩家
+	TournamentPlayerList []*TournamentPlayer `protobuf:"bytes,7,rep,name=tournamentPlayerList,proto3" json:"tournamentPlayerList,omitempty"`
+	// tournamentCount 为下一个锦标赛的 id
+	TournamentCount uint64 `protobuf:"varint,8,opt,name=tournamentCount,proto3" json:"tournamentCount,omitempty"`
+	// records 定义了所有的 record
+	Records []*Record `protobuf:"bytes,9,rep,name=records,proto3" json:"records,omitempty"`
+	// recordCount 为已经分配的 record id 的数量
+	RecordCount uint64 `protobuf:"varint,10,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	// recordRateCounters 定义了各账户在当前窗口内添加的 record 数量
+	RecordRateCounters []*RecordRateCounter `protobuf:"bytes,11,rep,name=recordRateCounters,proto3" json:"recordRateCounters,omitempty"`
+	// recordLogHead 为 record 链的头部，还没有添加过 record 时为空
+	RecordLogHead *RecordLogHead `protobuf:"bytes,12,opt,name=recordLogHead,proto3" json:"recordLogHead,omitempty"`
+	// remoteRecordList 定义了通过 IBC 从其他链收到的 record
+	RemoteRecordList []*RemoteRecord `protobuf:"bytes,13,rep,name=remoteRecordList,proto3" json:"remoteRecordList,omitempty"`
+	// outgoingPacketList 定义了本链发送的数据包
+	OutgoingPacketList []*OutgoingPacket `protobuf:"bytes,14,rep,name=outgoingPacketList,proto3" json:"outgoingPacketList,omitempty"`
+	// ibcGameList 定义了所有跨链游戏
+	IbcGameList []*IbcGame `protobuf:"bytes,15,rep,name=ibcGameList,proto3" json:"ibcGameList,omitempty"`
+	// ibcGameCount 为已经分配的跨链游戏 id 的数量
+	IbcGameCount uint64 `protobuf:"varint,16,opt,name=ibcGameCount,proto3" json:"ibcGameCount,omitempty"`
+	// ibcChannelList 定义了模块的端口上已经开启的通道
+	IbcChannelList []*IbcChannel `protobuf:"bytes,17,rep,name=ibcChannelList,proto3" json:"ibcChannelList,omitempty"`
+	// defaultChannel 为跨链功能的默认通道，没有设置时为空
+	DefaultChannel string `protobuf:"bytes,18,opt,name=defaultChannel,proto3" json:"defaultChannel,omitempty"`
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
 	}
-	if len(x.IndexedStoredGameList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.IndexedStoredGameList})
-		if !f(fd_GenesisState_indexedStoredGameList, value) {
-			return
-		}
+	return nil
+}
+
+func (x *GenesisState) GetIndexedStoredGameList() []*IndexedStoredGame {
+	if x != nil {
+		return x.IndexedStoredGameList
 	}
-	if len(x.RecordList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.RecordList})
-		if !f(fd_GenesisState_recordList, value) {
-			return
-		}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *GenesisState) GetRecordList() []string {
+	if x != nil {
+		return x.RecordList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIndexedPlayerInfoList() []*IndexedPlayerInfo {
+	if x != nil {
+		return x.IndexedPlayerInfoList
 	}
+	return nil
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		return x.Params != nil
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		return len(x.IndexedStoredGameList) != 0
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		return len(x.RecordList) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *GenesisState) GetQueueEntryList() []*QueueEntry {
+	if x != nil {
+		return x.QueueEntryList
 	}
+	return nil
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		x.Params = nil
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		x.IndexedStoredGameList = nil
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		x.RecordList = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *GenesisState) GetTournamentList() []*Tournament {
+	if x != nil {
+		return x.TournamentList
 	}
+	return nil
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		if len(x.IndexedStoredGameList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.IndexedStoredGameList}
-		return protoreflect.ValueOfList(listValue)
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		if len(x.RecordList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.RecordList}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *GenesisState) GetTournamentPlayerList() []*TournamentPlayer {
+	if x != nil {
+		return x.TournamentPlayerList
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.IndexedStoredGameList = *clv.list
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.RecordList = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *GenesisState) GetTournamentCount() uint64 {
+	if x != nil {
+		return x.TournamentCount
 	}
+	return 0
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		if x.IndexedStoredGameList == nil {
-			x.IndexedStoredGameList = []*IndexedStoredGame{}
-		}
-		value := &_GenesisState_2_list{list: &x.IndexedStoredGameList}
-		return protoreflect.ValueOfList(value)
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		if x.RecordList == nil {
-			x.RecordList = []string{}
-		}
-		value := &_GenesisState_3_list{list: &x.RecordList}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *GenesisState) GetRecords() []*Record {
+	if x != nil {
+		return x.Records
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "buzzing.checkers.v1.GenesisState.indexedStoredGameList":
-		list := []*IndexedStoredGame{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "buzzing.checkers.v1.GenesisState.recordList":
-		list := []string{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *GenesisState) GetRecordCount() uint64 {
+	if x != nil {
+		return x.RecordCount
 	}
+	return 0
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.GenesisState", d.FullName()))
+func (x *GenesisState) GetRecordRateCounters() []*RecordRateCounter {
+	if x != nil {
+		return x.RecordRateCounters
 	}
-	panic("unreachable")
+	return nil
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *GenesisState) GetRecordLogHead() *RecordLogHead {
+	if x != nil {
+		return x.RecordLogHead
+	}
+	return nil
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *GenesisState) GetRemoteRecordList() []*RemoteRecord {
+	if x != nil {
+		return x.RemoteRecordList
+	}
+	return nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *GenesisState) GetOutgoingPacketList() []*OutgoingPacket {
+	if x != nil {
+		return x.OutgoingPacketList
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.IndexedStoredGameList) > 0 {
-			for _, e := range x.IndexedStoredGameList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.RecordList) > 0 {
-			for _, s := range x.RecordList {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *GenesisState) GetIbcGameList() []*IbcGame {
+	if x != nil {
+		return x.IbcGameList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIbcGameCount() uint64 {
+	if x != nil {
+		return x.IbcGameCount
 	}
+	return 0
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.RecordList) > 0 {
-			for iNdEx := len(x.RecordList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.RecordList[iNdEx])
-				copy(dAtA[i:], x.RecordList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordList[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.IndexedStoredGameList) > 0 {
-			for iNdEx := len(x.IndexedStoredGameList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.IndexedStoredGameList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *GenesisState) GetIbcChannelList() []*IbcChannel {
+	if x != nil {
+		return x.IbcChannelList
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexedStoredGameList", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.IndexedStoredGameList = append(x.IndexedStoredGameList, &IndexedStoredGame{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndexedStoredGameList[len(x.IndexedStoredGameList)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordList", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RecordList = append(x.RecordList, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
+
+func (x *GenesisState) GetDefaultChannel() string {
+	if x != nil {
+		return x.DefaultChannel
+	}
+	return ""
+}
+
+// StoredGame 为一局游戏进行到某一步时的状态
+type StoredGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// board 定义了棋盘的状态，由规则文件(rules/checkers.go)序列化
+	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	// turn 定义了当前回合的玩家
+	Turn string `protobuf:"bytes,2,opt,name=turn,proto3" json:"turn,omitempty"`
+	// black 定义了黑方玩家的地址
+	// 处于 GAME_STATUS_OPEN 的游戏中，尚未有玩家加入的一方为空
+	Black string `protobuf:"bytes,3,opt,name=black,proto3" json:"black,omitempty"`
+	// red 定义了红方玩家的地址
+	Red string `protobuf:"bytes,4,opt,name=red,proto3" json:"red,omitempty"`
+	// status 定义了游戏对局当前所处的阶段
+	Status GameStatus `protobuf:"varint,5,opt,name=status,proto3,enum=buzzing.checkers.v1.GameStatus" json:"status,omitempty"`
+	// winner 定义了获胜方的颜色，与 turn 的取值相同
+	// 游戏未结束或以和棋结束时为 "*"
+	Winner string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	// move_count 定义了游戏对局中已经走过的步数
+	MoveCount uint64 `protobuf:"varint,7,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	// draw_offer 定义了提出和棋的一方的颜色，与 turn 的取值相同
+	// 没有待处理的和棋提议时为空
+	DrawOffer string `protobuf:"bytes,8,opt,name=draw_offer,json=drawOffer,proto3" json:"draw_offer,omitempty"`
+	// black_accepted 定义了黑方是否已经接受游戏邀请
+	BlackAccepted bool `protobuf:"varint,9,opt,name=black_accepted,json=blackAccepted,proto3" json:"black_accepted,omitempty"`
+	// red_accepted 定义了红方是否已经接受游戏邀请
+	RedAccepted bool `protobuf:"varint,10,opt,name=red_accepted,json=redAccepted,proto3" json:"red_accepted,omitempty"`
+	// invitation_deadline 定义了游戏邀请的过期区块高度
+	// 处于 GAME_STATUS_PENDING 的游戏在该高度之后仍未被双方接受，将被删除
+	InvitationDeadline int64 `protobuf:"varint,11,opt,name=invitation_deadline,json=invitationDeadline,proto3" json:"invitation_deadline,omitempty"`
+	// wager 定义了每位玩家的赌注，denom 为空时表示没有赌注
+	// 玩家接受游戏或加入游戏时，赌注托管到模块账户；游戏结束时由获胜方全部取得，
+	// 和棋时各自退回；游戏被拒绝或过期时退回已经托管的赌注
+	Wager *v1beta1.Coin `protobuf:"bytes,12,opt,name=wager,proto3" json:"wager,omitempty"`
+	// min_rating 定义了加入游戏的玩家需要达到的最低等级分，为 0 时不限制
+	MinRating uint64 `protobuf:"varint,13,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	// turn_duration 定义了每一步棋的时限（区块数），为 0 时不限时
+	TurnDuration uint64 `protobuf:"varint,14,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// deadline 定义了当前回合的玩家必须在该区块高度之前（含）走棋，否则判负
+	// 只有限时的、正在进行的游戏才有 deadline，否则为 0
+	Deadline int64 `protobuf:"varint,15,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// tournament_id 为游戏所属的锦标赛，为 0 时不属于任何锦标赛
+	TournamentId uint64 `protobuf:"varint,16,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+}
+
+func (x *StoredGame) Reset() {
+	*x = StoredGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *StoredGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredGame) ProtoMessage() {}
+
+// Deprecated: Use StoredGame.ProtoReflect.Descriptor instead.
+func (*StoredGame) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoredGame) GetBoard() string {
+	if x != nil {
+		return x.Board
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *StoredGame) GetTurn() string {
+	if x != nil {
+		return x.Turn
 	}
+	return ""
 }
 
-var (
-	md_StoredGame       protoreflect.MessageDescriptor
-	fd_StoredGame_board protoreflect.FieldDescriptor
-	fd_StoredGame_turn  protoreflect.FieldDescriptor
-	fd_StoredGame_black protoreflect.FieldDescriptor
-	fd_StoredGame_red   protoreflect.FieldDescriptor
-)
+func (x *StoredGame) GetBlack() string {
+	if x != nil {
+		return x.Black
+	}
+	return ""
+}
 
-func init() {
-	file_buzzing_checkers_v1_types_proto_init()
-	md_StoredGame = File_buzzing_checkers_v1_types_proto.Messages().ByName("StoredGame")
-	fd_StoredGame_board = md_StoredGame.Fields().ByName("board")
-	fd_StoredGame_turn = md_StoredGame.Fields().ByName("turn")
-	fd_StoredGame_black = md_StoredGame.Fields().ByName("black")
-	fd_StoredGame_red = md_StoredGame.Fields().ByName("red")
+func (x *StoredGame) GetRed() string {
+	if x != nil {
+		return x.Red
+	}
+	return ""
 }
 
-var _ protoreflect.Message = (*fastReflection_StoredGame)(nil)
+func (x *StoredGame) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
 
-type fastReflection_StoredGame StoredGame
+func (x *StoredGame) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
 
-func (x *StoredGame) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_StoredGame)(x)
+func (x *StoredGame) GetMoveCount() uint64 {
+	if x != nil {
+		return x.MoveCount
+	}
+	return 0
 }
 
-func (x *StoredGame) slowProtoReflect() protoreflect.Message {
-	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StoredGame) GetDrawOffer() string {
+	if x != nil {
+		return x.DrawOffer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-var _fastReflection_StoredGame_messageType fastReflection_StoredGame_messageType
-var _ protoreflect.MessageType = fastReflection_StoredGame_messageType{}
+func (x *StoredGame) GetBlackAccepted() bool {
+	if x != nil {
+		return x.BlackAccepted
+	}
+	return false
+}
 
-type fastReflection_StoredGame_messageType struct{}
+func (x *StoredGame) GetRedAccepted() bool {
+	if x != nil {
+		return x.RedAccepted
+	}
+	return false
+}
 
-func (x fastReflection_StoredGame_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_StoredGame)(nil)
+func (x *StoredGame) GetInvitationDeadline() int64 {
+	if x != nil {
+		return x.InvitationDeadline
+	}
+	return 0
 }
-func (x fastReflection_StoredGame_messageType) New() protoreflect.Message {
-	return new(fastReflection_StoredGame)
+
+func (x *StoredGame) GetWager() *v1beta1.Coin {
+	if x != nil {
+		return x.Wager
+	}
+	return nil
 }
-func (x fastReflection_StoredGame_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_StoredGame
+
+func (x *StoredGame) GetMinRating() uint64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_StoredGame) Descriptor() protoreflect.MessageDescriptor {
-	return md_StoredGame
+func (x *StoredGame) GetTurnDuration() uint64 {
+	if x != nil {
+		return x.TurnDuration
+	}
+	return 0
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_StoredGame) Type() protoreflect.MessageType {
-	return _fastReflection_StoredGame_messageType
+func (x *StoredGame) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_StoredGame) New() protoreflect.Message {
-	return new(fastReflection_StoredGame)
+func (x *StoredGame) GetTournamentId() uint64 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_StoredGame) Interface() protoreflect.ProtoMessage {
-	return (*StoredGame)(x)
+// PlayerInfo 记录了玩家的对局统计
+type PlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// won_count 为玩家获胜的对局数
+	WonCount uint64 `protobuf:"varint,1,opt,name=won_count,json=wonCount,proto3" json:"won_count,omitempty"`
+	// lost_count 为玩家落败的对局数
+	LostCount uint64 `protobuf:"varint,2,opt,name=lost_count,json=lostCount,proto3" json:"lost_count,omitempty"`
+	// drawn_count 为玩家和棋的对局数
+	DrawnCount uint64 `protobuf:"varint,3,opt,name=drawn_count,json=drawnCount,proto3" json:"drawn_count,omitempty"`
+	// rating 为玩家的 Elo 等级分，新玩家为 DefaultRating
+	Rating uint64 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_StoredGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Board != "" {
-		value := protoreflect.ValueOfString(x.Board)
-		if !f(fd_StoredGame_board, value) {
-			return
-		}
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if x.Turn != "" {
-		value := protoreflect.ValueOfString(x.Turn)
-		if !f(fd_StoredGame_turn, value) {
-			return
-		}
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlayerInfo) GetWonCount() uint64 {
+	if x != nil {
+		return x.WonCount
 	}
-	if x.Black != "" {
-		value := protoreflect.ValueOfString(x.Black)
-		if !f(fd_StoredGame_black, value) {
-			return
-		}
+	return 0
+}
+
+func (x *PlayerInfo) GetLostCount() uint64 {
+	if x != nil {
+		return x.LostCount
 	}
-	if x.Red != "" {
-		value := protoreflect.ValueOfString(x.Red)
-		if !f(fd_StoredGame_red, value) {
-			return
-		}
+	return 0
+}
+
+func (x *PlayerInfo) GetDrawnCount() uint64 {
+	if x != nil {
+		return x.DrawnCount
 	}
+	return 0
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_StoredGame) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		return x.Board != ""
-	case "buzzing.checkers.v1.StoredGame.turn":
-		return x.Turn != ""
-	case "buzzing.checkers.v1.StoredGame.black":
-		return x.Black != ""
-	case "buzzing.checkers.v1.StoredGame.red":
-		return x.Red != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+func (x *PlayerInfo) GetRating() uint64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// IndexedPlayerInfo 为 PlayerInfo 的包装，用于索引
+type IndexedPlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player     string      `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	PlayerInfo *PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo,omitempty"`
+}
+
+func (x *IndexedPlayerInfo) Reset() {
+	*x = IndexedPlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexedPlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexedPlayerInfo) ProtoMessage() {}
+
+// Deprecated: Use IndexedPlayerInfo.ProtoReflect.Descriptor instead.
+func (*IndexedPlayerInfo) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IndexedPlayerInfo) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *IndexedPlayerInfo) GetPlayerInfo() *PlayerInfo {
+	if x != nil {
+		return x.PlayerInfo
+	}
+	return nil
+}
+
+// QueueEntry 为匹配队列中的一位玩家
+type QueueEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// rating 为玩家进入队列时的等级分
+	Rating uint64 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	// turn_duration 为玩家希望的每一步棋的时限（区块数），只与时限相同的玩家匹配
+	TurnDuration uint64 `protobuf:"varint,3,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// wager 为玩家希望的赌注，只与赌注相同的玩家匹配，进入队列时托管
+	Wager *v1beta1.Coin `protobuf:"bytes,4,opt,name=wager,proto3" json:"wager,omitempty"`
+	// joined_height 为玩家进入队列时的区块高度
+	JoinedHeight int64 `protobuf:"varint,5,opt,name=joined_height,json=joinedHeight,proto3" json:"joined_height,omitempty"`
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueueEntry) GetPlayer() string {
+	if x != nil {
+		return x.Player
 	}
+	return ""
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoredGame) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		x.Board = ""
-	case "buzzing.checkers.v1.StoredGame.turn":
-		x.Turn = ""
-	case "buzzing.checkers.v1.StoredGame.black":
-		x.Black = ""
-	case "buzzing.checkers.v1.StoredGame.red":
-		x.Red = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+func (x *QueueEntry) GetRating() uint64 {
+	if x != nil {
+		return x.Rating
 	}
+	return 0
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_StoredGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		value := x.Board
-		return protoreflect.ValueOfString(value)
-	case "buzzing.checkers.v1.StoredGame.turn":
-		value := x.Turn
-		return protoreflect.ValueOfString(value)
-	case "buzzing.checkers.v1.StoredGame.black":
-		value := x.Black
-		return protoreflect.ValueOfString(value)
-	case "buzzing.checkers.v1.StoredGame.red":
-		value := x.Red
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", descriptor.FullName()))
+func (x *QueueEntry) GetTurnDuration() uint64 {
+	if x != nil {
+		return x.TurnDuration
 	}
+	return 0
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoredGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		x.Board = value.Interface().(string)
-	case "buzzing.checkers.v1.StoredGame.turn":
-		x.Turn = value.Interface().(string)
-	case "buzzing.checkers.v1.StoredGame.black":
-		x.Black = value.Interface().(string)
-	case "buzzing.checkers.v1.StoredGame.red":
-		x.Red = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+func (x *QueueEntry) GetWager() *v1beta1.Coin {
+	if x != nil {
+		return x.Wager
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoredGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		panic(fmt.Errorf("field board of message buzzing.checkers.v1.StoredGame is not mutable"))
-	case "buzzing.checkers.v1.StoredGame.turn":
-		panic(fmt.Errorf("field turn of message buzzing.checkers.v1.StoredGame is not mutable"))
-	case "buzzing.checkers.v1.StoredGame.black":
-		panic(fmt.Errorf("field black of message buzzing.checkers.v1.StoredGame is not mutable"))
-	case "buzzing.checkers.v1.StoredGame.red":
-		panic(fmt.Errorf("field red of message buzzing.checkers.v1.StoredGame is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+func (x *QueueEntry) GetJoinedHeight() int64 {
+	if x != nil {
+		return x.JoinedHeight
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_StoredGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.StoredGame.board":
-		return protoreflect.ValueOfString("")
-	case "buzzing.checkers.v1.StoredGame.turn":
-		return protoreflect.ValueOfString("")
-	case "buzzing.checkers.v1.StoredGame.black":
-		return protoreflect.ValueOfString("")
-	case "buzzing.checkers.v1.StoredGame.red":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.StoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.StoredGame does not contain field %s", fd.FullName()))
+// IndexedStoredGame 为 StoredGame 的包装，用于索引
+type IndexedStoredGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      string      `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	StoredGame *StoredGame `protobuf:"bytes,2,opt,name=storedGame,proto3" json:"storedGame,omitempty"`
+}
+
+func (x *IndexedStoredGame) Reset() {
+	*x = IndexedStoredGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_StoredGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.StoredGame", d.FullName()))
+func (x *IndexedStoredGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexedStoredGame) ProtoMessage() {}
+
+// Deprecated: Use IndexedStoredGame.ProtoReflect.Descriptor instead.
+func (*IndexedStoredGame) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IndexedStoredGame) GetIndex() string {
+	if x != nil {
+		return x.Index
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_StoredGame) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *IndexedStoredGame) GetStoredGame() *StoredGame {
+	if x != nil {
+		return x.StoredGame
+	}
+	return nil
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoredGame) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+// Tournament 定义了一个锦标赛
+type Tournament struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string           `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Format  TournamentFormat `protobuf:"varint,4,opt,name=format,proto3,enum=buzzing.checkers.v1.TournamentFormat" json:"format,omitempty"`
+	Status  TournamentStatus `protobuf:"varint,5,opt,name=status,proto3,enum=buzzing.checkers.v1.TournamentStatus" json:"status,omitempty"`
+	// sanctioned 为 true 时表示由 authority 创建的官方锦标赛，只有官方锦标赛的对局计入等级分
+	Sanctioned bool `protobuf:"varint,6,opt,name=sanctioned,proto3" json:"sanctioned,omitempty"`
+	// entry_fee 为每位玩家报名时托管的报名费，可选
+	EntryFee *v1beta1.Coin `protobuf:"bytes,7,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee,omitempty"`
+	// prize_pool 为奖池，即所有玩家的报名费之和
+	PrizePool *v1beta1.Coin `protobuf:"bytes,8,opt,name=prize_pool,json=prizePool,proto3" json:"prize_pool,omitempty"`
+	// prize_shares 为各名次分得奖池的百分比，从第一名开始，总和为 100
+	PrizeShares []uint32 `protobuf:"varint,9,rep,packed,name=prize_shares,json=prizeShares,proto3" json:"prize_shares,omitempty"`
+	// max_players 为报名人数上限
+	MaxPlayers uint64 `protobuf:"varint,10,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// start_height 为报名截止、第一轮开始的区块高度
+	StartHeight int64 `protobuf:"varint,11,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// turn_duration 为锦标赛中每一步棋的时限（区块数），保证每一轮都能结束
+	TurnDuration uint64 `protobuf:"varint,12,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// rounds 为总轮数，循环赛在开始时根据人数计算
+	Rounds uint32 `protobuf:"varint,13,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// current_round 为当前轮次，从 1 开始，报名期间为 0
+	CurrentRound uint32 `protobuf:"varint,14,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
+	// round_games 为当前轮次所有游戏的索引
+	RoundGames []string `protobuf:"bytes,15,rep,name=round_games,json=roundGames,proto3" json:"round_games,omitempty"`
+	// players 为按报名顺序排列的参赛玩家
+	Players []string `protobuf:"bytes,16,rep,name=players,proto3" json:"players,omitempty"`
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_StoredGame) IsValid() bool {
-	return x != nil
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_StoredGame) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*StoredGame)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Board)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Turn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Black)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Red)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Tournament) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tournament) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() TournamentFormat {
+	if x != nil {
+		return x.Format
 	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*StoredGame)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Red) > 0 {
-			i -= len(x.Red)
-			copy(dAtA[i:], x.Red)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Red)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Black) > 0 {
-			i -= len(x.Black)
-			copy(dAtA[i:], x.Black)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Black)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Turn) > 0 {
-			i -= len(x.Turn)
-			copy(dAtA[i:], x.Turn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Turn)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Board) > 0 {
-			i -= len(x.Board)
-			copy(dAtA[i:], x.Board)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Board)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *Tournament) GetStatus() TournamentStatus {
+	if x != nil {
+		return x.Status
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*StoredGame)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoredGame: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Board = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Turn = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Black = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Red = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Tournament) GetSanctioned() bool {
+	if x != nil {
+		return x.Sanctioned
+	}
+	return false
+}
+
+func (x *Tournament) GetEntryFee() *v1beta1.Coin {
+	if x != nil {
+		return x.EntryFee
+	}
+	return nil
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *Tournament) GetPrizePool() *v1beta1.Coin {
+	if x != nil {
+		return x.PrizePool
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *Tournament) GetPrizeShares() []uint32 {
+	if x != nil {
+		return x.PrizeShares
 	}
+	return nil
 }
 
-var (
-	md_IndexedStoredGame            protoreflect.MessageDescriptor
-	fd_IndexedStoredGame_index      protoreflect.FieldDescriptor
-	fd_IndexedStoredGame_storedGame protoreflect.FieldDescriptor
-)
+func (x *Tournament) GetMaxPlayers() uint64 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
 
-func init() {
-	file_buzzing_checkers_v1_types_proto_init()
-	md_IndexedStoredGame = File_buzzing_checkers_v1_types_proto.Messages().ByName("IndexedStoredGame")
-	fd_IndexedStoredGame_index = md_IndexedStoredGame.Fields().ByName("index")
-	fd_IndexedStoredGame_storedGame = md_IndexedStoredGame.Fields().ByName("storedGame")
+func (x *Tournament) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
 }
 
-var _ protoreflect.Message = (*fastReflection_IndexedStoredGame)(nil)
+func (x *Tournament) GetTurnDuration() uint64 {
+	if x != nil {
+		return x.TurnDuration
+	}
+	return 0
+}
 
-type fastReflection_IndexedStoredGame IndexedStoredGame
+func (x *Tournament) GetRounds() uint32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
 
-func (x *IndexedStoredGame) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_IndexedStoredGame)(x)
+func (x *Tournament) GetCurrentRound() uint32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
 }
 
-func (x *IndexedStoredGame) slowProtoReflect() protoreflect.Message {
-	mi := &file_buzzing_checkers_v1_types_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *Tournament) GetRoundGames() []string {
+	if x != nil {
+		return x.RoundGames
+	}
+	return nil
+}
+
+func (x *Tournament) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// TournamentResult 为参赛玩家一轮的结果
+type TournamentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// opponent 为对手，为空时表示本轮轮空
+	Opponent string `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	// color 为玩家执子的颜色，轮空时为空
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// points 为本轮得分，以半分为单位：胜 2，和 1，负 0，轮空 2
+	Points uint32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	// game_index 为本轮的游戏索引，轮空时为空
+	GameIndex string `protobuf:"bytes,5,opt,name=game_index,json=gameIndex,proto3" json:"game_index,omitempty"`
+}
+
+func (x *TournamentResult) Reset() {
+	*x = TournamentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_IndexedStoredGame_messageType fastReflection_IndexedStoredGame_messageType
-var _ protoreflect.MessageType = fastReflection_IndexedStoredGame_messageType{}
+func (x *TournamentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_IndexedStoredGame_messageType struct{}
+func (*TournamentResult) ProtoMessage() {}
 
-func (x fastReflection_IndexedStoredGame_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_IndexedStoredGame)(nil)
+// Deprecated: Use TournamentResult.ProtoReflect.Descriptor instead.
+func (*TournamentResult) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{8}
 }
-func (x fastReflection_IndexedStoredGame_messageType) New() protoreflect.Message {
-	return new(fastReflection_IndexedStoredGame)
+
+func (x *TournamentResult) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
 }
-func (x fastReflection_IndexedStoredGame_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_IndexedStoredGame
+
+func (x *TournamentResult) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_IndexedStoredGame) Descriptor() protoreflect.MessageDescriptor {
-	return md_IndexedStoredGame
+func (x *TournamentResult) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_IndexedStoredGame) Type() protoreflect.MessageType {
-	return _fastReflection_IndexedStoredGame_messageType
+func (x *TournamentResult) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_IndexedStoredGame) New() protoreflect.Message {
-	return new(fastReflection_IndexedStoredGame)
+func (x *TournamentResult) GetGameIndex() string {
+	if x != nil {
+		return x.GameIndex
+	}
+	return ""
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_IndexedStoredGame) Interface() protoreflect.ProtoMessage {
-	return (*IndexedStoredGame)(x)
+// TournamentPlayer 为锦标赛的参赛玩家及其成绩
+type TournamentPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TournamentId uint64 `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Player       string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// score 为总得分，以半分为单位
+	Score uint64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// buchholz 为所有对手的总得分之和，以半分为单位，轮空不计
+	Buchholz uint64 `protobuf:"varint,4,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	// sonneborn_berger 为每局得分与对手总得分乘积之和，以四分之一分为单位，轮空不计
+	SonnebornBerger uint64              `protobuf:"varint,5,opt,name=sonneborn_berger,json=sonnebornBerger,proto3" json:"sonneborn_berger,omitempty"`
+	Results         []*TournamentResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_IndexedStoredGame) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Index != "" {
-		value := protoreflect.ValueOfString(x.Index)
-		if !f(fd_IndexedStoredGame_index, value) {
-			return
-		}
+func (x *TournamentPlayer) Reset() {
+	*x = TournamentPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if x.StoredGame != nil {
-		value := protoreflect.ValueOfMessage(x.StoredGame.ProtoReflect())
-		if !f(fd_IndexedStoredGame_storedGame, value) {
-			return
-		}
+}
+
+func (x *TournamentPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentPlayer) ProtoMessage() {}
+
+// Deprecated: Use TournamentPlayer.ProtoReflect.Descriptor instead.
+func (*TournamentPlayer) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TournamentPlayer) GetTournamentId() uint64 {
+	if x != nil {
+		return x.TournamentId
 	}
+	return 0
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_IndexedStoredGame) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		return x.Index != ""
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		return x.StoredGame != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+func (x *TournamentPlayer) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *TournamentPlayer) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TournamentPlayer) GetBuchholz() uint64 {
+	if x != nil {
+		return x.Buchholz
+	}
+	return 0
+}
+
+func (x *TournamentPlayer) GetSonnebornBerger() uint64 {
+	if x != nil {
+		return x.SonnebornBerger
+	}
+	return 0
+}
+
+func (x *TournamentPlayer) GetResults() []*TournamentResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Record 为一条 record，按 id 保存，并按作者和区块高度建立索引
+type Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id 为 record 的唯一编号，按添加的顺序递增
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height 和 time 为添加 record 的区块高度和区块头中的时间
+	Height int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Source RecordSource           `protobuf:"varint,4,opt,name=source,proto3,enum=buzzing.checkers.v1.RecordSource" json:"source,omitempty"`
+	Text   string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// creator 为通过 MsgAddRecord 添加 record 的作者，由模块添加时为空
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	// tags 为作者为 record 添加的标签，可以为空
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// prev_hash 为前一条 record 的 hash，第一条 record 为空
+	PrevHash []byte `protobuf:"bytes,8,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	// hash 为清空 hash 字段后 record 的 protobuf 编码的 SHA-256，包含 prev_hash，从而将 record 连接成链
+	Hash []byte `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Record) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Record) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Record) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Record) GetSource() RecordSource {
+	if x != nil {
+		return x.Source
+	}
+	return RecordSource_RECORD_SOURCE_UNSPECIFIED
+}
+
+func (x *Record) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Record) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Record) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Record) GetPrevHash() []byte {
+	if x != nil {
+		return x.PrevHash
+	}
+	return nil
+}
+
+func (x *Record) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// RecordLogHead 为 record 链的头部，即最后添加的 record
+type RecordLogHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *RecordLogHead) Reset() {
+	*x = RecordLogHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IndexedStoredGame) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		x.Index = ""
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		x.StoredGame = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+func (x *RecordLogHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordLogHead) ProtoMessage() {}
+
+// Deprecated: Use RecordLogHead.ProtoReflect.Descriptor instead.
+func (*RecordLogHead) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecordLogHead) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_IndexedStoredGame) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		value := x.Index
-		return protoreflect.ValueOfString(value)
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		value := x.StoredGame
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", descriptor.FullName()))
+func (x *RecordLogHead) GetHash() []byte {
+	if x != nil {
+		return x.Hash
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IndexedStoredGame) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		x.Index = value.Interface().(string)
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		x.StoredGame = value.Message().Interface().(*StoredGame)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+// RecordRateCounter 记录一个账户在一个区块窗口内通过 MsgAddRecord 添加的 record 数量
+type RecordRateCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// window_start 为窗口的第一个区块的高度
+	WindowStart int64  `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Count       uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RecordRateCounter) Reset() {
+	*x = RecordRateCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IndexedStoredGame) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		if x.StoredGame == nil {
-			x.StoredGame = new(StoredGame)
-		}
-		return protoreflect.ValueOfMessage(x.StoredGame.ProtoReflect())
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		panic(fmt.Errorf("field index of message buzzing.checkers.v1.IndexedStoredGame is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+func (x *RecordRateCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRateCounter) ProtoMessage() {}
+
+// Deprecated: Use RecordRateCounter.ProtoReflect.Descriptor instead.
+func (*RecordRateCounter) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RecordRateCounter) GetAuthor() string {
+	if x != nil {
+		return x.Author
 	}
+	return ""
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_IndexedStoredGame) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "buzzing.checkers.v1.IndexedStoredGame.index":
-		return protoreflect.ValueOfString("")
-	case "buzzing.checkers.v1.IndexedStoredGame.storedGame":
-		m := new(StoredGame)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: buzzing.checkers.v1.IndexedStoredGame"))
-		}
-		panic(fmt.Errorf("message buzzing.checkers.v1.IndexedStoredGame does not contain field %s", fd.FullName()))
+func (x *RecordRateCounter) GetWindowStart() int64 {
+	if x != nil {
+		return x.WindowStart
 	}
+	return 0
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_IndexedStoredGame) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in buzzing.checkers.v1.IndexedStoredGame", d.FullName()))
+func (x *RecordRateCounter) GetCount() uint64 {
+	if x != nil {
+		return x.Count
 	}
-	panic("unreachable")
+	return 0
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_IndexedStoredGame) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+// RemoteRecord 为通过 IBC 从其他链收到的 record，按 (channel, sequence) 保存
+type RemoteRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel 为本链接收数据包的通道，不同的对方链可能使用相同的通道 id，因此不使用对方的通道作为键
+	Channel  string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// source_port 和 source_channel 为对方链发送数据包的端口和通道
+	SourcePort    string `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// sender 为对方链上发送 record 的账户，使用对方链的地址前缀
+	Sender     string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	RecordData string `protobuf:"bytes,6,opt,name=record_data,json=recordData,proto3" json:"record_data,omitempty"`
+	// height 和 time 为本链收到数据包的区块高度和区块头中的时间
+	Height int64                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_IndexedStoredGame) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *RemoteRecord) Reset() {
+	*x = RemoteRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_IndexedStoredGame) IsValid() bool {
-	return x != nil
+func (x *RemoteRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_IndexedStoredGame) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*IndexedStoredGame)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Index)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.StoredGame != nil {
-			l = options.Size(x.StoredGame)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
+func (*RemoteRecord) ProtoMessage() {}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*IndexedStoredGame)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.StoredGame != nil {
-			encoded, err := options.Marshal(x.StoredGame)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Index) > 0 {
-			i -= len(x.Index)
-			copy(dAtA[i:], x.Index)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+// Deprecated: Use RemoteRecord.ProtoReflect.Descriptor instead.
+func (*RemoteRecord) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoteRecord) GetChannel() string {
+	if x != nil {
+		return x.Channel
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*IndexedStoredGame)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedStoredGame: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IndexedStoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Index = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.StoredGame == nil {
-					x.StoredGame = &StoredGame{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StoredGame); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *RemoteRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *RemoteRecord) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: buzzing/checkers/v1/types.proto
+func (x *RemoteRecord) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *RemoteRecord) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
 
-// Params 定义了 checkers 模块的参数
-type Params struct {
+func (x *RemoteRecord) GetRecordData() string {
+	if x != nil {
+		return x.RecordData
+	}
+	return ""
+}
+
+func (x *RemoteRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RemoteRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// OutgoingPacket 为本链发送的数据包，按 (channel, sequence) 保存
+type OutgoingPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Port     string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel  string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender 为发送数据包的账户，只有 sender 可以重新发送超时的数据包
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// sent_height 为发送数据包的区块高度
+	SentHeight            int64                `protobuf:"varint,6,opt,name=sent_height,json=sentHeight,proto3" json:"sent_height,omitempty"`
+	TimeoutRevisionNumber uint64               `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64               `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	TimeoutTimestamp      uint64               `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Status                OutgoingPacketStatus `protobuf:"varint,10,opt,name=status,proto3,enum=buzzing.checkers.v1.OutgoingPacketStatus" json:"status,omitempty"`
+	// result 为对方链返回的确认中的结果或错误信息
+	Result string `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
+	// resolved_height 为收到确认或超时的区块高度，处于 PENDING 状态时为 0
+	ResolvedHeight int64 `protobuf:"varint,12,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// data 为发送的 CheckersPacketData 的编码，与数据包中的数据相同
+	Data []byte `protobuf:"bytes,13,opt,name=data,proto3" json:"data,omitempty"`
+	// escrowed_fee 为发送 record 时托管在模块账户中的费用，denom 为空时表示没有费用
+	// 收到成功的确认后按 fee_destination 销毁或转入社区池，失败或超时时退回 sender
+	EscrowedFee    *v1beta1.Coin        `protobuf:"bytes,14,opt,name=escrowed_fee,json=escrowedFee,proto3" json:"escrowed_fee,omitempty"`
+	FeeDestination RecordFeeDestination `protobuf:"varint,15,opt,name=fee_destination,json=feeDestination,proto3,enum=buzzing.checkers.v1.RecordFeeDestination" json:"fee_destination,omitempty"`
+	// retry_sequence 为重新发送这个数据包时使用的序号，没有重新发送时为 0
+	RetrySequence uint64 `protobuf:"varint,16,opt,name=retry_sequence,json=retrySequence,proto3" json:"retry_sequence,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *OutgoingPacket) Reset() {
+	*x = OutgoingPacket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[0]
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *OutgoingPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*OutgoingPacket) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use OutgoingPacket.ProtoReflect.Descriptor instead.
+func (*OutgoingPacket) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
-// GenesisState 为 checkers 模块的创世状态
-type GenesisState struct {
+func (x *OutgoingPacket) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *OutgoingPacket) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *OutgoingPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *OutgoingPacket) GetSentHeight() int64 {
+	if x != nil {
+		return x.SentHeight
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetTimeoutRevisionNumber() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetTimeoutRevisionHeight() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetStatus() OutgoingPacketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OutgoingPacketStatus_OUTGOING_PACKET_STATUS_UNSPECIFIED
+}
+
+func (x *OutgoingPacket) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *OutgoingPacket) GetResolvedHeight() int64 {
+	if x != nil {
+		return x.ResolvedHeight
+	}
+	return 0
+}
+
+func (x *OutgoingPacket) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OutgoingPacket) GetEscrowedFee() *v1beta1.Coin {
+	if x != nil {
+		return x.EscrowedFee
+	}
+	return nil
+}
+
+func (x *OutgoingPacket) GetFeeDestination() RecordFeeDestination {
+	if x != nil {
+		return x.FeeDestination
+	}
+	return RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (x *OutgoingPacket) GetRetrySequence() uint64 {
+	if x != nil {
+		return x.RetrySequence
+	}
+	return 0
+}
+
+// IbcChannel 为模块的端口上已经开启的通道，在通道握手完成时保存，按 channel_id 保存
+type IbcChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params 定义了模块的所有参数
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// indexedStoredGameList 定义了所有的 StoredGame
-	IndexedStoredGameList []*IndexedStoredGame `protobuf:"bytes,2,rep,name=indexedStoredGameList,proto3" json:"indexedStoredGameList,omitempty"`
-	// 用于测试 BeginBlocker 函数的测试字段
-	RecordList []string `protobuf:"bytes,3,rep,name=recordList,proto3" json:"recordList,omitempty"`
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// counterparty_port_id 和 counterparty_channel_id 为对方链上的端口和通道
+	CounterpartyPortId    string   `protobuf:"bytes,3,opt,name=counterparty_port_id,json=counterpartyPortId,proto3" json:"counterparty_port_id,omitempty"`
+	CounterpartyChannelId string   `protobuf:"bytes,4,opt,name=counterparty_channel_id,json=counterpartyChannelId,proto3" json:"counterparty_channel_id,omitempty"`
+	ConnectionHops        []string `protobuf:"bytes,5,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty"`
+	Version               string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// opened_height 为通道握手完成的区块高度
+	OpenedHeight int64 `protobuf:"varint,7,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
+	// packets_sent 为本链在这个通道上发送的数据包数量
+	PacketsSent uint64 `protobuf:"varint,8,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	// packets_received 为本链在这个通道上成功处理的数据包数量
+	PacketsReceived uint64 `protobuf:"varint,9,opt,name=packets_received,json=packetsReceived,proto3" json:"packets_received,omitempty"`
+	// packets_failed 为本链发送后对方链返回错误或超时的数据包数量
+	PacketsFailed uint64 `protobuf:"varint,10,opt,name=packets_failed,json=packetsFailed,proto3" json:"packets_failed,omitempty"`
+	// closed 表示通道已经被关闭，关闭的通道不能再发送数据包
+	Closed bool `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
+func (x *IbcChannel) Reset() {
+	*x = IbcChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[1]
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenesisState) String() string {
+func (x *IbcChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenesisState) ProtoMessage() {}
+func (*IbcChannel) ProtoMessage() {}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use IbcChannel.ProtoReflect.Descriptor instead.
+func (*IbcChannel) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GenesisState) GetParams() *Params {
+func (x *IbcChannel) GetChannelId() string {
 	if x != nil {
-		return x.Params
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetIndexedStoredGameList() []*IndexedStoredGame {
+func (x *IbcChannel) GetPortId() string {
 	if x != nil {
-		return x.IndexedStoredGameList
+		return x.PortId
 	}
-	return nil
+	return ""
 }
 
-func (x *GenesisState) GetRecordList() []string {
+func (x *IbcChannel) GetCounterpartyPortId() string {
 	if x != nil {
-		return x.RecordList
+		return x.CounterpartyPortId
+	}
+	return ""
+}
+
+func (x *IbcChannel) GetCounterpartyChannelId() string {
+	if x != nil {
+		return x.CounterpartyChannelId
+	}
+	return ""
+}
+
+func (x *IbcChannel) GetConnectionHops() []string {
+	if x != nil {
+		return x.ConnectionHops
 	}
 	return nil
 }
 
-// StoredGame 为一局游戏进行到某一步时的状态
-type StoredGame struct {
+func (x *IbcChannel) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *IbcChannel) GetOpenedHeight() int64 {
+	if x != nil {
+		return x.OpenedHeight
+	}
+	return 0
+}
+
+func (x *IbcChannel) GetPacketsSent() uint64 {
+	if x != nil {
+		return x.PacketsSent
+	}
+	return 0
+}
+
+func (x *IbcChannel) GetPacketsReceived() uint64 {
+	if x != nil {
+		return x.PacketsReceived
+	}
+	return 0
+}
+
+func (x *IbcChannel) GetPacketsFailed() uint64 {
+	if x != nil {
+		return x.PacketsFailed
+	}
+	return 0
+}
+
+func (x *IbcChannel) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+// IbcGame 为与另一条链上的玩家进行的游戏，两条链各自保存一份，按 (channel, id) 保存
+// 玩家在本链上的操作立即生效，并通过数据包发送到对方链；对方链返回错误或数据包超时后，
+// 游戏恢复到操作之前的状态
+type IbcGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// board 定义了棋盘的状态，由规则文件(rules/checkers.go)序列化
-	Board string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
-	// turn 定义了当前回合的玩家
-	Turn string `protobuf:"bytes,2,opt,name=turn,proto3" json:"turn,omitempty"`
-	// black 定义了黑方玩家的地址
-	Black string `protobuf:"bytes,3,opt,name=black,proto3" json:"black,omitempty"`
-	// red 定义了红方玩家的地址
-	Red string `protobuf:"bytes,4,opt,name=red,proto3" json:"red,omitempty"`
+	// channel 为本链与对方链通信的通道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// id 为本链上的游戏 id，remote_id 为对方链上的游戏 id
+	// 本链发出的邀请在收到确认之前 remote_id 为 0
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RemoteId uint64 `protobuf:"varint,3,opt,name=remote_id,json=remoteId,proto3" json:"remote_id,omitempty"`
+	// local_player 为本链上的玩家，remote_player 为对方链上的玩家
+	LocalPlayer  string `protobuf:"bytes,4,opt,name=local_player,json=localPlayer,proto3" json:"local_player,omitempty"`
+	RemotePlayer string `protobuf:"bytes,5,opt,name=remote_player,json=remotePlayer,proto3" json:"remote_player,omitempty"`
+	// local_color 为本链上的玩家执的棋子颜色
+	LocalColor string `protobuf:"bytes,6,opt,name=local_color,json=localColor,proto3" json:"local_color,omitempty"`
+	// game 为游戏的状态，black 和 red 分别为双方在各自链上的地址
+	// 跨链游戏没有赌注和每一步棋的时限，也不计入对局统计
+	Game *StoredGame `protobuf:"bytes,7,opt,name=game,proto3" json:"game,omitempty"`
+	// pending_sequence 为本链发出、还没有收到确认的数据包的序号，为 0 时没有等待确认的数据包
+	PendingSequence uint64 `protobuf:"varint,8,opt,name=pending_sequence,json=pendingSequence,proto3" json:"pending_sequence,omitempty"`
+	// rollback 为发送 pending_sequence 之前游戏的状态，数据包失败时恢复
+	// 本链发出的邀请失败时游戏被删除，此时 rollback 为空
+	Rollback *StoredGame `protobuf:"bytes,9,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	// updated_time 为游戏最后一次改变的区块时间，本链发出的数据包在收到确认时更新
+	// 用于判断对手是否超时以及邀请是否过期
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
 }
 
-func (x *StoredGame) Reset() {
-	*x = StoredGame{}
+func (x *IbcGame) Reset() {
+	*x = IbcGame{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[2]
+		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StoredGame) String() string {
+func (x *IbcGame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoredGame) ProtoMessage() {}
+func (*IbcGame) ProtoMessage() {}
 
-// Deprecated: Use StoredGame.ProtoReflect.Descriptor instead.
-func (*StoredGame) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use IbcGame.ProtoReflect.Descriptor instead.
+func (*IbcGame) Descriptor() ([]byte, []int) {
+	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StoredGame) GetBoard() string {
+func (x *IbcGame) GetChannel() string {
 	if x != nil {
-		return x.Board
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *StoredGame) GetTurn() string {
+func (x *IbcGame) GetId() uint64 {
 	if x != nil {
-		return x.Turn
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *StoredGame) GetBlack() string {
+func (x *IbcGame) GetRemoteId() uint64 {
 	if x != nil {
-		return x.Black
+		return x.RemoteId
 	}
-	return ""
+	return 0
 }
 
-func (x *StoredGame) GetRed() string {
+func (x *IbcGame) GetLocalPlayer() string {
 	if x != nil {
-		return x.Red
+		return x.LocalPlayer
 	}
 	return ""
 }
 
-// IndexedStoredGame 为 StoredGame 的包装，用于索引
-type IndexedStoredGame struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Index      string      `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	StoredGame *StoredGame `protobuf:"bytes,2,opt,name=storedGame,proto3" json:"storedGame,omitempty"`
+func (x *IbcGame) GetRemotePlayer() string {
+	if x != nil {
+		return x.RemotePlayer
+	}
+	return ""
 }
 
-func (x *IndexedStoredGame) Reset() {
-	*x = IndexedStoredGame{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_buzzing_checkers_v1_types_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *IbcGame) GetLocalColor() string {
+	if x != nil {
+		return x.LocalColor
 	}
+	return ""
 }
 
-func (x *IndexedStoredGame) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IbcGame) GetGame() *StoredGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
 }
 
-func (*IndexedStoredGame) ProtoMessage() {}
-
-// Deprecated: Use IndexedStoredGame.ProtoReflect.Descriptor instead.
-func (*IndexedStoredGame) Descriptor() ([]byte, []int) {
-	return file_buzzing_checkers_v1_types_proto_rawDescGZIP(), []int{3}
+func (x *IbcGame) GetPendingSequence() uint64 {
+	if x != nil {
+		return x.PendingSequence
+	}
+	return 0
 }
 
-func (x *IndexedStoredGame) GetIndex() string {
+func (x *IbcGame) GetRollback() *StoredGame {
 	if x != nil {
-		return x.Index
+		return x.Rollback
 	}
-	return ""
+	return nil
 }
 
-func (x *IndexedStoredGame) GetStoredGame() *StoredGame {
+func (x *IbcGame) GetUpdatedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StoredGame
+		return x.UpdatedTime
 	}
 	return nil
 }
@@ -2390,51 +18261,508 @@ var file_buzzing_checkers_v1_types_proto_rawDesc = []byte{
 	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x62, 0x0a,
-	0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61,
-	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62,
-	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x47, 0x61, 0x6d, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x92, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c,
-	0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x05, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3a, 0x0a,
+	0x19, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x47,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x75, 0x72,
+	0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x54, 0x75, 0x72, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x57, 0x61, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x77, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x57, 0x61, 0x67, 0x65,
+	0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6e, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x65, 0x65, 0x12, 0x5f, 0x0a,
+	0x16, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xf6, 0x09, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x62, 0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x14, 0x74, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x6f, 0x67, 0x48,
+	0x65, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x75, 0x7a, 0x7a,
+	0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x52, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x12, 0x53, 0x0a, 0x10,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x59, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0b,
+	0x69, 0x62, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x62, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x69, 0x62, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x62, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x69, 0x62, 0x63, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x69, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xd8, 0x04,
+	0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x03, 0x72,
+	0x65, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x05,
+	0x77, 0x61, 0x67, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x77, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x8c, 0x01, 0x0a,
+	0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x0a,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x75, 0x72,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x61, 0x67,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x77, 0x61, 0x67, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x70, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x45, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x22, 0x84, 0x05, 0x0a, 0x0a, 0x54, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x03, 0x72, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x11, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x42, 0xd3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
+	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
 	0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
-	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x43, 0x58, 0xaa, 0x02, 0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
-	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x09,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x7a, 0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x69, 0x7a, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0b, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x91,
+	0x01, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x8d, 0x02, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x74, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f, 0x6c, 0x7a,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x75, 0x63, 0x68, 0x68, 0x6f, 0x6c, 0x7a,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x6e, 0x6e, 0x65, 0x62, 0x6f, 0x72, 0x6e, 0x5f, 0x62, 0x65,
+	0x72, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x6f, 0x6e, 0x6e,
+	0x65, 0x62, 0x6f, 0x72, 0x6e, 0x42, 0x65, 0x72, 0x67, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62,
+	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x39, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7e, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x97, 0x02, 0x0a,
+	0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x05, 0x0a, 0x0e, 0x4f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x0c, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x03,
+	0x0a, 0x0a, 0x49, 0x62, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x22, 0xbf, 0x03, 0x0a, 0x07, 0x49, 0x62, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04,
+	0x67, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x47, 0x0a, 0x0c,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x8a, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x22, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c,
+	0x10, 0x02, 0x2a, 0x8a, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x04, 0x2a,
+	0x75, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53,
+	0x57, 0x49, 0x53, 0x53, 0x10, 0x02, 0x2a, 0xb9, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x54,
+	0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0xb4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x53, 0x49, 0x53, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42,
+	0x45, 0x47, 0x49, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x4e,
+	0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43,
+	0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x58, 0x10, 0x04, 0x12,
+	0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x2a, 0xd4, 0x01, 0x0a, 0x14, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x22, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x55,
+	0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x27,
+	0x0a, 0x23, 0x4f, 0x55, 0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x55, 0x54, 0x47, 0x4f,
+	0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x55,
+	0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04,
+	0x42, 0xd3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x7a, 0x7a,
+	0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x43, 0x58,
+	0xaa, 0x02, 0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67,
+	0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x42,
+	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x15, 0x42, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2449,22 +18777,75 @@ func file_buzzing_checkers_v1_types_proto_rawDescGZIP() []byte {
 	return file_buzzing_checkers_v1_types_proto_rawDescData
 }
 
-var file_buzzing_checkers_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_buzzing_checkers_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_buzzing_checkers_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_buzzing_checkers_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),            // 0: buzzing.checkers.v1.Params
-	(*GenesisState)(nil),      // 1: buzzing.checkers.v1.GenesisState
-	(*StoredGame)(nil),        // 2: buzzing.checkers.v1.StoredGame
-	(*IndexedStoredGame)(nil), // 3: buzzing.checkers.v1.IndexedStoredGame
+	(RecordFeeDestination)(0),     // 0: buzzing.checkers.v1.RecordFeeDestination
+	(GameStatus)(0),               // 1: buzzing.checkers.v1.GameStatus
+	(TournamentFormat)(0),         // 2: buzzing.checkers.v1.TournamentFormat
+	(TournamentStatus)(0),         // 3: buzzing.checkers.v1.TournamentStatus
+	(RecordSource)(0),             // 4: buzzing.checkers.v1.RecordSource
+	(OutgoingPacketStatus)(0),     // 5: buzzing.checkers.v1.OutgoingPacketStatus
+	(*Params)(nil),                // 6: buzzing.checkers.v1.Params
+	(*GenesisState)(nil),          // 7: buzzing.checkers.v1.GenesisState
+	(*StoredGame)(nil),            // 8: buzzing.checkers.v1.StoredGame
+	(*PlayerInfo)(nil),            // 9: buzzing.checkers.v1.PlayerInfo
+	(*IndexedPlayerInfo)(nil),     // 10: buzzing.checkers.v1.IndexedPlayerInfo
+	(*QueueEntry)(nil),            // 11: buzzing.checkers.v1.QueueEntry
+	(*IndexedStoredGame)(nil),     // 12: buzzing.checkers.v1.IndexedStoredGame
+	(*Tournament)(nil),            // 13: buzzing.checkers.v1.Tournament
+	(*TournamentResult)(nil),      // 14: buzzing.checkers.v1.TournamentResult
+	(*TournamentPlayer)(nil),      // 15: buzzing.checkers.v1.TournamentPlayer
+	(*Record)(nil),                // 16: buzzing.checkers.v1.Record
+	(*RecordLogHead)(nil),         // 17: buzzing.checkers.v1.RecordLogHead
+	(*RecordRateCounter)(nil),     // 18: buzzing.checkers.v1.RecordRateCounter
+	(*RemoteRecord)(nil),          // 19: buzzing.checkers.v1.RemoteRecord
+	(*OutgoingPacket)(nil),        // 20: buzzing.checkers.v1.OutgoingPacket
+	(*IbcChannel)(nil),            // 21: buzzing.checkers.v1.IbcChannel
+	(*IbcGame)(nil),               // 22: buzzing.checkers.v1.IbcGame
+	(*v1beta1.Coin)(nil),          // 23: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
 }
 var file_buzzing_checkers_v1_types_proto_depIdxs = []int32{
-	0, // 0: buzzing.checkers.v1.GenesisState.params:type_name -> buzzing.checkers.v1.Params
-	3, // 1: buzzing.checkers.v1.GenesisState.indexedStoredGameList:type_name -> buzzing.checkers.v1.IndexedStoredGame
-	2, // 2: buzzing.checkers.v1.IndexedStoredGame.storedGame:type_name -> buzzing.checkers.v1.StoredGame
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	23, // 0: buzzing.checkers.v1.Params.record_fee:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 1: buzzing.checkers.v1.Params.record_fee_destination:type_name -> buzzing.checkers.v1.RecordFeeDestination
+	6,  // 2: buzzing.checkers.v1.GenesisState.params:type_name -> buzzing.checkers.v1.Params
+	12, // 3: buzzing.checkers.v1.GenesisState.indexedStoredGameList:type_name -> buzzing.checkers.v1.IndexedStoredGame
+	10, // 4: buzzing.checkers.v1.GenesisState.indexedPlayerInfoList:type_name -> buzzing.checkers.v1.IndexedPlayerInfo
+	11, // 5: buzzing.checkers.v1.GenesisState.queueEntryList:type_name -> buzzing.checkers.v1.QueueEntry
+	13, // 6: buzzing.checkers.v1.GenesisState.tournamentList:type_name -> buzzing.checkers.v1.Tournament
+	15, // 7: buzzing.checkers.v1.GenesisState.tournamentPlayerList:type_name -> buzzing.checkers.v1.TournamentPlayer
+	16, // 8: buzzing.checkers.v1.GenesisState.records:type_name -> buzzing.checkers.v1.Record
+	18, // 9: buzzing.checkers.v1.GenesisState.recordRateCounters:type_name -> buzzing.checkers.v1.RecordRateCounter
+	17, // 10: buzzing.checkers.v1.GenesisState.recordLogHead:type_name -> buzzing.checkers.v1.RecordLogHead
+	19, // 11: buzzing.checkers.v1.GenesisState.remoteRecordList:type_name -> buzzing.checkers.v1.RemoteRecord
+	20, // 12: buzzing.checkers.v1.GenesisState.outgoingPacketList:type_name -> buzzing.checkers.v1.OutgoingPacket
+	22, // 13: buzzing.checkers.v1.GenesisState.ibcGameList:type_name -> buzzing.checkers.v1.IbcGame
+	21, // 14: buzzing.checkers.v1.GenesisState.ibcChannelList:type_name -> buzzing.checkers.v1.IbcChannel
+	1,  // 15: buzzing.checkers.v1.StoredGame.status:type_name -> buzzing.checkers.v1.GameStatus
+	23, // 16: buzzing.checkers.v1.StoredGame.wager:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 17: buzzing.checkers.v1.IndexedPlayerInfo.playerInfo:type_name -> buzzing.checkers.v1.PlayerInfo
+	23, // 18: buzzing.checkers.v1.QueueEntry.wager:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 19: buzzing.checkers.v1.IndexedStoredGame.storedGame:type_name -> buzzing.checkers.v1.StoredGame
+	2,  // 20: buzzing.checkers.v1.Tournament.format:type_name -> buzzing.checkers.v1.TournamentFormat
+	3,  // 21: buzzing.checkers.v1.Tournament.status:type_name -> buzzing.checkers.v1.TournamentStatus
+	23, // 22: buzzing.checkers.v1.Tournament.entry_fee:type_name -> cosmos.base.v1beta1.Coin
+	23, // 23: buzzing.checkers.v1.Tournament.prize_pool:type_name -> cosmos.base.v1beta1.Coin
+	14, // 24: buzzing.checkers.v1.TournamentPlayer.results:type_name -> buzzing.checkers.v1.TournamentResult
+	24, // 25: buzzing.checkers.v1.Record.time:type_name -> google.protobuf.Timestamp
+	4,  // 26: buzzing.checkers.v1.Record.source:type_name -> buzzing.checkers.v1.RecordSource
+	24, // 27: buzzing.checkers.v1.RemoteRecord.time:type_name -> google.protobuf.Timestamp
+	5,  // 28: buzzing.checkers.v1.OutgoingPacket.status:type_name -> buzzing.checkers.v1.OutgoingPacketStatus
+	23, // 29: buzzing.checkers.v1.OutgoingPacket.escrowed_fee:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 30: buzzing.checkers.v1.OutgoingPacket.fee_destination:type_name -> buzzing.checkers.v1.RecordFeeDestination
+	8,  // 31: buzzing.checkers.v1.IbcGame.game:type_name -> buzzing.checkers.v1.StoredGame
+	8,  // 32: buzzing.checkers.v1.IbcGame.rollback:type_name -> buzzing.checkers.v1.StoredGame
+	24, // 33: buzzing.checkers.v1.IbcGame.updated_time:type_name -> google.protobuf.Timestamp
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_buzzing_checkers_v1_types_proto_init() }
@@ -2510,6 +18891,42 @@ func file_buzzing_checkers_v1_types_proto_init() {
 			}
 		}
 		file_buzzing_checkers_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexedPlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IndexedStoredGame); i {
 			case 0:
 				return &v.state
@@ -2521,19 +18938,140 @@ func file_buzzing_checkers_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tournament); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TournamentPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordLogHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordRateCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutgoingPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buzzing_checkers_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_buzzing_checkers_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_buzzing_checkers_v1_types_proto_goTypes,
 		DependencyIndexes: file_buzzing_checkers_v1_types_proto_depIdxs,
+		EnumInfos:         file_buzzing_checkers_v1_types_proto_enumTypes,
 		MessageInfos:      file_buzzing_checkers_v1_types_proto_msgTypes,
 	}.Build()
 	File_buzzing_checkers_v1_types_proto = out.File
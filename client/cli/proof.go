@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/buzzing/checkers/proof"
+)
+
+// proofOutput 为证明命令的输出，Value 和 Proof 可以直接用于 proof.Verify
+type proofOutput struct {
+	// Height 为查询的高度，需要使用高度为 Height+1 的区块头验证
+	Height int64           `json:"height"`
+	Key    []byte          `json:"key"`
+	Value  []byte          `json:"value"`
+	Proof  json.RawMessage `json:"proof"`
+}
+
+// NewProofCmd 返回查询游戏和 record 及其 merkle 证明的命令，参见 proof 包
+func NewProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkers-proof",
+		Short: "Query a game or a record with its merkle proof against the app hash",
+	}
+	cmd.AddCommand(
+		newProofQueryCmd("game index", "Query a game with its merkle proof", func(clientCtx client.Context, arg string) (proof.Proof, error) {
+			return proof.QueryGame(clientCtx, arg, clientCtx.Height)
+		}),
+		newProofQueryCmd("record id", "Query a record with its merkle proof", func(clientCtx client.Context, arg string) (proof.Proof, error) {
+			id, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return proof.Proof{}, err
+			}
+			return proof.QueryRecord(clientCtx, id, clientCtx.Height)
+		}),
+	)
+	return cmd
+}
+
+// newProofQueryCmd 返回一个查询证明的命令，query 根据唯一的参数查询证明
+func newProofQueryCmd(use, short string, query func(client.Context, string) (proof.Proof, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short + ", the proof must be verified with the header at the next height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			result, err := query(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			proofJSON, err := clientCtx.Codec.MarshalJSON(&result.Proof)
+			if err != nil {
+				return err
+			}
+			out, err := json.Marshal(proofOutput{
+				Height: result.Height,
+				Key:    result.Key,
+				Value:  result.Value,
+				Proof:  proofJSON,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,61 @@
+// Package cli 提供 autocli 无法生成的命令
+// autocli 只能为 gRPC 服务生成命令，这里的命令需要由应用添加到根命令中，例如
+//
+//	rootCmd.AddCommand(cli.NewVerifyRecordLogCmd(), cli.NewProofCmd())
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/buzzing/checkers"
+)
+
+// NewVerifyRecordLogCmd 返回验证导出的创世文件中 record 链的命令
+// 命令只读取文件，不连接节点，参见 checkers.VerifyRecordChain
+func NewVerifyRecordLogCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-record-log [genesis-file]",
+		Short: "Verify the hash chain of the records in an exported genesis file",
+		Long: `Verify the hash chain of the records in an exported genesis file without connecting to a node.
+Each record must match its hash, records with consecutive ids must be linked through prev_hash,
+and the record log head must match the last record. Links around deleted or pruned records cannot be checked.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+			moduleState, ok := appState[checkers.ModuleName]
+			if !ok {
+				return fmt.Errorf("%s genesis state not found in %s", checkers.ModuleName, args[0])
+			}
+
+			// 模块的创世状态中没有 Any 类型，不需要应用注册的接口
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			var genesis checkers.GenesisState
+			if err := cdc.UnmarshalJSON(moduleState, &genesis); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", checkers.ModuleName, err)
+			}
+			if err := genesis.VerifyRecordLog(); err != nil {
+				return err
+			}
+
+			if genesis.RecordLogHead == nil {
+				cmd.Println("no record has been added")
+				return nil
+			}
+			cmd.Printf("verified %d records, head %d %X\n", len(genesis.Records), genesis.RecordLogHead.Id, genesis.RecordLogHead.Hash)
+			return nil
+		},
+	}
+}
@@ -25,8 +25,32 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// MsgCreateGame 为某个特定的交易消息，注册这个消息为 sdk.Msg 接口的实现
 	// 使得框架能够识别和处理 MsgCreateGame 这个类型
 	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
 		&MsgCreateGame{},
+		&MsgCreateOpenGame{},
+		&MsgJoinGame{},
+		&MsgJoinQueue{},
+		&MsgLeaveQueue{},
+		&MsgCreateTournament{},
+		&MsgJoinTournament{},
+		&MsgAcceptGame{},
+		&MsgPlayMove{},
 		&MsgAddRecord{},
+		&MsgSendRecord{},
+		&MsgRetryPacket{},
+		&MsgInviteIbcGame{},
+		&MsgAcceptIbcGame{},
+		&MsgPlayIbcMove{},
+		&MsgResignIbcGame{},
+		&MsgClaimIbcGameTimeout{},
+		&MsgDeleteRecord{},
+		&MsgPruneRecords{},
+		&MsgSetDefaultChannel{},
+		&MsgResign{},
+		&MsgReject{},
+		&MsgOfferDraw{},
+		&MsgAcceptDraw{},
+		&MsgDeclineDraw{},
 	)
 	// cosmos SDK 使用接口注册机制来处理多态类型
 	// 通过注册接口和具体实现的关系，使得框架能够正确地序列化和反序列化这些类型
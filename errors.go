@@ -3,11 +3,61 @@ package checkers
 import "cosmossdk.io/errors"
 
 var (
-	ErrIndexTooLong     = errors.Register(ModuleName, 2, "index too long")
-	ErrDuplicateAddress = errors.Register(ModuleName, 3, "duplicate address")
-	ErrInvalidBlack     = errors.Register(ModuleName, 4, "black address is invalid: %s")
-	ErrInvalidRed       = errors.Register(ModuleName, 5, "red address is invalid: %s")
-	ErrGameNotParseable = errors.Register(ModuleName, 6, "game cannot be parsed")
+	ErrIndexTooLong          = errors.Register(ModuleName, 2, "index too long")
+	ErrDuplicateAddress      = errors.Register(ModuleName, 3, "duplicate address")
+	ErrInvalidBlack          = errors.Register(ModuleName, 4, "black address is invalid: %s")
+	ErrInvalidRed            = errors.Register(ModuleName, 5, "red address is invalid: %s")
+	ErrGameNotParseable      = errors.Register(ModuleName, 6, "game cannot be parsed")
+	ErrInvalidGameStatus     = errors.Register(ModuleName, 8, "game status is invalid")
+	ErrGameNotFound          = errors.Register(ModuleName, 9, "game by id not found")
+	ErrNotPlayer             = errors.Register(ModuleName, 10, "message creator is not a player")
+	ErrGameNotActive         = errors.Register(ModuleName, 11, "game is not active")
+	ErrGameAlreadyPlayed     = errors.Register(ModuleName, 12, "game has already been played")
+	ErrInvalidWinner         = errors.Register(ModuleName, 13, "winner is invalid")
+	ErrInvalidPlayerInfo     = errors.Register(ModuleName, 14, "player info is invalid")
+	ErrNotPlayerTurn         = errors.Register(ModuleName, 15, "player tried to play out of turn")
+	ErrWrongMove             = errors.Register(ModuleName, 16, "wrong move")
+	ErrDrawOfferPending      = errors.Register(ModuleName, 17, "a draw offer is already pending")
+	ErrNoDrawOffer           = errors.Register(ModuleName, 18, "no draw offer from the opponent")
+	ErrInvalidDrawOffer      = errors.Register(ModuleName, 19, "draw offer is invalid")
+	ErrGameNotPending        = errors.Register(ModuleName, 20, "game is not waiting for players to accept")
+	ErrAlreadyAccepted       = errors.Register(ModuleName, 21, "player has already accepted the game")
+	ErrInvalidWager          = errors.Register(ModuleName, 22, "wager is invalid")
+	ErrInvalidColor          = errors.Register(ModuleName, 23, "color is invalid")
+	ErrGameNotOpen           = errors.Register(ModuleName, 24, "game is not open for joining")
+	ErrCannotJoinOwnGame     = errors.Register(ModuleName, 25, "player cannot join their own game")
+	ErrRatingTooLow          = errors.Register(ModuleName, 26, "player rating is below the game minimum")
+	ErrAlreadyQueued         = errors.Register(ModuleName, 27, "player is already in the matchmaking queue")
+	ErrNotQueued             = errors.Register(ModuleName, 28, "player is not in the matchmaking queue")
+	ErrInvalidQueueEntry     = errors.Register(ModuleName, 29, "queue entry is invalid")
+	ErrTournamentNotFound    = errors.Register(ModuleName, 30, "tournament not found")
+	ErrInvalidTournament     = errors.Register(ModuleName, 31, "tournament is invalid")
+	ErrTournamentNotOpen     = errors.Register(ModuleName, 32, "tournament is not open for registration")
+	ErrTournamentFull        = errors.Register(ModuleName, 33, "tournament is full")
+	ErrAlreadyRegistered     = errors.Register(ModuleName, 34, "player is already registered in the tournament")
+	ErrTournamentGame        = errors.Register(ModuleName, 35, "tournament games cannot be rejected")
+	ErrNotAuthority          = errors.Register(ModuleName, 36, "signer is not the module authority")
+	ErrInvalidTurnDuration   = errors.Register(ModuleName, 37, "turn duration is invalid")
+	ErrTooManyActiveGames    = errors.Register(ModuleName, 38, "player has too many active games")
+	ErrRecordTooLong         = errors.Register(ModuleName, 39, "record is too long")
+	ErrInvalidEndReason      = errors.Register(ModuleName, 40, "game end reason is invalid")
+	ErrInvalidRecord         = errors.Register(ModuleName, 41, "record is invalid")
+	ErrInvalidPruneHeight    = errors.Register(ModuleName, 42, "records cannot be pruned at this height")
+	ErrRecordTooShort        = errors.Register(ModuleName, 43, "record is too short")
+	ErrRecordDenied          = errors.Register(ModuleName, 44, "record matches a denied pattern")
+	ErrRecordRateLimited     = errors.Register(ModuleName, 45, "too many records in the current window")
+	ErrInvalidRecordFee      = errors.Register(ModuleName, 46, "record fee cannot be collected")
+	ErrRecordNotFound        = errors.Register(ModuleName, 47, "record not found")
+	ErrNotRecordAuthor       = errors.Register(ModuleName, 48, "only the author can delete the record")
+	ErrRecordChainBroken     = errors.Register(ModuleName, 49, "record chain is broken")
+	ErrInvalidRecordRange    = errors.Register(ModuleName, 50, "record range is invalid")
+	ErrInvalidRemoteRecord   = errors.Register(ModuleName, 51, "remote record is invalid")
+	ErrInvalidOutgoingPacket = errors.Register(ModuleName, 52, "outgoing packet is invalid")
+	ErrInvalidIbcGame        = errors.Register(ModuleName, 53, "cross-chain game is invalid")
+	ErrIbcGamePending        = errors.Register(ModuleName, 54, "cross-chain game is waiting for an acknowledgement")
+	ErrIbcGameNotTimedOut    = errors.Register(ModuleName, 55, "opponent has not timed out")
+	ErrInvalidIbcChannel     = errors.Register(ModuleName, 56, "channel is invalid")
+	ErrQueueFull             = errors.Register(ModuleName, 57, "matchmaking queue is full")
 )
 
 var (
@@ -0,0 +1,34 @@
+package checkers
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper 定义了模块所需的 bank 模块的功能
+// 用于将玩家的赌注托管到模块账户，以及在游戏结束时支付或退回赌注
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	// BurnCoins 用于销毁 record 的费用，参见 Params.RecordFeeDestination
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	// GetAllBalances 用于检查模块账户的余额与托管的赌注一致，参见 keeper/invariants.go
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	// SpendableCoins 仅用于模拟测试，为随机生成的交易计算手续费
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AccountKeeper 定义了模块所需的 auth 模块的功能
+type AccountKeeper interface {
+	// GetAccount 仅用于模拟测试，为随机生成的交易读取账户的编号和序号
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	// GetModuleAddressAndPermissions 用于检查模块账户是否有销毁 record 费用的权限
+	GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string)
+}
+
+// DistributionKeeper 定义了模块所需的 distribution 模块的功能
+// 用于将 record 的费用转入社区池，没有 distribution 模块时为 nil
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -0,0 +1,39 @@
+package checkers
+
+import (
+	"context"
+
+	"github.com/buzzing/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GameKeeper 定义了 keeper 提供给其他模块的游戏接口
+// 其他模块应当通过该接口创建和操作游戏，而不是直接修改 keeper 的存储，
+// 接口的每个方法都会执行与对应消息相同的检查，并发出相同的事件、调用相同的回调
+type GameKeeper interface {
+	// CreateGame 在 index 处创建一局游戏
+	CreateGame(ctx context.Context, index string, newGame NewGame) error
+	// GetGame 读取 index 处的游戏，游戏不存在时返回 ErrGameNotFound
+	GetGame(ctx context.Context, index string) (StoredGame, error)
+	// ApplyMove 由 player 在 index 处的游戏中走一步棋
+	// 返回被吃掉的棋子的位置（没有吃子时为 -1）以及走棋后的获胜方
+	ApplyMove(ctx context.Context, index string, player string, from, to rules.Pos) (captured rules.Pos, winner string, err error)
+	// EndGame 以 winner 获胜结束 index 处正在进行的游戏，winner 为 "*" 时表示和棋
+	EndGame(ctx context.Context, index string, winner string, reason GameEndReason) error
+}
+
+// NewGame 描述了通过 GameKeeper.CreateGame 创建的游戏
+type NewGame struct {
+	// Creator 为创建游戏的账户，为空时表示游戏由其他模块创建
+	// Creator 是游戏的玩家时，创建游戏即表示接受邀请，并托管其赌注
+	Creator string
+	Black   string
+	Red     string
+	// Wager 为双方各自需要托管的赌注，为空时表示没有赌注
+	Wager sdk.Coin
+	// TurnDuration 为每一步棋的区块数，为 0 时使用 Params.MaxTurnDuration
+	TurnDuration uint64
+	// Started 为 true 时游戏创建后立即开始，双方都视为已接受
+	// 由于不能代替玩家托管赌注，已经开始的游戏不能设置赌注
+	Started bool
+}
@@ -9,11 +9,18 @@
 //     导出用于生成当前模块的状态快照
 package checkers
 
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState() *GenesisState {
-	record := GetFormatDates() + " by genesis state"
 	return &GenesisState{
-		Params:     DefaultParams(),
-		RecordList: []string{record},
+		Params: DefaultParams(),
 	}
 }
 
@@ -40,10 +47,155 @@ func (gs *GenesisState) Validate() error {
 		unique[indexedStoredGame.Index] = true
 	}
 
-	// RecordList 初始只包含一个由模块生成的记录
-	if len(gs.RecordList) != 1 {
-		return ErrRecordListSpecified
+	// 验证创世状态中的所有玩家统计
+	uniquePlayers := make(map[string]bool)
+	for _, indexedPlayerInfo := range gs.IndexedPlayerInfoList {
+		if _, err := sdk.AccAddressFromBech32(indexedPlayerInfo.Player); err != nil {
+			return errors.Wrapf(ErrInvalidPlayerInfo, "player %s: %s", indexedPlayerInfo.Player, err)
+		}
+		if uniquePlayers[indexedPlayerInfo.Player] {
+			return ErrDuplicateAddress
+		}
+		uniquePlayers[indexedPlayerInfo.Player] = true
+	}
+
+	// 验证创世状态中的匹配队列
+	uniqueQueued := make(map[string]bool)
+	for _, entry := range gs.QueueEntryList {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if uniqueQueued[entry.Player] {
+			return ErrDuplicateAddress
+		}
+		uniqueQueued[entry.Player] = true
+	}
+
+	// 验证创世状态中的锦标赛
+	uniqueTournaments := make(map[uint64]Tournament)
+	for _, tournament := range gs.TournamentList {
+		if err := tournament.Validate(); err != nil {
+			return err
+		}
+		if tournament.Id == 0 || tournament.Id > gs.TournamentCount {
+			return errors.Wrapf(ErrInvalidTournament, "id %d, tournament count %d", tournament.Id, gs.TournamentCount)
+		}
+		if _, ok := uniqueTournaments[tournament.Id]; ok {
+			return errors.Wrapf(ErrInvalidTournament, "duplicate id %d", tournament.Id)
+		}
+		uniqueTournaments[tournament.Id] = tournament
+	}
+	uniqueTournamentPlayers := make(map[string]bool)
+	for _, tournamentPlayer := range gs.TournamentPlayerList {
+		if err := tournamentPlayer.Validate(); err != nil {
+			return err
+		}
+		tournament, ok := uniqueTournaments[tournamentPlayer.TournamentId]
+		if !ok || !tournament.HasPlayer(tournamentPlayer.Player) {
+			return errors.Wrapf(ErrInvalidTournament, "player %s is not registered in tournament %d", tournamentPlayer.Player, tournamentPlayer.TournamentId)
+		}
+		key := fmt.Sprintf("%d/%s", tournamentPlayer.TournamentId, tournamentPlayer.Player)
+		if uniqueTournamentPlayers[key] {
+			return ErrDuplicateAddress
+		}
+		uniqueTournamentPlayers[key] = true
+	}
+
+	// 验证创世状态中的 record，为空时初始化阶段会写入一条 GENESIS record
+	// 版本 1 的 recordList 需要先通过 migrations/v2 的 MigrateGenesis 转换
+	if len(gs.RecordList) > 0 {
+		return errors.Wrapf(ErrInvalidRecord, "legacy recordList with %d records must be migrated", len(gs.RecordList))
+	}
+	uniqueRecords := make(map[uint64]bool)
+	for _, record := range gs.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.Id >= gs.RecordCount {
+			return errors.Wrapf(ErrInvalidRecord, "id %d, record count %d", record.Id, gs.RecordCount)
+		}
+		if uniqueRecords[record.Id] {
+			return errors.Wrapf(ErrInvalidRecord, "duplicate id %d", record.Id)
+		}
+		uniqueRecords[record.Id] = true
+	}
+	if gs.RecordLogHead != nil && gs.RecordLogHead.Id >= gs.RecordCount {
+		return errors.Wrapf(ErrRecordChainBroken, "head %d, record count %d", gs.RecordLogHead.Id, gs.RecordCount)
+	}
+	if err := gs.VerifyRecordLog(); err != nil {
+		return err
+	}
+	uniqueRemoteRecords := make(map[string]bool)
+	for _, remoteRecord := range gs.RemoteRecordList {
+		if err := remoteRecord.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", remoteRecord.Channel, remoteRecord.Sequence)
+		if uniqueRemoteRecords[key] {
+			return errors.Wrapf(ErrInvalidRemoteRecord, "duplicate packet %s", key)
+		}
+		uniqueRemoteRecords[key] = true
+	}
+	uniqueOutgoingPackets := make(map[string]bool)
+	for _, packet := range gs.OutgoingPacketList {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", packet.Channel, packet.Sequence)
+		if uniqueOutgoingPackets[key] {
+			return errors.Wrapf(ErrInvalidOutgoingPacket, "duplicate packet %s", key)
+		}
+		uniqueOutgoingPackets[key] = true
+	}
+	uniqueIbcGames := make(map[string]bool)
+	for _, ibcGame := range gs.IbcGameList {
+		if err := ibcGame.Validate(); err != nil {
+			return err
+		}
+		if ibcGame.Id > gs.IbcGameCount {
+			return errors.Wrapf(ErrInvalidIbcGame, "id %d, cross-chain game count %d", ibcGame.Id, gs.IbcGameCount)
+		}
+		key := fmt.Sprintf("%s/%d", ibcGame.Channel, ibcGame.Id)
+		if uniqueIbcGames[key] {
+			return errors.Wrapf(ErrInvalidIbcGame, "duplicate game %s", key)
+		}
+		uniqueIbcGames[key] = true
+	}
+	ibcChannels := make(map[string]IbcChannel)
+	for _, ibcChannel := range gs.IbcChannelList {
+		if err := ibcChannel.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ibcChannels[ibcChannel.ChannelId]; ok {
+			return errors.Wrapf(ErrInvalidIbcChannel, "duplicate channel %s", ibcChannel.ChannelId)
+		}
+		ibcChannels[ibcChannel.ChannelId] = ibcChannel
+	}
+	if gs.DefaultChannel != "" {
+		if ibcChannel, ok := ibcChannels[gs.DefaultChannel]; !ok || ibcChannel.Closed {
+			return errors.Wrapf(ErrInvalidIbcChannel, "default channel %s is not an open channel", gs.DefaultChannel)
+		}
+	}
+	uniqueRateCounters := make(map[string]bool)
+	for _, counter := range gs.RecordRateCounters {
+		if _, err := sdk.AccAddressFromBech32(counter.Author); err != nil {
+			return errors.Wrapf(ErrInvalidRecord, "rate counter author %s: %s", counter.Author, err)
+		}
+		if uniqueRateCounters[counter.Author] {
+			return ErrDuplicateAddress
+		}
+		uniqueRateCounters[counter.Author] = true
 	}
 
 	return nil
 }
+
+// VerifyRecordLog 按 id 的顺序验证创世状态中的 record 链，参见 VerifyRecordChain
+func (gs *GenesisState) VerifyRecordLog() error {
+	records := slices.Clone(gs.Records)
+	slices.SortFunc(records, func(a, b Record) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+	_, err := VerifyRecordChain(records, gs.RecordLogHead)
+	return err
+}
@@ -0,0 +1,63 @@
+package checkers
+
+import "context"
+
+// CheckersHooks 定义了其他模块可以实现的回调，用于在游戏状态变化后执行自己的逻辑
+// 回调在状态已经保存之后调用，交易中返回错误时整个交易失败；
+// EndBlock 中（邀请过期、超时判负、匹配队列和锦标赛创建或结束的游戏）返回错误时只丢弃回调自己的修改并记录日志，不会使链停止
+type CheckersHooks interface {
+	// AfterGameCreated 在游戏创建后调用，包括邀请、大厅、匹配队列和锦标赛创建的游戏
+	AfterGameCreated(ctx context.Context, index string, storedGame StoredGame) error
+	// AfterMovePlayed 在玩家走棋后调用，storedGame 为走棋后的游戏
+	AfterMovePlayed(ctx context.Context, index string, storedGame StoredGame, player string) error
+	// AfterGameEnded 在游戏结束、赌注和对局统计处理完成后调用
+	// 被拒绝或邀请过期而删除的游戏也会调用，此时 storedGame 为删除之前的游戏，状态不是 GAME_STATUS_FINISHED
+	AfterGameEnded(ctx context.Context, index string, storedGame StoredGame) error
+}
+
+// CheckersHooksWrapper 为 CheckersHooks 的包装，用于通过 depinject 注入
+// 每个模块最多提供一个，参见 module/depinject.go
+type CheckersHooksWrapper struct{ CheckersHooks }
+
+// IsOnePerModuleType 实现 depinject.OnePerModuleType 接口
+func (CheckersHooksWrapper) IsOnePerModuleType() {}
+
+// MultiCheckersHooks 将多个 CheckersHooks 组合为一个，按顺序依次调用
+type MultiCheckersHooks []CheckersHooks
+
+var _ CheckersHooks = MultiCheckersHooks{}
+
+// NewMultiCheckersHooks 创建 MultiCheckersHooks
+func NewMultiCheckersHooks(hooks ...CheckersHooks) MultiCheckersHooks {
+	return hooks
+}
+
+// AfterGameCreated 依次调用所有的 AfterGameCreated，遇到错误时停止
+func (h MultiCheckersHooks) AfterGameCreated(ctx context.Context, index string, storedGame StoredGame) error {
+	for _, hook := range h {
+		if err := hook.AfterGameCreated(ctx, index, storedGame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterMovePlayed 依次调用所有的 AfterMovePlayed，遇到错误时停止
+func (h MultiCheckersHooks) AfterMovePlayed(ctx context.Context, index string, storedGame StoredGame, player string) error {
+	for _, hook := range h {
+		if err := hook.AfterMovePlayed(ctx, index, storedGame, player); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterGameEnded 依次调用所有的 AfterGameEnded，遇到错误时停止
+func (h MultiCheckersHooks) AfterGameEnded(ctx context.Context, index string, storedGame StoredGame) error {
+	for _, hook := range h {
+		if err := hook.AfterGameEnded(ctx, index, storedGame); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package checkers
+
+import (
+	"cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate 验证模块的端口上已经开启的通道
+func (ibcChannel *IbcChannel) Validate() error {
+	if err := host.ChannelIdentifierValidator(ibcChannel.ChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidIbcChannel, "channel: %s", err)
+	}
+	if ibcChannel.PortId != PortId {
+		return errors.Wrapf(ErrInvalidIbcChannel, "port %s, expected %s", ibcChannel.PortId, PortId)
+	}
+	if err := host.PortIdentifierValidator(ibcChannel.CounterpartyPortId); err != nil {
+		return errors.Wrapf(ErrInvalidIbcChannel, "counterparty port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(ibcChannel.CounterpartyChannelId); err != nil {
+		return errors.Wrapf(ErrInvalidIbcChannel, "counterparty channel: %s", err)
+	}
+	if len(ibcChannel.ConnectionHops) == 0 {
+		return errors.Wrapf(ErrInvalidIbcChannel, "empty connection hops")
+	}
+	for _, connection := range ibcChannel.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connection); err != nil {
+			return errors.Wrapf(ErrInvalidIbcChannel, "connection: %s", err)
+		}
+	}
+	if ibcChannel.OpenedHeight < 0 {
+		return errors.Wrapf(ErrInvalidIbcChannel, "opened height %d", ibcChannel.OpenedHeight)
+	}
+	if ibcChannel.PacketsFailed > ibcChannel.PacketsSent {
+		return errors.Wrapf(ErrInvalidIbcChannel, "%d failed packets, %d sent", ibcChannel.PacketsFailed, ibcChannel.PacketsSent)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package checkers
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/buzzing/checkers/rules"
+)
+
+// IbcGameTurnTimeout 定义跨链游戏中对手超过多长时间没有走棋时，可以宣布对手超时
+// 两条链的区块高度无法比较，因此使用区块时间
+const IbcGameTurnTimeout = 24 * time.Hour
+
+// IbcInvitationTimeout 定义跨链游戏的邀请在最后一次改变之后多长时间仍未被接受时过期
+// 与本链的邀请一样，过期的邀请在 EndBlock 中删除，避免对方链发来的邀请无限制地占用存储
+const IbcInvitationTimeout = 24 * time.Hour
+
+// ValidateColor 检查 color 为黑方或红方
+func ValidateColor(color string) error {
+	switch color {
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+		return nil
+	default:
+		return errors.Wrapf(ErrInvalidColor, "%q", color)
+	}
+}
+
+// NewIbcGame 返回一局尚未被双方接受的跨链游戏，inviterColor 为发出邀请的一方执的棋子颜色
+// 发出邀请的一方视为已经接受
+func NewIbcGame(channel string, localPlayer string, remotePlayer string, localColor string, inviterColor string) IbcGame {
+	newBoard := rules.New()
+	storedGame := StoredGame{
+		Board:  newBoard.String(),
+		Turn:   rules.PieceStrings[newBoard.Turn],
+		Status: GameStatus_GAME_STATUS_PENDING,
+		Winner: rules.PieceStrings[rules.NO_PLAYER],
+	}
+	if localColor == rules.PieceStrings[rules.BLACK_PLAYER] {
+		storedGame.Black, storedGame.Red = localPlayer, remotePlayer
+	} else {
+		storedGame.Black, storedGame.Red = remotePlayer, localPlayer
+	}
+	if inviterColor == rules.PieceStrings[rules.BLACK_PLAYER] {
+		storedGame.BlackAccepted = true
+	} else {
+		storedGame.RedAccepted = true
+	}
+	return IbcGame{
+		Channel:      channel,
+		LocalPlayer:  localPlayer,
+		RemotePlayer: remotePlayer,
+		LocalColor:   localColor,
+		Game:         storedGame,
+	}
+}
+
+// RemoteColor 返回对方链上的玩家执的棋子颜色
+func (ibcGame *IbcGame) RemoteColor() string {
+	return OpponentColor(ibcGame.LocalColor)
+}
+
+// Validate 验证跨链游戏，对方链上的玩家只检查为 bech32 地址
+// 游戏的黑方和红方分属两条链，因此不使用 StoredGame.ValidatePlayers
+func (ibcGame *IbcGame) Validate() error {
+	if err := host.ChannelIdentifierValidator(ibcGame.Channel); err != nil {
+		return errors.Wrapf(ErrInvalidIbcGame, "channel: %s", err)
+	}
+	if ibcGame.Id == 0 {
+		return errors.Wrapf(ErrInvalidIbcGame, "id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(ibcGame.LocalPlayer); err != nil {
+		return errors.Wrapf(ErrInvalidIbcGame, "local player %s: %s", ibcGame.LocalPlayer, err)
+	}
+	if _, _, err := bech32.DecodeAndConvert(ibcGame.RemotePlayer); err != nil {
+		return errors.Wrapf(ErrInvalidIbcGame, "remote player %q: %s", ibcGame.RemotePlayer, err)
+	}
+	if err := ValidateColor(ibcGame.LocalColor); err != nil {
+		return err
+	}
+	if player, _ := ibcGame.Game.PlayerAddress(ibcGame.LocalColor); player != ibcGame.LocalPlayer {
+		return errors.Wrapf(ErrInvalidIbcGame, "local player %s does not play %s", ibcGame.LocalPlayer, ibcGame.LocalColor)
+	}
+	if player, _ := ibcGame.Game.PlayerAddress(ibcGame.RemoteColor()); player != ibcGame.RemotePlayer {
+		return errors.Wrapf(ErrInvalidIbcGame, "remote player %s does not play %s", ibcGame.RemotePlayer, ibcGame.RemoteColor())
+	}
+	if err := validateIbcStoredGame(&ibcGame.Game); err != nil {
+		return err
+	}
+	if ibcGame.PendingSequence == 0 && ibcGame.Rollback != nil {
+		return errors.Wrapf(ErrInvalidIbcGame, "rollback without a pending packet")
+	}
+	if ibcGame.Rollback != nil {
+		return validateIbcStoredGame(ibcGame.Rollback)
+	}
+	return nil
+}
+
+// validateIbcStoredGame 验证跨链游戏的状态，跨链游戏只会处于邀请、进行中和已结束三种状态
+func validateIbcStoredGame(storedGame *StoredGame) error {
+	switch storedGame.Status {
+	case GameStatus_GAME_STATUS_PENDING, GameStatus_GAME_STATUS_ACTIVE, GameStatus_GAME_STATUS_FINISHED:
+	default:
+		return errors.Wrapf(ErrInvalidGameStatus, "%s", storedGame.Status)
+	}
+	if storedGame.HasWager() {
+		return errors.Wrapf(ErrInvalidIbcGame, "cross-chain game cannot have a wager")
+	}
+	if err := storedGame.ValidateWinner(); err != nil {
+		return err
+	}
+	_, err := storedGame.ParseGame()
+	return err
+}
+
+// PlayIbcMove 在跨链游戏中走一步棋，走棋的合法性由 rules 判断
+// 发送方和接收方使用相同的逻辑，保证两条链上的游戏状态一致
+// 走棋后轮到走棋的一方无棋可走（包括棋子被吃光）时判负，游戏随即结束，参见 checkers.MoveWinner
+func PlayIbcMove(storedGame *StoredGame, from, to rules.Pos) (captured rules.Pos, winner string, err error) {
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return captured, "", err
+	}
+	captured, err = game.Move(from, to)
+	if err != nil {
+		return captured, "", errors.Wrapf(ErrWrongMove, "%s", err)
+	}
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.MoveCount++
+	winner = rules.PieceStrings[MoveWinner(game)]
+	if winner != rules.PieceStrings[rules.NO_PLAYER] {
+		storedGame.Status = GameStatus_GAME_STATUS_FINISHED
+		storedGame.Winner = winner
+	}
+	return captured, winner, nil
+}
+
+// SenderGameId 返回跨链游戏数据包中发送方链上的游戏 id，不是跨链游戏的数据包时返回 false
+func (packetData *CheckersPacketData) SenderGameId() (uint64, bool) {
+	switch packet := packetData.Packet.(type) {
+	case *CheckersPacketData_GameInvitePacket:
+		return packet.GameInvitePacket.SenderGameId, true
+	case *CheckersPacketData_GameAcceptPacket:
+		return packet.GameAcceptPacket.SenderGameId, true
+	case *CheckersPacketData_GameMovePacket:
+		return packet.GameMovePacket.SenderGameId, true
+	case *CheckersPacketData_GameResignPacket:
+		return packet.GameResignPacket.SenderGameId, true
+	case *CheckersPacketData_GameResultPacket:
+		return packet.GameResultPacket.SenderGameId, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+)
+
+var _ checkers.GameKeeper = (*Keeper)(nil)
+
+// CreateGame 在 index 处创建一局游戏，参见 checkers.GameKeeper
+// 与 MsgCreateGame 相同，检查 index、赌注、时限以及接受游戏的一方尚未结束的游戏数量
+// 游戏默认以邀请的形式创建，newGame.Started 为 true 时立即开始
+func (k *Keeper) CreateGame(ctx context.Context, index string, newGame checkers.NewGame) error {
+	if err := k.checkNewGameIndex(ctx, index); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.ValidateWager(newGame.Wager); err != nil {
+		return err
+	}
+	turnDuration, err := params.ValidateTurnDuration(newGame.TurnDuration)
+	if err != nil {
+		return err
+	}
+	if newGame.Started && checkers.HasWager(newGame.Wager) {
+		return errorsmod.Wrapf(checkers.ErrInvalidWager, "started game cannot have a wager")
+	}
+	// 只检查创建后即接受游戏的一方：已经开始的游戏为双方，否则为作为玩家的创建者
+	// 被邀请的一方在接受邀请时检查
+	var accepting []string
+	switch {
+	case newGame.Started && newGame.Black != newGame.Red:
+		accepting = []string{newGame.Black, newGame.Red}
+	case newGame.Started:
+		accepting = []string{newGame.Black}
+	case newGame.Creator == newGame.Black || newGame.Creator == newGame.Red:
+		accepting = []string{newGame.Creator}
+	}
+	for _, player := range accepting {
+		if err := k.checkActiveGames(ctx, params, player); err != nil {
+			return err
+		}
+	}
+
+	newBoard := rules.New()
+	storedGame := checkers.StoredGame{
+		Board:              newBoard.String(),
+		Turn:               rules.PieceStrings[newBoard.Turn],
+		Black:              newGame.Black,
+		Red:                newGame.Red,
+		Status:             checkers.GameStatus_GAME_STATUS_PENDING,
+		Winner:             rules.PieceStrings[rules.NO_PLAYER],
+		InvitationDeadline: sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(params.InvitationExpiryBlocks),
+		Wager:              newGame.Wager,
+		TurnDuration:       turnDuration,
+	}
+	if err := storedGame.Validate(); err != nil {
+		return err
+	}
+	if newGame.Started {
+		storedGame.BlackAccepted = true
+		storedGame.RedAccepted = true
+	} else if _, err := k.acceptSeats(ctx, &storedGame, newGame.Creator); err != nil {
+		// 创建者作为玩家时，创建游戏即表示接受邀请
+		return err
+	}
+	if storedGame.IsAccepted() {
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+		if err := k.scheduleDeadline(ctx, index, &storedGame); err != nil {
+			return err
+		}
+	}
+	if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+		return err
+	}
+
+	return k.afterGameCreated(ctx, index, newGame.Creator, storedGame)
+}
+
+// GetGame 读取 index 处的游戏，游戏不存在时返回 checkers.ErrGameNotFound
+func (k *Keeper) GetGame(ctx context.Context, index string) (checkers.StoredGame, error) {
+	storedGame, err := k.StoredGames.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return storedGame, errorsmod.Wrapf(checkers.ErrGameNotFound, "%s", index)
+	}
+	return storedGame, err
+}
+
+// ApplyMove 由 player 在 index 处的游戏中走一步棋，参见 checkers.GameKeeper
+// 与 MsgPlayMove 相同，游戏需要正在进行且轮到 player 走棋，走棋的合法性由 rules 判断
+// 走棋后轮到走棋的一方无棋可走（包括棋子被吃光）时判负，游戏随即结束，参见 checkers.MoveWinner
+func (k *Keeper) ApplyMove(ctx context.Context, index string, player string, from, to rules.Pos) (rules.Pos, string, error) {
+	noPos := rules.Pos{X: -1, Y: -1}
+	storedGame, color, err := k.getGameOfPlayer(ctx, index, player)
+	if err != nil {
+		return noPos, "", err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return noPos, "", errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s is %s", index, storedGame.Status)
+	}
+	if color != storedGame.Turn {
+		return noPos, "", errorsmod.Wrapf(checkers.ErrNotPlayerTurn, "%s", color)
+	}
+
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return noPos, "", err
+	}
+	captured, err := game.Move(from, to)
+	if err != nil {
+		return noPos, "", errorsmod.Wrapf(checkers.ErrWrongMove, "%s", err)
+	}
+
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.MoveCount++
+	// 对手走棋后，对手之前没有回应的和棋提议自动失效
+	if storedGame.DrawOffer != "" && storedGame.DrawOffer != color {
+		storedGame.DrawOffer = ""
+	}
+
+	winner := rules.PieceStrings[checkers.MoveWinner(game)]
+	finished := winner != rules.PieceStrings[rules.NO_PLAYER]
+	deadline := int64(0)
+	if !finished {
+		// 轮到对手走棋，重新开始计时
+		if err := k.scheduleDeadline(ctx, index, &storedGame); err != nil {
+			return noPos, "", err
+		}
+		deadline = storedGame.Deadline
+	}
+	// 结束游戏的一步也先保存走棋后的棋盘，使 AfterMovePlayed 读到的是已经保存的状态，之后再由 finishGame 结束游戏
+	if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+		return noPos, "", err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventMovePlayed{
+		GameIndex: index,
+		Player:    player,
+		FromX:     int32(from.X),
+		FromY:     int32(from.Y),
+		ToX:       int32(to.X),
+		ToY:       int32(to.Y),
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Board:     storedGame.Board,
+		Deadline:  deadline,
+	}); err != nil {
+		return noPos, "", err
+	}
+	if err := k.callHook(ctx, "AfterMovePlayed", func(ctx context.Context) error {
+		return k.Hooks().AfterMovePlayed(ctx, index, storedGame, player)
+	}); err != nil {
+		return noPos, "", err
+	}
+	// 走棋的事件之后才是游戏结束的事件
+	if finished {
+		if err := k.finishGame(ctx, index, storedGame, winner, checkers.GameEndReason_GAME_END_REASON_NO_MOVES); err != nil {
+			return noPos, "", err
+		}
+	}
+
+	return captured, winner, nil
+}
+
+// EndGame 以 winner 获胜结束 index 处正在进行的游戏，参见 checkers.GameKeeper
+// 与认输、和棋和超时相同，支付赌注、更新对局统计并调用 AfterGameEnded 回调
+func (k *Keeper) EndGame(ctx context.Context, index string, winner string, reason checkers.GameEndReason) error {
+	storedGame, err := k.GetGame(ctx, index)
+	if err != nil {
+		return err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s is %s", index, storedGame.Status)
+	}
+	switch winner {
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER], rules.PieceStrings[rules.NO_PLAYER]:
+	default:
+		return errorsmod.Wrapf(checkers.ErrInvalidWinner, "%s", winner)
+	}
+	if _, ok := checkers.GameEndReason_name[int32(reason)]; !ok || reason == checkers.GameEndReason_GAME_END_REASON_UNSPECIFIED {
+		return errorsmod.Wrapf(checkers.ErrInvalidEndReason, "%s", reason)
+	}
+	return k.finishGame(ctx, index, storedGame, winner, reason)
+}
+
+// checkNewGameIndex 对新游戏的 index 长度和重复性进行检查
+// 与 genesis.go 中对创世状态中的检查逻辑类似
+func (k *Keeper) checkNewGameIndex(ctx context.Context, index string) error {
+	if length := len([]byte(index)); length < 1 || length > checkers.MaxIndexLength {
+		return checkers.ErrIndexTooLong
+	}
+	if _, err := k.StoredGames.Get(ctx, index); err == nil || errors.Is(err, collections.ErrEncoding) {
+		return fmt.Errorf("game already exists at index %s", index)
+	}
+	return nil
+}
+
+// getGameOfPlayer 读取游戏，并确认 player 是该游戏的黑方或红方
+// 返回游戏以及 player 在游戏中执的棋子颜色
+func (k *Keeper) getGameOfPlayer(ctx context.Context, index string, player string) (checkers.StoredGame, string, error) {
+	storedGame, err := k.GetGame(ctx, index)
+	if err != nil {
+		return storedGame, "", err
+	}
+	color, found := storedGame.PlayerColor(player)
+	if !found {
+		return storedGame, "", errorsmod.Wrapf(checkers.ErrNotPlayer, "%s", player)
+	}
+	return storedGame, color, nil
+}
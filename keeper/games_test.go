@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/rules"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// 黑方走到 (3,4) 之后双方的棋子互相挡住，都无棋可走
+// 红方无棋可走时仍然轮到黑方，黑方也无棋可走，黑方判负
+const jammedBoard = "********|********|********|**b*****|********|b*b*b*b*|*r*b*b*b|r*r*r*r*"
+
+// 轮到走棋的一方无棋可走时判负，即使双方都还有棋子
+func TestApplyMoveFinishesGameWhenSideToMoveIsBlocked(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	black, red := testutil.Address(0), testutil.Address(1)
+	_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: black, Index: "1", Black: black, Red: red})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: red, GameIndex: "1"})
+	require.NoError(t, err)
+	storedGame, err := f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.NoError(t, err)
+	storedGame.Board = jammedBoard
+	require.NoError(t, f.Keeper.StoredGames.Set(f.Ctx, "1", storedGame))
+	// 回调读到的是已经保存的、走棋之后的游戏
+	var savedBoards []string
+	f.Keeper.SetHooks(testutil.Hooks{
+		MovePlayed: func(ctx context.Context, index string, moved checkers.StoredGame, _ string) error {
+			saved, err := f.Keeper.StoredGames.Get(ctx, index)
+			require.NoError(t, err)
+			require.Equal(t, moved.Board, saved.Board)
+			savedBoards = append(savedBoards, saved.Board)
+			return nil
+		},
+	})
+
+	from, to := rules.Pos{X: 2, Y: 3}, rules.Pos{X: 3, Y: 4}
+	_, winner, err := f.Keeper.ApplyMove(f.Ctx, "1", black, from, to)
+	require.NoError(t, err)
+	require.Equal(t, "r", winner)
+	require.Equal(t, []string{"********|********|********|********|***b****|b*b*b*b*|*r*b*b*b|r*r*r*r*"}, savedBoards)
+
+	storedGame, err = f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, storedGame.Status)
+	require.Equal(t, "r", storedGame.Winner)
+	require.Zero(t, storedGame.Deadline)
+	var ended []*checkers.EventGameEnded
+	for _, event := range f.Ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		if event, ok := typed.(*checkers.EventGameEnded); ok {
+			ended = append(ended, event)
+		}
+	}
+	require.Len(t, ended, 1)
+	require.Equal(t, checkers.GameEndReason_GAME_END_REASON_NO_MOVES, ended[0].Reason)
+	msg, broken := keeper.StoredGamesInvariant(f.Keeper)(f.Ctx)
+	require.False(t, broken, msg)
+
+	// 跨链游戏使用相同的规则
+	ibcGame := checkers.StoredGame{
+		Board:  jammedBoard,
+		Turn:   "b",
+		Black:  black,
+		Red:    red,
+		Status: checkers.GameStatus_GAME_STATUS_ACTIVE,
+		Winner: "*",
+	}
+	_, winner, err = checkers.PlayIbcMove(&ibcGame, from, to)
+	require.NoError(t, err)
+	require.Equal(t, "r", winner)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, ibcGame.Status)
+	require.Equal(t, "r", ibcGame.Winner)
+}
+
+// MsgPlayMove 由 ApplyMove 处理，只有轮到走棋的一方可以走棋
+func TestPlayMoveAppliesMove(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	black, red := testutil.Address(0), testutil.Address(1)
+	_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: black, Index: "1", Black: black, Red: red})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: red, GameIndex: "1"})
+	require.NoError(t, err)
+
+	_, err = ms.PlayMove(f.Ctx, &checkers.MsgPlayMove{Creator: red, GameIndex: "1", FromX: 0, FromY: 5, ToX: 1, ToY: 4})
+	require.Error(t, err)
+	response, err := ms.PlayMove(f.Ctx, &checkers.MsgPlayMove{Creator: black, GameIndex: "1", FromX: 1, FromY: 2, ToX: 2, ToY: 3})
+	require.NoError(t, err)
+	require.Equal(t, &checkers.MsgPlayMoveResponse{CapturedX: -1, CapturedY: -1, Winner: "*"}, response)
+
+	storedGame, err := f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, "r", storedGame.Turn)
+	require.Equal(t, uint64(1), storedGame.MoveCount)
+}
@@ -10,6 +10,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 	"github.com/buzzing/checkers"
 )
 
@@ -17,6 +20,10 @@ import (
 func (k *Keeper) InitGenesis(ctx context.Context, data *checkers.GenesisState) error {
 	// 初始化模块 Keeper 的 Params
 	// 参见 keeper/keeper.go 中的 Params 字段
+	// 创世状态的验证不能访问 keeper，在这里检查 record 的费用能否结算
+	if err := k.CheckRecordFeeDestination(data.Params); err != nil {
+		return err
+	}
 	if err := k.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -27,10 +34,92 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *checkers.GenesisState) e
 		if err := k.StoredGames.Set(ctx, indexedStoredGame.Index, indexedStoredGame.StoredGame); err != nil {
 			return err
 		}
+		// Deadlines 不导出，根据游戏的 deadline 重建
+		if deadline := indexedStoredGame.StoredGame.Deadline; deadline != 0 {
+			if err := k.Deadlines.Set(ctx, collections.Join(deadline, indexedStoredGame.Index)); err != nil {
+				return err
+			}
+		}
 	}
 
-	for _, record := range data.RecordList {
-		if err := k.RecordList.Set(ctx, record); err != nil {
+	for _, indexedPlayerInfo := range data.IndexedPlayerInfoList {
+		if err := k.PlayerInfo.Set(ctx, indexedPlayerInfo.Player, indexedPlayerInfo.PlayerInfo); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.QueueEntryList {
+		if err := k.enqueue(ctx, entry); err != nil {
+			return err
+		}
+	}
+
+	for _, tournament := range data.TournamentList {
+		if err := k.Tournaments.Set(ctx, tournament.Id, tournament); err != nil {
+			return err
+		}
+	}
+	for _, tournamentPlayer := range data.TournamentPlayerList {
+		if err := k.TournamentPlayers.Set(ctx, collections.Join(tournamentPlayer.TournamentId, tournamentPlayer.Player), tournamentPlayer); err != nil {
+			return err
+		}
+	}
+	// TournamentSeq 保存的是已经使用的 id 数量，下一个锦标赛的 id 为 TournamentSeq + 1
+	if err := k.TournamentSeq.Set(ctx, data.TournamentCount); err != nil {
+		return err
+	}
+
+	for _, record := range data.Records {
+		if err := k.Records.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+	}
+	// RecordSeq 保存的是下一个 record 的 id
+	if err := k.RecordSeq.Set(ctx, data.RecordCount); err != nil {
+		return err
+	}
+	if data.RecordLogHead != nil {
+		if err := k.RecordLogHead.Set(ctx, *data.RecordLogHead); err != nil {
+			return err
+		}
+	}
+	for _, remoteRecord := range data.RemoteRecordList {
+		if err := k.RemoteRecords.Set(ctx, collections.Join(remoteRecord.Channel, remoteRecord.Sequence), remoteRecord); err != nil {
+			return err
+		}
+	}
+	for _, packet := range data.OutgoingPacketList {
+		if err := k.OutgoingPackets.Set(ctx, collections.Join(packet.Channel, packet.Sequence), packet); err != nil {
+			return err
+		}
+	}
+	for _, ibcGame := range data.IbcGameList {
+		if err := k.IbcGames.Set(ctx, collections.Join(ibcGame.Channel, ibcGame.Id), ibcGame); err != nil {
+			return err
+		}
+	}
+	// IbcGameSeq 保存的是已经使用的 id 数量，下一局跨链游戏的 id 为 IbcGameSeq + 1
+	if err := k.IbcGameSeq.Set(ctx, data.IbcGameCount); err != nil {
+		return err
+	}
+	for _, ibcChannel := range data.IbcChannelList {
+		if err := k.IbcChannels.Set(ctx, ibcChannel.ChannelId, ibcChannel); err != nil {
+			return err
+		}
+	}
+	if data.DefaultChannel != "" {
+		if err := k.DefaultChannel.Set(ctx, data.DefaultChannel); err != nil {
+			return err
+		}
+	}
+	for _, counter := range data.RecordRateCounters {
+		if err := k.RecordRateCounters.Set(ctx, counter.Author, counter); err != nil {
+			return err
+		}
+	}
+	// 创世状态中没有 record 时，添加一条由创世状态产生的 record
+	if len(data.Records) == 0 {
+		if _, err := k.AddRecord(ctx, checkers.RecordSource_RECORD_SOURCE_GENESIS, "by genesis state", "", nil); err != nil {
 			return err
 		}
 	}
@@ -60,9 +149,101 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*checkers.GenesisState, err
 		return nil, err
 	}
 
-	var recordList []string
-	if err := k.RecordList.Walk(ctx, nil, func(record string) (bool, error) {
-		recordList = append(recordList, record)
+	var indexedPlayerInfos []checkers.IndexedPlayerInfo
+	if err := k.PlayerInfo.Walk(ctx, nil, func(player string, playerInfo checkers.PlayerInfo) (bool, error) {
+		indexedPlayerInfos = append(indexedPlayerInfos, checkers.IndexedPlayerInfo{
+			Player:     player,
+			PlayerInfo: playerInfo,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var queueEntries []checkers.QueueEntry
+	if err := k.Queue.Walk(ctx, nil, func(_ string, entry checkers.QueueEntry) (bool, error) {
+		queueEntries = append(queueEntries, entry)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var tournaments []checkers.Tournament
+	if err := k.Tournaments.Walk(ctx, nil, func(_ uint64, tournament checkers.Tournament) (bool, error) {
+		tournaments = append(tournaments, tournament)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	var tournamentPlayers []checkers.TournamentPlayer
+	if err := k.TournamentPlayers.Walk(ctx, nil, func(_ collections.Pair[uint64, string], tournamentPlayer checkers.TournamentPlayer) (bool, error) {
+		tournamentPlayers = append(tournamentPlayers, tournamentPlayer)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	tournamentCount, err := k.TournamentSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []checkers.Record
+	if err := k.Records.Walk(ctx, nil, func(_ uint64, record checkers.Record) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	recordCount, err := k.RecordSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var recordLogHead *checkers.RecordLogHead
+	head, err := k.RecordLogHead.Get(ctx)
+	if err == nil {
+		recordLogHead = &head
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	var remoteRecords []checkers.RemoteRecord
+	if err := k.RemoteRecords.Walk(ctx, nil, func(_ collections.Pair[string, uint64], remoteRecord checkers.RemoteRecord) (bool, error) {
+		remoteRecords = append(remoteRecords, remoteRecord)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	var outgoingPackets []checkers.OutgoingPacket
+	if err := k.OutgoingPackets.Walk(ctx, nil, func(_ collections.Pair[string, uint64], packet checkers.OutgoingPacket) (bool, error) {
+		outgoingPackets = append(outgoingPackets, packet)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	var ibcGames []checkers.IbcGame
+	if err := k.IbcGames.Walk(ctx, nil, func(_ collections.Pair[string, uint64], ibcGame checkers.IbcGame) (bool, error) {
+		ibcGames = append(ibcGames, ibcGame)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	ibcGameCount, err := k.IbcGameSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ibcChannels []checkers.IbcChannel
+	if err := k.IbcChannels.Walk(ctx, nil, func(_ string, ibcChannel checkers.IbcChannel) (bool, error) {
+		ibcChannels = append(ibcChannels, ibcChannel)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	defaultChannel, err := k.DefaultChannel.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	var recordRateCounters []checkers.RecordRateCounter
+	if err := k.RecordRateCounters.Walk(ctx, nil, func(_ string, counter checkers.RecordRateCounter) (bool, error) {
+		recordRateCounters = append(recordRateCounters, counter)
 		return false, nil
 	}); err != nil {
 		return nil, err
@@ -71,6 +252,20 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*checkers.GenesisState, err
 	return &checkers.GenesisState{
 		Params:                params,
 		IndexedStoredGameList: indexedStoredGames,
-		RecordList:            recordList,
+		IndexedPlayerInfoList: indexedPlayerInfos,
+		QueueEntryList:        queueEntries,
+		TournamentList:        tournaments,
+		TournamentPlayerList:  tournamentPlayers,
+		TournamentCount:       tournamentCount,
+		Records:               records,
+		RecordCount:           recordCount,
+		RecordRateCounters:    recordRateCounters,
+		RecordLogHead:         recordLogHead,
+		RemoteRecordList:      remoteRecords,
+		OutgoingPacketList:    outgoingPackets,
+		IbcGameList:           ibcGames,
+		IbcGameCount:          ibcGameCount,
+		IbcChannelList:        ibcChannels,
+		DefaultChannel:        defaultChannel,
 	}, nil
 }
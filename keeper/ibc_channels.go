@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// RegisterChannel 在模块的端口上的通道完成握手时保存通道
+// 对方链的端口和通道、连接和版本从 channel keeper 中读取，握手完成时已经写入
+func (k *Keeper) RegisterChannel(ctx sdk.Context, portId string, channelId string) error {
+	channel, found := k.ibcKeeperFn().ChannelKeeper.GetChannel(ctx, portId, channelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", portId, channelId)
+	}
+	ibcChannel := checkers.IbcChannel{
+		ChannelId:             channelId,
+		PortId:                portId,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionHops:        channel.ConnectionHops,
+		Version:               channel.Version,
+		OpenedHeight:          k.headerService.GetHeaderInfo(ctx).Height,
+	}
+	if err := k.IbcChannels.Set(ctx, channelId, ibcChannel); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&checkers.EventIbcChannelOpened{
+		ChannelId:             channelId,
+		CounterpartyPortId:    ibcChannel.CounterpartyPortId,
+		CounterpartyChannelId: ibcChannel.CounterpartyChannelId,
+	})
+}
+
+// CloseChannel 将已经关闭的通道标记为 closed，通道为默认通道时同时取消默认通道
+func (k *Keeper) CloseChannel(ctx sdk.Context, channelId string) error {
+	ibcChannel, err := k.IbcChannels.Get(ctx, channelId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ibcChannel.Closed = true
+	if err := k.IbcChannels.Set(ctx, channelId, ibcChannel); err != nil {
+		return err
+	}
+	if defaultChannel, err := k.DefaultChannel.Get(ctx); err == nil && defaultChannel == channelId {
+		if err := k.SetDefaultChannel(ctx, ""); err != nil {
+			return err
+		}
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&checkers.EventIbcChannelClosed{ChannelId: channelId})
+}
+
+// SetDefaultChannel 设置跨链功能的默认通道，channel 为空时取消默认通道
+// 默认通道必须是已经保存且没有关闭的通道
+func (k *Keeper) SetDefaultChannel(goCtx context.Context, channel string) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if channel == "" {
+		if err := k.DefaultChannel.Remove(ctx); err != nil {
+			return err
+		}
+	} else {
+		ibcChannel, err := k.IbcChannels.Get(ctx, channel)
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(checkers.ErrInvalidIbcChannel, "channel %s is not open on port %s", channel, checkers.PortId)
+		}
+		if err != nil {
+			return err
+		}
+		if ibcChannel.Closed {
+			return errorsmod.Wrapf(checkers.ErrInvalidIbcChannel, "channel %s is closed", channel)
+		}
+		if err := k.DefaultChannel.Set(ctx, channel); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&checkers.EventDefaultChannelSet{Channel: channel})
+}
+
+// resolveChannel 返回 channel，channel 为空时返回跨链功能的默认通道
+func (k *Keeper) resolveChannel(ctx context.Context, channel string) (string, error) {
+	if channel != "" {
+		return channel, nil
+	}
+	defaultChannel, err := k.DefaultChannel.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", errorsmod.Wrapf(checkers.ErrInvalidIbcChannel, "empty channel and no default channel")
+	}
+	return defaultChannel, err
+}
+
+// CountReceivedPacket 增加通道上成功处理的数据包数量
+func (k *Keeper) CountReceivedPacket(ctx context.Context, channelId string) error {
+	return k.updateChannelStats(ctx, channelId, func(ibcChannel *checkers.IbcChannel) {
+		ibcChannel.PacketsReceived++
+	})
+}
+
+// updateChannelStats 更新通道的数据包统计，没有保存的通道直接忽略
+func (k *Keeper) updateChannelStats(ctx context.Context, channelId string, update func(*checkers.IbcChannel)) error {
+	ibcChannel, err := k.IbcChannels.Get(ctx, channelId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	update(&ibcChannel)
+	return k.IbcChannels.Set(ctx, channelId, ibcChannel)
+}
@@ -0,0 +1,491 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+)
+
+// 跨链游戏在两条链上各保存一份，玩家在本链上的操作立即生效（乐观执行），
+// 同时把操作之前的状态保存在 IbcGame.Rollback 中，并通过数据包发送到对方链。
+// 对方链使用相同的规则检查操作，返回错误确认或数据包超时后，本链恢复到操作之前的状态。
+// 每局游戏同一时间最多只有一个本链发出、等待确认的数据包。
+
+// InviteIbcGame 由 creator 通过 channel 邀请对方链上的 invitee 进行一局跨链游戏，creator 执 color
+// 返回本链上的游戏 id 和数据包的序号，邀请失败时游戏被删除，channel 为空时使用跨链功能的默认通道
+func (k *Keeper) InviteIbcGame(goCtx context.Context, creator string, channel string, invitee string, color string) (uint64, uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channel, err := k.resolveChannel(ctx, channel)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := host.ChannelIdentifierValidator(channel); err != nil {
+		return 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "channel: %s", err)
+	}
+	if err := checkers.ValidateColor(color); err != nil {
+		return 0, 0, err
+	}
+	id, err := k.IbcGameSeq.Next(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	// 游戏 id 从 1 开始，0 表示对方链上的 id 未知
+	id++
+
+	ibcGame := checkers.NewIbcGame(channel, creator, invitee, color, color)
+	ibcGame.Id = id
+	sequence, err := k.sendIbcGamePacket(ctx, &ibcGame, nil, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameInvitePacket{GameInvitePacket: &checkers.GameInvitePacketData{
+			SenderGameId: id,
+			Inviter:      creator,
+			Invitee:      invitee,
+			InviterColor: color,
+		}},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return id, sequence, nil
+}
+
+// AcceptIbcGame 由 creator 接受对方链发来的游戏邀请，游戏随即开始
+func (k *Keeper) AcceptIbcGame(goCtx context.Context, creator string, channel string, id uint64) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ibcGame, err := k.getLocalIbcGame(ctx, channel, id, creator)
+	if err != nil {
+		return 0, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_PENDING {
+		return 0, errorsmod.Wrapf(checkers.ErrGameNotPending, "game %s/%d is %s", channel, id, ibcGame.Game.Status)
+	}
+
+	rollback := ibcGame.Game
+	if !acceptIbcSeat(&ibcGame.Game, ibcGame.LocalColor) {
+		return 0, errorsmod.Wrapf(checkers.ErrAlreadyAccepted, "%s", creator)
+	}
+	ibcGame.Game.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+	return k.sendIbcGamePacket(ctx, &ibcGame, &rollback, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameAcceptPacket{GameAcceptPacket: &checkers.GameAcceptPacketData{
+			SenderGameId:   ibcGame.Id,
+			ReceiverGameId: ibcGame.RemoteId,
+			Player:         creator,
+		}},
+	})
+}
+
+// PlayIbcMove 由 creator 在跨链游戏中走一步棋，走棋的合法性由 rules 判断
+func (k *Keeper) PlayIbcMove(goCtx context.Context, creator string, channel string, id uint64, from, to rules.Pos) (rules.Pos, string, uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	noPos := rules.Pos{X: -1, Y: -1}
+	ibcGame, err := k.getLocalIbcGame(ctx, channel, id, creator)
+	if err != nil {
+		return noPos, "", 0, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return noPos, "", 0, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s/%d is %s", channel, id, ibcGame.Game.Status)
+	}
+	if ibcGame.Game.Turn != ibcGame.LocalColor {
+		return noPos, "", 0, errorsmod.Wrapf(checkers.ErrNotPlayerTurn, "%s", ibcGame.LocalColor)
+	}
+
+	rollback := ibcGame.Game
+	captured, winner, err := checkers.PlayIbcMove(&ibcGame.Game, from, to)
+	if err != nil {
+		return noPos, "", 0, err
+	}
+	sequence, err := k.sendIbcGamePacket(ctx, &ibcGame, &rollback, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameMovePacket{GameMovePacket: &checkers.GameMovePacketData{
+			SenderGameId:   ibcGame.Id,
+			ReceiverGameId: ibcGame.RemoteId,
+			Player:         creator,
+			MoveCount:      rollback.MoveCount,
+			FromX:          uint64(from.X),
+			FromY:          uint64(from.Y),
+			ToX:            uint64(to.X),
+			ToY:            uint64(to.Y),
+		}},
+	})
+	if err != nil {
+		return noPos, "", 0, err
+	}
+	return captured, winner, sequence, nil
+}
+
+// ResignIbcGame 由 creator 在跨链游戏中认输，对方获胜
+func (k *Keeper) ResignIbcGame(goCtx context.Context, creator string, channel string, id uint64) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ibcGame, err := k.getLocalIbcGame(ctx, channel, id, creator)
+	if err != nil {
+		return 0, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return 0, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s/%d is %s", channel, id, ibcGame.Game.Status)
+	}
+
+	rollback := ibcGame.Game
+	finishIbcGame(&ibcGame.Game, ibcGame.RemoteColor())
+	return k.sendIbcGamePacket(ctx, &ibcGame, &rollback, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameResignPacket{GameResignPacket: &checkers.GameResignPacketData{
+			SenderGameId:   ibcGame.Id,
+			ReceiverGameId: ibcGame.RemoteId,
+			Player:         creator,
+			MoveCount:      rollback.MoveCount,
+		}},
+	})
+}
+
+// ClaimIbcGameTimeout 在对手超过 checkers.IbcGameTurnTimeout 没有走棋时，由 creator 宣布获胜
+func (k *Keeper) ClaimIbcGameTimeout(goCtx context.Context, creator string, channel string, id uint64) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ibcGame, err := k.getLocalIbcGame(ctx, channel, id, creator)
+	if err != nil {
+		return 0, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return 0, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s/%d is %s", channel, id, ibcGame.Game.Status)
+	}
+	if ibcGame.Game.Turn != ibcGame.RemoteColor() {
+		return 0, errorsmod.Wrapf(checkers.ErrIbcGameNotTimedOut, "it is the turn of %s", ibcGame.Game.Turn)
+	}
+	deadline := ibcGame.UpdatedTime.Add(checkers.IbcGameTurnTimeout)
+	if now := k.headerService.GetHeaderInfo(ctx).Time; now.Before(deadline) {
+		return 0, errorsmod.Wrapf(checkers.ErrIbcGameNotTimedOut, "opponent has until %s", deadline)
+	}
+
+	rollback := ibcGame.Game
+	finishIbcGame(&ibcGame.Game, ibcGame.LocalColor)
+	return k.sendIbcGamePacket(ctx, &ibcGame, &rollback, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameResultPacket{GameResultPacket: &checkers.GameResultPacketData{
+			SenderGameId:   ibcGame.Id,
+			ReceiverGameId: ibcGame.RemoteId,
+			Player:         creator,
+			MoveCount:      rollback.MoveCount,
+			Winner:         ibcGame.LocalColor,
+			Reason:         checkers.GameEndReason_GAME_END_REASON_TIMED_OUT,
+		}},
+	})
+}
+
+// ExpireIbcInvitations 删除已经过期、仍未被双方接受的跨链游戏邀请，参见 checkers.IbcInvitationTimeout
+// 在 EndBlock 中调用，通过 InvitationExpiry 索引只遍历已经过期的邀请
+// 对方链上的玩家之后接受邀请时，本链返回错误确认，对方链恢复游戏
+func (k *Keeper) ExpireIbcInvitations(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := k.headerService.GetHeaderInfo(ctx).Time
+
+	// 过期时间向下取整到秒，只删除过期时间早于当前这一秒的邀请，保证不会提前过期
+	// 先收集再删除，避免在遍历索引时修改索引
+	var expired []collections.Pair[string, uint64]
+	if err := k.IbcGames.Indexes.InvitationExpiry.RefKeys.Walk(ctx,
+		collections.NewPrefixUntilPairRange[int64, collections.Pair[string, uint64]](now.Unix()-1),
+		func(key collections.Pair[int64, collections.Pair[string, uint64]]) (bool, error) {
+			expired = append(expired, key.K2())
+			return false, nil
+		},
+	); err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := k.IbcGames.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&checkers.EventIbcGameDeleted{
+			Channel: key.K1(),
+			GameId:  key.K2(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnRecvGameInvitePacket 处理对方链发来的游戏邀请，在本链上创建游戏，等待被邀请的玩家接受
+// 邀请在 checkers.IbcInvitationTimeout 之内没有被接受时过期，参见 ExpireIbcInvitations
+func (k *Keeper) OnRecvGameInvitePacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.GameInvitePacketData) (checkers.GamePacketAck, error) {
+	if data.SenderGameId == 0 {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrInvalidIbcGame, "sender game id must be positive")
+	}
+	if err := checkers.ValidateColor(data.InviterColor); err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	id, err := k.IbcGameSeq.Next(ctx)
+	if err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	id++
+
+	ibcGame := checkers.NewIbcGame(packet.DestinationChannel, data.Invitee, data.Inviter, checkers.OpponentColor(data.InviterColor), data.InviterColor)
+	ibcGame.Id = id
+	ibcGame.RemoteId = data.SenderGameId
+	if err := k.setIbcGame(ctx, ibcGame, false); err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	return checkers.GamePacketAck{GameId: id}, nil
+}
+
+// OnRecvGameAcceptPacket 处理对方链上的玩家接受本链发出的游戏邀请，游戏随即开始
+func (k *Keeper) OnRecvGameAcceptPacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.GameAcceptPacketData) (checkers.GamePacketAck, error) {
+	ibcGame, err := k.getRemoteIbcGame(ctx, packet, data.ReceiverGameId, data.SenderGameId, data.Player, 0)
+	if err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_PENDING {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrGameNotPending, "game %d is %s", ibcGame.Id, ibcGame.Game.Status)
+	}
+	if !acceptIbcSeat(&ibcGame.Game, ibcGame.RemoteColor()) {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrAlreadyAccepted, "%s", data.Player)
+	}
+	ibcGame.Game.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+	return k.confirmIbcGame(ctx, ibcGame)
+}
+
+// OnRecvGameMovePacket 处理对方链上的玩家走的一步棋，使用与本链玩家相同的规则检查
+func (k *Keeper) OnRecvGameMovePacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.GameMovePacketData) (checkers.GamePacketAck, error) {
+	ibcGame, err := k.getRemoteIbcGame(ctx, packet, data.ReceiverGameId, data.SenderGameId, data.Player, data.MoveCount)
+	if err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %d is %s", ibcGame.Id, ibcGame.Game.Status)
+	}
+	if ibcGame.Game.Turn != ibcGame.RemoteColor() {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrNotPlayerTurn, "%s", ibcGame.RemoteColor())
+	}
+	if _, _, err := checkers.PlayIbcMove(&ibcGame.Game,
+		rules.Pos{X: int(data.FromX), Y: int(data.FromY)},
+		rules.Pos{X: int(data.ToX), Y: int(data.ToY)},
+	); err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	return k.confirmIbcGame(ctx, ibcGame)
+}
+
+// OnRecvGameResignPacket 处理对方链上的玩家认输，本链的玩家获胜
+func (k *Keeper) OnRecvGameResignPacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.GameResignPacketData) (checkers.GamePacketAck, error) {
+	ibcGame, err := k.getRemoteIbcGame(ctx, packet, data.ReceiverGameId, data.SenderGameId, data.Player, data.MoveCount)
+	if err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %d is %s", ibcGame.Id, ibcGame.Game.Status)
+	}
+	finishIbcGame(&ibcGame.Game, ibcGame.LocalColor)
+	return k.confirmIbcGame(ctx, ibcGame)
+}
+
+// OnRecvGameResultPacket 处理对方链上的玩家宣布本链的玩家超时
+// 两条链的区块时间不同，本链只检查轮到本链的玩家走棋，且双方的步数一致
+func (k *Keeper) OnRecvGameResultPacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.GameResultPacketData) (checkers.GamePacketAck, error) {
+	ibcGame, err := k.getRemoteIbcGame(ctx, packet, data.ReceiverGameId, data.SenderGameId, data.Player, data.MoveCount)
+	if err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %d is %s", ibcGame.Id, ibcGame.Game.Status)
+	}
+	if data.Reason != checkers.GameEndReason_GAME_END_REASON_TIMED_OUT {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrInvalidEndReason, "%s", data.Reason)
+	}
+	if data.Winner != ibcGame.RemoteColor() || ibcGame.Game.Turn != ibcGame.LocalColor {
+		return checkers.GamePacketAck{}, errorsmod.Wrapf(checkers.ErrInvalidWinner, "%s while it is the turn of %s", data.Winner, ibcGame.Game.Turn)
+	}
+	finishIbcGame(&ibcGame.Game, data.Winner)
+	return k.confirmIbcGame(ctx, ibcGame)
+}
+
+// OnAcknowledgementGamePacket 处理本链发出的跨链游戏数据包的确认
+// 成功时记录对方链上的游戏 id，失败时恢复到发送之前的状态
+func (k *Keeper) OnAcknowledgementGamePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data checkers.CheckersPacketData,
+	acknowledgement channeltypes.Acknowledgement,
+) error {
+	senderGameId, _ := data.SenderGameId()
+	switch resp := acknowledgement.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck checkers.GamePacketAck
+		if err := checkers.ModuleCdc.UnmarshalJSON(resp.Result, &packetAck); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal game packet acknowledgement: %v", err)
+		}
+		key := collections.Join(packet.SourceChannel, senderGameId)
+		ibcGame, err := k.IbcGames.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if ibcGame.RemoteId == 0 {
+			ibcGame.RemoteId = packetAck.GameId
+		}
+		if ibcGame.PendingSequence != packet.Sequence {
+			return k.IbcGames.Set(ctx, key, ibcGame)
+		}
+		// 对方在收到数据包时才能看到本链的操作，因此对方的回合和邀请的过期都从收到确认时开始计时，
+		// 而不是从本链乐观执行时开始
+		ibcGame.PendingSequence = 0
+		ibcGame.Rollback = nil
+		return k.setIbcGame(ctx, ibcGame, false)
+	case *channeltypes.Acknowledgement_Error:
+		return k.rollbackIbcGame(ctx, packet, senderGameId)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unrecognized acknowledgement response %T", resp)
+	}
+}
+
+// OnTimeoutGamePacket 处理本链发出的跨链游戏数据包的超时，游戏恢复到发送之前的状态
+func (k *Keeper) OnTimeoutGamePacket(ctx sdk.Context, packet channeltypes.Packet, data checkers.CheckersPacketData) error {
+	senderGameId, _ := data.SenderGameId()
+	return k.rollbackIbcGame(ctx, packet, senderGameId)
+}
+
+// sendIbcGamePacket 发送跨链游戏的数据包，并保存乐观执行后的游戏以及用于恢复的状态
+func (k *Keeper) sendIbcGamePacket(ctx sdk.Context, ibcGame *checkers.IbcGame, rollback *checkers.StoredGame, packetData checkers.CheckersPacketData) (uint64, error) {
+	// 发送之前检查游戏，避免发出对方链一定会拒绝的数据包
+	if err := ibcGame.Validate(); err != nil {
+		return 0, err
+	}
+	timeoutHeight, timeoutTimestamp, err := k.defaultPacketTimeout(ctx, checkers.PortId, ibcGame.Channel)
+	if err != nil {
+		return 0, err
+	}
+	sequence, err := k.SendCheckersPacket(ctx, ibcGame.LocalPlayer, packetData, checkers.PortId, ibcGame.Channel, timeoutHeight, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	ibcGame.PendingSequence = sequence
+	ibcGame.Rollback = rollback
+	if err := k.setIbcGame(ctx, *ibcGame, false); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// getLocalIbcGame 读取本链的玩家将要操作的跨链游戏，游戏不能有等待确认的数据包
+func (k *Keeper) getLocalIbcGame(ctx context.Context, channel string, id uint64, player string) (checkers.IbcGame, error) {
+	ibcGame, err := k.IbcGames.Get(ctx, collections.Join(channel, id))
+	if errors.Is(err, collections.ErrNotFound) {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrGameNotFound, "%s/%d", channel, id)
+	}
+	if err != nil {
+		return ibcGame, err
+	}
+	if ibcGame.LocalPlayer != player {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrNotPlayer, "%s", player)
+	}
+	if ibcGame.PendingSequence != 0 {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrIbcGamePending, "packet %d", ibcGame.PendingSequence)
+	}
+	return ibcGame, nil
+}
+
+// getRemoteIbcGame 读取对方链上的玩家操作的跨链游戏
+// 数据包中双方的游戏 id、玩家和操作之前的步数都必须与本链保存的游戏一致
+func (k *Keeper) getRemoteIbcGame(ctx context.Context, packet channeltypes.Packet, receiverGameId, senderGameId uint64, player string, moveCount uint64) (checkers.IbcGame, error) {
+	ibcGame, err := k.IbcGames.Get(ctx, collections.Join(packet.DestinationChannel, receiverGameId))
+	if errors.Is(err, collections.ErrNotFound) {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrGameNotFound, "%s/%d", packet.DestinationChannel, receiverGameId)
+	}
+	if err != nil {
+		return ibcGame, err
+	}
+	// 对方可能在本链收到邀请的确认之前就接受了邀请
+	if ibcGame.RemoteId != 0 && ibcGame.RemoteId != senderGameId {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrInvalidIbcGame, "sender game id %d, expected %d", senderGameId, ibcGame.RemoteId)
+	}
+	ibcGame.RemoteId = senderGameId
+	if ibcGame.RemotePlayer != player {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrNotPlayer, "%s", player)
+	}
+	if ibcGame.Game.MoveCount != moveCount {
+		return ibcGame, errorsmod.Wrapf(checkers.ErrInvalidIbcGame, "move count %d, expected %d", moveCount, ibcGame.Game.MoveCount)
+	}
+	return ibcGame, nil
+}
+
+// confirmIbcGame 保存对方链上的玩家操作后的游戏
+// 对方的操作基于本链最新的状态（步数一致），说明对方已经收到本链等待确认的数据包，无需再恢复
+func (k *Keeper) confirmIbcGame(ctx sdk.Context, ibcGame checkers.IbcGame) (checkers.GamePacketAck, error) {
+	ibcGame.PendingSequence = 0
+	ibcGame.Rollback = nil
+	if err := k.setIbcGame(ctx, ibcGame, false); err != nil {
+		return checkers.GamePacketAck{}, err
+	}
+	return checkers.GamePacketAck{GameId: ibcGame.Id}, nil
+}
+
+// rollbackIbcGame 在本链发出的数据包失败时恢复游戏，数据包已经不再等待确认时不做处理
+// 失败的数据包为邀请时删除游戏
+func (k *Keeper) rollbackIbcGame(ctx sdk.Context, packet channeltypes.Packet, id uint64) error {
+	key := collections.Join(packet.SourceChannel, id)
+	ibcGame, err := k.IbcGames.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ibcGame.PendingSequence != packet.Sequence {
+		return nil
+	}
+	if ibcGame.Rollback == nil {
+		if err := k.IbcGames.Remove(ctx, key); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&checkers.EventIbcGameDeleted{
+			Channel: ibcGame.Channel,
+			GameId:  ibcGame.Id,
+		})
+	}
+	ibcGame.Game = *ibcGame.Rollback
+	ibcGame.PendingSequence = 0
+	ibcGame.Rollback = nil
+	return k.setIbcGame(ctx, ibcGame, true)
+}
+
+// setIbcGame 保存改变后的跨链游戏，并记录改变的区块时间
+func (k *Keeper) setIbcGame(ctx sdk.Context, ibcGame checkers.IbcGame, rolledBack bool) error {
+	ibcGame.UpdatedTime = k.headerService.GetHeaderInfo(ctx).Time
+	if err := ibcGame.Validate(); err != nil {
+		return err
+	}
+	if err := k.IbcGames.Set(ctx, collections.Join(ibcGame.Channel, ibcGame.Id), ibcGame); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&checkers.EventIbcGameUpdated{
+		Channel:    ibcGame.Channel,
+		GameId:     ibcGame.Id,
+		Status:     ibcGame.Game.Status,
+		MoveCount:  ibcGame.Game.MoveCount,
+		Winner:     ibcGame.Game.Winner,
+		RolledBack: rolledBack,
+	})
+}
+
+// acceptIbcSeat 将 color 一方标记为已接受，已经接受时返回 false
+func acceptIbcSeat(storedGame *checkers.StoredGame, color string) bool {
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		accepted := !storedGame.BlackAccepted
+		storedGame.BlackAccepted = true
+		return accepted
+	}
+	accepted := !storedGame.RedAccepted
+	storedGame.RedAccepted = true
+	return accepted
+}
+
+// finishIbcGame 以 winner 获胜结束跨链游戏
+func finishIbcGame(storedGame *checkers.StoredGame, winner string) {
+	storedGame.Status = checkers.GameStatus_GAME_STATUS_FINISHED
+	storedGame.Winner = winner
+}
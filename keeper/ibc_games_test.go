@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+	"github.com/buzzing/checkers/testutil"
+)
+
+const testChannel = "channel-0"
+
+// blocksAfter 返回 WithHeight 的区块时间经过 duration 之后的区块高度
+func blocksAfter(height int64, duration time.Duration) int64 {
+	return height + int64(duration/(5*time.Second))
+}
+
+// ibcInvitationExpiries 返回 InvitationExpiry 索引中的所有 (过期时间, (通道, 游戏 id))
+func ibcInvitationExpiries(t *testing.T, f *testutil.Fixture) []collections.Pair[int64, collections.Pair[string, uint64]] {
+	t.Helper()
+	iter, err := f.Keeper.IbcGames.Indexes.InvitationExpiry.RefKeys.Iterate(f.Ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	return keys
+}
+
+// 对方链发来的邀请没有被接受时过期，不会一直占用存储
+func TestExpireIbcInvitationsRemovesReceivedInvitations(t *testing.T) {
+	f := testutil.NewFixture(t)
+	local, remote := testutil.Address(0), testutil.Address(1)
+	packet := channeltypes.Packet{Sequence: 1, DestinationChannel: testChannel}
+
+	ack, err := f.Keeper.OnRecvGameInvitePacket(f.Ctx, packet, checkers.GameInvitePacketData{
+		SenderGameId: 5,
+		Inviter:      remote,
+		Invitee:      local,
+		InviterColor: "b",
+	})
+	require.NoError(t, err)
+	key := collections.Join(testChannel, ack.GameId)
+	expiry := f.Ctx.BlockTime().Add(checkers.IbcInvitationTimeout)
+	require.Equal(t, []collections.Pair[int64, collections.Pair[string, uint64]]{
+		collections.Join(expiry.Unix(), key),
+	}, ibcInvitationExpiries(t, f))
+
+	// 过期时间当秒仍然有效
+	lastValid := blocksAfter(1, checkers.IbcInvitationTimeout)
+	require.NoError(t, f.Keeper.ExpireIbcInvitations(testutil.WithHeight(f.Ctx, lastValid)))
+	has, err := f.Keeper.IbcGames.Has(f.Ctx, key)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, f.Keeper.ExpireIbcInvitations(testutil.WithHeight(f.Ctx, lastValid+1)))
+	has, err = f.Keeper.IbcGames.Has(f.Ctx, key)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Empty(t, ibcInvitationExpiries(t, f))
+}
+
+// 本链发出的数据包在收到确认时才开始计时，等待确认的游戏不会过期，对手的回合也从确认时开始
+func TestAcknowledgementRestartsIbcGameClock(t *testing.T) {
+	f := testutil.NewFixture(t)
+	local, remote := testutil.Address(0), testutil.Address(1)
+
+	// 本链的黑方已经走了一步，数据包 3 还在等待确认
+	ibcGame := checkers.NewIbcGame(testChannel, local, remote, "b", "b")
+	ibcGame.Id = 1
+	ibcGame.RemoteId = 9
+	ibcGame.Game.RedAccepted = true
+	ibcGame.Game.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+	rollback := ibcGame.Game
+	_, _, err := checkers.PlayIbcMove(&ibcGame.Game, rules.Pos{X: 1, Y: 2}, rules.Pos{X: 2, Y: 3})
+	require.NoError(t, err)
+	ibcGame.PendingSequence = 3
+	ibcGame.Rollback = &rollback
+	ibcGame.UpdatedTime = f.Ctx.BlockTime()
+	key := collections.Join(testChannel, ibcGame.Id)
+	require.NoError(t, f.Keeper.IbcGames.Set(f.Ctx, key, ibcGame))
+	require.Empty(t, ibcInvitationExpiries(t, f))
+
+	ackHeight := int64(1000)
+	ackCtx := testutil.WithHeight(f.Ctx, ackHeight)
+	bz, err := checkers.ModuleCdc.MarshalJSON(&checkers.GamePacketAck{GameId: 9})
+	require.NoError(t, err)
+	require.NoError(t, f.Keeper.OnAcknowledgementGamePacket(ackCtx,
+		channeltypes.Packet{Sequence: 3, SourceChannel: testChannel},
+		checkers.CheckersPacketData{Packet: &checkers.CheckersPacketData_GameMovePacket{GameMovePacket: &checkers.GameMovePacketData{SenderGameId: ibcGame.Id}}},
+		channeltypes.NewResultAcknowledgement(bz),
+	))
+	acked, err := f.Keeper.IbcGames.Get(f.Ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, acked.PendingSequence)
+	require.Nil(t, acked.Rollback)
+	require.Equal(t, ackCtx.BlockTime(), acked.UpdatedTime)
+
+	// 从本链走棋开始计算已经超时，从收到确认开始计算还没有超时
+	claimHeight := blocksAfter(ackHeight, checkers.IbcGameTurnTimeout) - 1
+	require.Greater(t, claimHeight, blocksAfter(1, checkers.IbcGameTurnTimeout))
+	_, err = f.Keeper.ClaimIbcGameTimeout(testutil.WithHeight(f.Ctx, claimHeight), local, testChannel, ibcGame.Id)
+	require.ErrorIs(t, err, checkers.ErrIbcGameNotTimedOut)
+}
@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,10 +14,10 @@ import (
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
 	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
 )
 
-// SendRecordPacket 发送 RecordPacketData 数据包
-// 触发跨链发送的入口，通过指定的 sourcePort 和 sourceChannel 发送数据包
+// SendRecordPacket 将 RecordPacketData 放入 CheckersPacketData 中发送
 func (k *Keeper) SendRecordPacket(
 	ctx sdk.Context,
 	packetData checkers.RecordPacketData,
@@ -20,6 +25,22 @@ func (k *Keeper) SendRecordPacket(
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+) (uint64, error) {
+	return k.SendCheckersPacket(ctx, packetData.Sender, checkers.NewRecordPacket(packetData.RecordData, packetData.Sender),
+		sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// SendCheckersPacket 发送 CheckersPacketData 数据包
+// 触发跨链发送的入口，通过指定的 sourcePort 和 sourceChannel 发送数据包
+// 发送的数据包以 PENDING 状态保存在 OutgoingPackets 中，收到确认或超时后更新状态
+func (k *Keeper) SendCheckersPacket(
+	ctx sdk.Context,
+	sender string,
+	packetData checkers.CheckersPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
 ) (uint64, error) {
 	// 获取通道的 Capability，确保模块有权限使用该通道
 	// OCAP 模型，参见 keeper/keeper.go 中的介绍
@@ -29,48 +50,307 @@ func (k *Keeper) SendRecordPacket(
 			"module does not own channel capability for port %s channel %s", sourcePort, sourceChannel)
 	}
 
-	packetDataBytes, err := checkers.ModuleCdc.MarshalJSON(&packetData)
+	packetDataBytes, err := packetData.GetBytes()
 	if err != nil {
-		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %v", err)
+		return 0, err
 	}
 
-	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel,
+	sequence, err := k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel,
 		timeoutHeight, timeoutTimestamp, packetDataBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.OutgoingPackets.Set(ctx, collections.Join(sourceChannel, sequence), checkers.OutgoingPacket{
+		Port:                  sourcePort,
+		Channel:               sourceChannel,
+		Sequence:              sequence,
+		Sender:                sender,
+		Data:                  packetDataBytes,
+		SentHeight:            k.headerService.GetHeaderInfo(ctx).Height,
+		TimeoutRevisionNumber: timeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: timeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+		Status:                checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_PENDING,
+	}); err != nil {
+		return 0, err
+	}
+	if err := k.updateChannelStats(ctx, sourceChannel, func(ibcChannel *checkers.IbcChannel) {
+		ibcChannel.PacketsSent++
+	}); err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// SendRecord 通过 port 和 channel 发送一条由 creator 提交的 record，返回数据包的序号
+// record 与 MsgAddRecord 一样受内容限制和数量限制，费用托管在模块账户中，
+// 收到成功的确认后才销毁或转入社区池，失败或超时时退回，并撤销对数量限制的计数
+// 超时高度和超时时间均为零时，使用相对于对方链最新高度和当前区块时间的默认超时
+// channel 为空时使用跨链功能的默认通道，此时 port 为空表示模块的端口
+func (k *Keeper) SendRecord(
+	goCtx context.Context,
+	creator string,
+	port string,
+	channel string,
+	recordData string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if channel == "" && port == "" {
+		port = checkers.PortId
+	}
+	channel, err := k.resolveChannel(ctx, channel)
+	if err != nil {
+		return 0, err
+	}
+	if err := host.PortIdentifierValidator(port); err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(channel); err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "channel: %s", err)
+	}
+	params, creatorAddr, err := k.checkRecordPolicy(ctx, creator, recordData)
+	if err != nil {
+		return 0, err
+	}
+	fee, err := k.escrowRecordFee(ctx, params, creatorAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		if timeoutHeight, timeoutTimestamp, err = k.defaultPacketTimeout(ctx, port, channel); err != nil {
+			return 0, err
+		}
+	}
+
+	sequence, err := k.SendRecordPacket(ctx, checkers.RecordPacketData{
+		RecordData: recordData,
+		Sender:     creator,
+	}, port, channel, timeoutHeight, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	if checkers.HasWager(fee) {
+		key := collections.Join(channel, sequence)
+		outgoing, err := k.OutgoingPackets.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		outgoing.EscrowedFee = fee
+		outgoing.FeeDestination = params.RecordFeeDestination
+		if err := k.OutgoingPackets.Set(ctx, key, outgoing); err != nil {
+			return 0, err
+		}
+	}
+	return sequence, nil
+}
+
+// defaultPacketTimeout 返回默认的超时高度和超时时间
+// 超时高度为对方链的轻客户端最新高度之后 DefaultPacketTimeoutHeightDelta 个区块，
+// 超时时间为当前区块时间之后 DefaultPacketTimeoutDuration
+func (k *Keeper) defaultPacketTimeout(ctx sdk.Context, port, channel string) (clienttypes.Height, uint64, error) {
+	_, clientState, err := k.ibcKeeperFn().ChannelKeeper.GetChannelClientState(ctx, port, channel)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	latest := clientState.GetLatestHeight()
+	timeoutHeight := clienttypes.NewHeight(latest.GetRevisionNumber(), latest.GetRevisionHeight()+checkers.DefaultPacketTimeoutHeightDelta)
+	timeoutTimestamp := uint64(k.headerService.GetHeaderInfo(ctx).Time.Add(checkers.DefaultPacketTimeoutDuration).UnixNano())
+	return timeoutHeight, timeoutTimestamp, nil
 }
 
 // OnRecvRecordPacket 处理接收到的 RecordPacketData 数据包
-// 自定义处理逻辑的函数
+// record 需要满足与 MsgAddRecord 相同的长度和内容限制，以 (接收的通道, 序号) 为键保存
+// 对方链上的发送者不是本链的账户，因此不受数量限制，也不收取费用
 func (k *Keeper) OnRecvRecordPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data checkers.RecordPacketData,
 ) (packetAck checkers.RecordPacketAck, err error) {
-	// TODO: 自定义处理逻辑
+	if err := checkers.ValidateRemoteSender(data.Sender); err != nil {
+		return packetAck, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return packetAck, err
+	}
+	if err := k.validateRecord(params, data.RecordData); err != nil {
+		return packetAck, err
+	}
 
-	return packetAck, err
+	key := collections.Join(packet.DestinationChannel, packet.Sequence)
+	if found, err := k.RemoteRecords.Has(ctx, key); err != nil {
+		return packetAck, err
+	} else if found {
+		return packetAck, errorsmod.Wrapf(checkers.ErrInvalidRemoteRecord, "packet %s/%d already received", packet.DestinationChannel, packet.Sequence)
+	}
+
+	headerInfo := k.headerService.GetHeaderInfo(ctx)
+	if err := k.RemoteRecords.Set(ctx, key, checkers.RemoteRecord{
+		Channel:       packet.DestinationChannel,
+		Sequence:      packet.Sequence,
+		SourcePort:    packet.SourcePort,
+		SourceChannel: packet.SourceChannel,
+		Sender:        data.Sender,
+		RecordData:    data.RecordData,
+		Height:        headerInfo.Height,
+		Time:          headerInfo.Time,
+	}); err != nil {
+		return packetAck, err
+	}
+
+	return checkers.RecordPacketAck{
+		Result: fmt.Sprintf("record stored as %s/%d", packet.DestinationChannel, packet.Sequence),
+		Height: headerInfo.Height,
+	}, nil
 }
 
 // OnAcknowledgementRecordPacket 处理 RecordPacketData 数据包的确认
-// 自定义处理逻辑的函数
+// 对方链保存了 record 时结算托管的费用，否则退回费用并撤销计数
 func (k *Keeper) OnAcknowledgementRecordPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data checkers.RecordPacketData,
 	acknowledgement channeltypes.Acknowledgement,
 ) error {
-	// TODO: 自定义处理逻辑
-
-	return nil
+	if acknowledgement.Success() {
+		outgoing, found, err := k.getOutgoingPacket(ctx, packet)
+		if err != nil || !found {
+			return err
+		}
+		return k.settleRecordFee(ctx, outgoing.EscrowedFee, outgoing.FeeDestination)
+	}
+	return k.revertRecordPacket(ctx, packet)
 }
 
-// OnTimeoutRecordPacket 处理 RecordPacketData 数据包的超时
-// 自定义处理逻辑的函数
+// OnTimeoutRecordPacket 处理 RecordPacketData 数据包的超时，退回托管的费用并撤销计数
 func (k *Keeper) OnTimeoutRecordPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data checkers.RecordPacketData,
 ) error {
-	// TODO: 自定义处理逻辑
+	return k.revertRecordPacket(ctx, packet)
+}
+
+// revertRecordPacket 撤销没有被对方链保存的 record 的副作用，退回托管的费用并撤销对数量限制的计数
+func (k *Keeper) revertRecordPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	outgoing, found, err := k.getOutgoingPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+	if checkers.HasWager(outgoing.EscrowedFee) {
+		sender, err := k.addressCodec.StringToBytes(outgoing.Sender)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, checkers.ModuleName, sender, sdk.NewCoins(outgoing.EscrowedFee)); err != nil {
+			return err
+		}
+	}
+	return k.uncountRecord(ctx, outgoing.Sender, outgoing.SentHeight)
+}
 
-	return nil
+// ResolveOutgoingPacket 更新本链发送的数据包的最终状态，并保存对方链返回的确认
+// acknowledgement 为 nil 时表示数据包超时，没有被跟踪的数据包直接忽略
+func (k *Keeper) ResolveOutgoingPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement *channeltypes.Acknowledgement) error {
+	outgoing, found, err := k.getOutgoingPacket(ctx, packet)
+	if err != nil || !found {
+		return err
+	}
+	if acknowledgement == nil {
+		outgoing.Status = checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_TIMED_OUT
+	} else {
+		switch resp := acknowledgement.Response.(type) {
+		case *channeltypes.Acknowledgement_Result:
+			outgoing.Status = checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_ACKNOWLEDGED
+			outgoing.Result = string(resp.Result)
+		case *channeltypes.Acknowledgement_Error:
+			outgoing.Status = checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_FAILED
+			outgoing.Result = resp.Error
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unrecognized acknowledgement response %T", resp)
+		}
+	}
+	outgoing.ResolvedHeight = k.headerService.GetHeaderInfo(ctx).Height
+	if err := k.OutgoingPackets.Set(ctx, collections.Join(packet.SourceChannel, packet.Sequence), outgoing); err != nil {
+		return err
+	}
+	if outgoing.Status == checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_ACKNOWLEDGED {
+		return nil
+	}
+	return k.updateChannelStats(ctx, packet.SourceChannel, func(ibcChannel *checkers.IbcChannel) {
+		ibcChannel.PacketsFailed++
+	})
+}
+
+// RetryPacket 由 creator 重新发送 channel 上超时的数据包，返回新的数据包的序号，每个数据包只能重新发送一次
+// 数据包超时时其副作用已经撤销（record 的费用已退还，跨链游戏已恢复），因此重新发送等同于
+// creator 使用默认的超时再次执行原来的操作，需要再次通过相同的检查
+func (k *Keeper) RetryPacket(goCtx context.Context, creator string, channel string, sequence uint64) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	key := collections.Join(channel, sequence)
+	outgoing, err := k.OutgoingPackets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, errorsmod.Wrapf(checkers.ErrInvalidOutgoingPacket, "packet %s/%d not found", channel, sequence)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if outgoing.Sender != creator {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "packet %s/%d was sent by %s, got %s", channel, sequence, outgoing.Sender, creator)
+	}
+	if outgoing.Status != checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_TIMED_OUT {
+		return 0, errorsmod.Wrapf(checkers.ErrInvalidOutgoingPacket, "packet %s/%d is %s, only timed out packets can be retried", channel, sequence, outgoing.Status)
+	}
+	if outgoing.RetrySequence != 0 {
+		return 0, errorsmod.Wrapf(checkers.ErrInvalidOutgoingPacket, "packet %s/%d was already retried as %d", channel, sequence, outgoing.RetrySequence)
+	}
+	packetData, err := checkers.UnmarshalCheckersPacketData(outgoing.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	var retrySequence uint64
+	switch packet := packetData.Packet.(type) {
+	case *checkers.CheckersPacketData_RecordPacket:
+		retrySequence, err = k.SendRecord(ctx, creator, outgoing.Port, channel, packet.RecordPacket.RecordData, clienttypes.Height{}, 0)
+	case *checkers.CheckersPacketData_GameInvitePacket:
+		_, retrySequence, err = k.InviteIbcGame(ctx, creator, channel, packet.GameInvitePacket.Invitee, packet.GameInvitePacket.InviterColor)
+	case *checkers.CheckersPacketData_GameAcceptPacket:
+		retrySequence, err = k.AcceptIbcGame(ctx, creator, channel, packet.GameAcceptPacket.SenderGameId)
+	case *checkers.CheckersPacketData_GameMovePacket:
+		move := packet.GameMovePacket
+		_, _, retrySequence, err = k.PlayIbcMove(ctx, creator, channel, move.SenderGameId,
+			rules.Pos{X: int(move.FromX), Y: int(move.FromY)},
+			rules.Pos{X: int(move.ToX), Y: int(move.ToY)},
+		)
+	case *checkers.CheckersPacketData_GameResignPacket:
+		retrySequence, err = k.ResignIbcGame(ctx, creator, channel, packet.GameResignPacket.SenderGameId)
+	case *checkers.CheckersPacketData_GameResultPacket:
+		retrySequence, err = k.ClaimIbcGameTimeout(ctx, creator, channel, packet.GameResultPacket.SenderGameId)
+	default:
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type %T", packet)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	outgoing.RetrySequence = retrySequence
+	if err := k.OutgoingPackets.Set(ctx, key, outgoing); err != nil {
+		return 0, err
+	}
+	return retrySequence, nil
+}
+
+// getOutgoingPacket 读取本链发送的数据包，数据包没有被跟踪时返回 false
+func (k *Keeper) getOutgoingPacket(ctx sdk.Context, packet channeltypes.Packet) (checkers.OutgoingPacket, bool, error) {
+	outgoing, err := k.OutgoingPackets.Get(ctx, collections.Join(packet.SourceChannel, packet.Sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return outgoing, false, nil
+	}
+	return outgoing, err == nil, err
 }
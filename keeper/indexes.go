@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+)
+
+// MultiIndex 与 indexes.Multi 相同，将引用键映射到主键，区别在于
+//   - 一个值可以有多个引用键，例如一局游戏同时按黑方和红方的地址索引
+//   - 保存引用的 RefKeys 是公开的，可以通过 query.CollectionPaginate 和
+//     query.WithCollectionPaginationPairPrefix 直接对某个引用键下的主键分页
+type MultiIndex[ReferenceKey, PrimaryKey, Value any] struct {
+	getRefKeys func(pk PrimaryKey, value Value) ([]ReferenceKey, error)
+	// RefKeys 保存所有的 (引用键, 主键)，由 IndexedMap 维护，不能直接修改
+	RefKeys collections.KeySet[collections.Pair[ReferenceKey, PrimaryKey]]
+}
+
+// NewMultiIndex 创建 MultiIndex，并注册到 SchemaBuilder 中
+// getRefKeys 返回值的所有引用键，返回的引用键可以重复
+func NewMultiIndex[ReferenceKey, PrimaryKey, Value any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeys func(pk PrimaryKey, value Value) ([]ReferenceKey, error),
+) *MultiIndex[ReferenceKey, PrimaryKey, Value] {
+	// collections 的 Map.IterateRaw 直接在 prefix 之后 append 起点和终点，prefix 有多余的容量时两者共用底层数组，
+	// 起点会被终点覆盖，按 Int32Key 这样较短的引用键分页时结果为空，因此去掉多余的容量
+	prefix = slices.Clip(prefix)
+	return &MultiIndex[ReferenceKey, PrimaryKey, Value]{
+		getRefKeys: getRefKeys,
+		RefKeys:    collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(refCodec, pkCodec)),
+	}
+}
+
+// Reference 实现 collections.Index 接口，先删除旧值的引用，再添加新值的引用
+func (m *MultiIndex[ReferenceKey, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := m.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	refKeys, err := m.getRefKeys(pk, newValue)
+	if err != nil {
+		return err
+	}
+	for _, refKey := range refKeys {
+		if err := m.RefKeys.Set(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unreference 实现 collections.Index 接口，删除值的所有引用
+func (m *MultiIndex[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return m.unreference(ctx, pk, value)
+}
+
+func (m *MultiIndex[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKeys, err := m.getRefKeys(pk, value)
+	if err != nil {
+		return err
+	}
+	for _, refKey := range refKeys {
+		if err := m.RefKeys.Remove(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchExact 返回引用键为 refKey 的所有主键，按主键升序排列
+func (m *MultiIndex[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, refKey ReferenceKey) (indexes.MultiIterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := m.RefKeys.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+	return indexes.MultiIterator[ReferenceKey, PrimaryKey](iter), err
+}
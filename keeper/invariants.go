@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// invariantRoutes 为模块注册的所有不变量，按路由名称排列
+// 不变量由 crisis 模块定期检查，也可以通过 Query/Invariants 按需检查
+var invariantRoutes = []struct {
+	route     string
+	invariant func(k *Keeper) sdk.Invariant
+}{
+	{"deadline-queue", DeadlineQueueInvariant},
+	{"escrow-balance", EscrowBalanceInvariant},
+	{"queue-size", QueueSizeInvariant},
+	{"stored-games", StoredGamesInvariant},
+}
+
+// RegisterInvariants 将模块的所有不变量注册到 SDK 的不变量注册表中
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	for _, route := range invariantRoutes {
+		ir.RegisterRoute(checkers.ModuleName, route.route, route.invariant(k))
+	}
+}
+
+// AllInvariants 依次检查模块的所有不变量，返回第一个被破坏的不变量
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, route := range invariantRoutes {
+			if msg, broken := route.invariant(k)(ctx); broken {
+				return msg, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// StoredGamesInvariant 检查所有游戏都可以解析并通过验证，局面合法，
+// 且正在进行的游戏中轮到走棋的一方有棋可走（无棋可走时游戏已经结束，参见 checkers.MoveWinner）
+func StoredGamesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		err := k.StoredGames.Walk(ctx, nil, func(index string, storedGame checkers.StoredGame) (bool, error) {
+			if err := storedGame.Validate(); err != nil {
+				problems = append(problems, fmt.Sprintf("game %s is invalid: %s", index, err))
+				return false, nil
+			}
+			if err := storedGame.ValidatePosition(); err != nil {
+				problems = append(problems, fmt.Sprintf("game %s has an invalid position: %s", index, err))
+				return false, nil
+			}
+			if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+				return false, nil
+			}
+			game, err := storedGame.ParseGame()
+			if err != nil {
+				return true, err
+			}
+			if !game.PlayerHasMove(game.Turn) {
+				problems = append(problems, fmt.Sprintf("game %s is active but %s has no moves", index, storedGame.Turn))
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk stored games: %s", err))
+		}
+		return invariantResult("stored-games", "games", problems)
+	}
+}
+
+// DeadlineQueueInvariant 检查每一局正在进行的游戏都以其 deadline 恰好一次出现在 Deadlines 中，
+// 且 Deadlines 中没有其他的游戏
+func DeadlineQueueInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		queued := make(map[string][]int64)
+		err := k.Deadlines.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+			queued[key.K2()] = append(queued[key.K2()], key.K1())
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk deadlines: %s", err))
+		}
+
+		err = k.StoredGames.Walk(ctx, nil, func(index string, storedGame checkers.StoredGame) (bool, error) {
+			deadlines := queued[index]
+			delete(queued, index)
+			if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+				if len(deadlines) > 0 {
+					problems = append(problems, fmt.Sprintf("game %s is %s but queued at %v", index, storedGame.Status, deadlines))
+				}
+				return false, nil
+			}
+			if len(deadlines) != 1 || deadlines[0] != storedGame.Deadline || storedGame.Deadline == 0 {
+				problems = append(problems, fmt.Sprintf("active game %s has deadline %d but is queued at %v", index, storedGame.Deadline, deadlines))
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk stored games: %s", err))
+		}
+		// 剩下的是已经不存在的游戏，遍历 StoredGames 的顺序是确定的，这里需要再按索引排列
+		for _, index := range sortedKeys(queued) {
+			problems = append(problems, fmt.Sprintf("missing game %s is queued at %v", index, queued[index]))
+		}
+		return invariantResult("deadline-queue", "deadlines", problems)
+	}
+}
+
+// EscrowBalanceInvariant 检查模块账户的余额等于所有托管中的赌注之和，包括
+// 尚未结束的游戏中已经接受的一方的赌注、匹配队列中的赌注、尚未结束的锦标赛的奖池，
+// 以及尚未收到确认或超时的 record 数据包托管的费用
+func EscrowBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		expected := sdk.NewCoins()
+
+		err := k.StoredGames.Walk(ctx, nil, func(_ string, storedGame checkers.StoredGame) (bool, error) {
+			if storedGame.Status == checkers.GameStatus_GAME_STATUS_FINISHED || !storedGame.HasWager() {
+				return false, nil
+			}
+			if storedGame.BlackAccepted {
+				expected = expected.Add(storedGame.Wager)
+			}
+			if storedGame.RedAccepted {
+				expected = expected.Add(storedGame.Wager)
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk stored games: %s", err))
+		}
+		err = k.Queue.Walk(ctx, nil, func(_ string, entry checkers.QueueEntry) (bool, error) {
+			if entry.HasWager() {
+				expected = expected.Add(entry.Wager)
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk queue: %s", err))
+		}
+		err = k.Tournaments.Walk(ctx, nil, func(_ uint64, tournament checkers.Tournament) (bool, error) {
+			switch tournament.Status {
+			case checkers.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION, checkers.TournamentStatus_TOURNAMENT_STATUS_RUNNING:
+				if checkers.HasWager(tournament.PrizePool) {
+					expected = expected.Add(tournament.PrizePool)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk tournaments: %s", err))
+		}
+		err = k.OutgoingPackets.Walk(ctx, nil, func(_ collections.Pair[string, uint64], packet checkers.OutgoingPacket) (bool, error) {
+			if packet.Status == checkers.OutgoingPacketStatus_OUTGOING_PACKET_STATUS_PENDING && checkers.HasWager(packet.EscrowedFee) {
+				expected = expected.Add(packet.EscrowedFee)
+			}
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk outgoing packets: %s", err))
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(checkers.ModuleName))
+		if !balance.Equal(expected) {
+			problems = append(problems, fmt.Sprintf("module account balance %s, expected outstanding wagers %s", balance, expected))
+		}
+		return invariantResult("escrow-balance", "escrow", problems)
+	}
+}
+
+// QueueSizeInvariant 检查 QueueSize 等于匹配队列中的玩家数量
+func QueueSizeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		count := uint64(0)
+		err := k.Queue.Walk(ctx, nil, func(string, checkers.QueueEntry) (bool, error) {
+			count++
+			return false, nil
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to walk queue: %s", err))
+		}
+		size, err := k.queueSize(ctx)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("unable to get queue size: %s", err))
+		} else if size != count {
+			problems = append(problems, fmt.Sprintf("queue size %d, but %d players are queued", size, count))
+		}
+		return invariantResult("queue-size", "queue size", problems)
+	}
+}
+
+// invariantResult 将检查中发现的问题格式化为 sdk.Invariant 的返回值
+func invariantResult(route string, subject string, problems []string) (string, bool) {
+	if len(problems) == 0 {
+		return sdk.FormatInvariant(checkers.ModuleName, route, fmt.Sprintf("all %s are consistent\n", subject)), false
+	}
+	return sdk.FormatInvariant(checkers.ModuleName, route, fmt.Sprintf(
+		"found %d inconsistent %s:\n%s\n", len(problems), subject, strings.Join(problems, "\n"),
+	)), true
+}
+
+// sortedKeys 返回 m 的所有键，按升序排列
+func sortedKeys(m map[string][]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
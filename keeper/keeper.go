@@ -15,9 +15,11 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	storetypes "cosmossdk.io/core/store"
 	"github.com/buzzing/checkers"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,8 +33,166 @@ import (
 	// collections 是 cosmos SDK 提供的一个存储抽象层，提供了更高级和
 	// 类型安全的存储接口，以替代传统的 KVStore 操作
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 )
 
+// StoredGameIndexes 定义了 StoredGames 的二级索引
+// 每个索引将引用键（例如玩家地址）映射到游戏的索引（主键）
+// IndexedMap 在 Set 和 Remove 时会自动维护这些索引
+type StoredGameIndexes struct {
+	// Black 按黑方玩家地址索引游戏
+	Black *indexes.Multi[string, string, checkers.StoredGame]
+	// Red 按红方玩家地址索引游戏
+	Red *indexes.Multi[string, string, checkers.StoredGame]
+	// Player 按双方玩家的地址索引游戏，用于按玩家分页查询
+	Player *MultiIndex[string, string, checkers.StoredGame]
+	// Status 按游戏状态索引游戏
+	Status *MultiIndex[int32, string, checkers.StoredGame]
+	// InvitationExpiry 按邀请的过期高度索引处于 GAME_STATUS_PENDING 的游戏，EndBlock 通过它删除过期的邀请
+	InvitationExpiry *MultiIndex[int64, string, checkers.StoredGame]
+}
+
+// IndexesList 实现 collections.Indexes 接口，返回所有需要维护的索引
+func (i StoredGameIndexes) IndexesList() []collections.Index[string, checkers.StoredGame] {
+	return []collections.Index[string, checkers.StoredGame]{i.Black, i.Red, i.Player, i.Status, i.InvitationExpiry}
+}
+
+// NewStoredGameIndexes 创建 StoredGames 的二级索引，并注册到 SchemaBuilder 中
+func NewStoredGameIndexes(sb *collections.SchemaBuilder) StoredGameIndexes {
+	return StoredGameIndexes{
+		Black: indexes.NewMulti(
+			sb, checkers.StoredGamesBlackIndexKey, "storedGamesByBlack",
+			collections.StringKey, collections.StringKey,
+			func(_ string, storedGame checkers.StoredGame) (string, error) {
+				return storedGame.Black, nil
+			},
+		),
+		Red: indexes.NewMulti(
+			sb, checkers.StoredGamesRedIndexKey, "storedGamesByRed",
+			collections.StringKey, collections.StringKey,
+			func(_ string, storedGame checkers.StoredGame) (string, error) {
+				return storedGame.Red, nil
+			},
+		),
+		Player: NewMultiIndex(
+			sb, checkers.StoredGamesPlayerIndexKey, "storedGamesByPlayer",
+			collections.StringKey, collections.StringKey,
+			func(_ string, storedGame checkers.StoredGame) ([]string, error) {
+				// 大厅中的游戏还没有对手，空的座位不建立索引
+				var players []string
+				for _, player := range []string{storedGame.Black, storedGame.Red} {
+					if player != "" {
+						players = append(players, player)
+					}
+				}
+				return players, nil
+			},
+		),
+		Status: NewMultiIndex(
+			sb, checkers.StoredGamesStatusIndexKey, "storedGamesByStatus",
+			collections.Int32Key, collections.StringKey,
+			func(_ string, storedGame checkers.StoredGame) ([]int32, error) {
+				return []int32{int32(storedGame.Status)}, nil
+			},
+		),
+		InvitationExpiry: NewMultiIndex(
+			sb, checkers.StoredGamesInvitationExpiryIndexKey, "storedGamesByInvitationExpiry",
+			collections.Int64Key, collections.StringKey,
+			func(_ string, storedGame checkers.StoredGame) ([]int64, error) {
+				if storedGame.Status != checkers.GameStatus_GAME_STATUS_PENDING {
+					return nil, nil
+				}
+				return []int64{storedGame.InvitationDeadline}, nil
+			},
+		),
+	}
+}
+
+// TournamentIndexes 定义了 Tournaments 的二级索引
+type TournamentIndexes struct {
+	// Status 按锦标赛状态索引锦标赛
+	Status *indexes.Multi[int32, uint64, checkers.Tournament]
+}
+
+// IndexesList 实现 collections.Indexes 接口，返回所有需要维护的索引
+func (i TournamentIndexes) IndexesList() []collections.Index[uint64, checkers.Tournament] {
+	return []collections.Index[uint64, checkers.Tournament]{i.Status}
+}
+
+// NewTournamentIndexes 创建 Tournaments 的二级索引，并注册到 SchemaBuilder 中
+func NewTournamentIndexes(sb *collections.SchemaBuilder) TournamentIndexes {
+	return TournamentIndexes{
+		Status: indexes.NewMulti(
+			sb, checkers.TournamentsStatusIndexKey, "tournamentsByStatus",
+			collections.Int32Key, collections.Uint64Key,
+			func(_ uint64, tournament checkers.Tournament) (int32, error) {
+				return int32(tournament.Status), nil
+			},
+		),
+	}
+}
+
+// RecordIndexes 定义了 Records 的二级索引
+type RecordIndexes struct {
+	// Author 按作者地址索引 record，由模块添加的 record 作者为空
+	Author *indexes.Multi[string, uint64, checkers.Record]
+	// Height 按区块高度索引 record，EndBlock 通过它删除超过保留期的 record
+	Height *indexes.Multi[int64, uint64, checkers.Record]
+}
+
+// IndexesList 实现 collections.Indexes 接口，返回所有需要维护的索引
+func (i RecordIndexes) IndexesList() []collections.Index[uint64, checkers.Record] {
+	return []collections.Index[uint64, checkers.Record]{i.Author, i.Height}
+}
+
+// NewRecordIndexes 创建 Records 的二级索引，并注册到 SchemaBuilder 中
+func NewRecordIndexes(sb *collections.SchemaBuilder) RecordIndexes {
+	return RecordIndexes{
+		Author: indexes.NewMulti(
+			sb, checkers.RecordsAuthorIndexKey, "recordsByAuthor",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, record checkers.Record) (string, error) {
+				return record.Creator, nil
+			},
+		),
+		Height: indexes.NewMulti(
+			sb, checkers.RecordsHeightIndexKey, "recordsByHeight",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, record checkers.Record) (int64, error) {
+				return record.Height, nil
+			},
+		),
+	}
+}
+
+// IbcGameIndexes 定义了 IbcGames 的二级索引
+type IbcGameIndexes struct {
+	// InvitationExpiry 按邀请的过期时间（Unix 秒）索引处于 GAME_STATUS_PENDING 且没有等待确认的数据包的跨链游戏，
+	// EndBlock 通过它删除过期的邀请。本链发出的邀请在收到确认之后才开始计时
+	InvitationExpiry *MultiIndex[int64, collections.Pair[string, uint64], checkers.IbcGame]
+}
+
+// IndexesList 实现 collections.Indexes 接口，返回所有需要维护的索引
+func (i IbcGameIndexes) IndexesList() []collections.Index[collections.Pair[string, uint64], checkers.IbcGame] {
+	return []collections.Index[collections.Pair[string, uint64], checkers.IbcGame]{i.InvitationExpiry}
+}
+
+// NewIbcGameIndexes 创建 IbcGames 的二级索引，并注册到 SchemaBuilder 中
+func NewIbcGameIndexes(sb *collections.SchemaBuilder) IbcGameIndexes {
+	return IbcGameIndexes{
+		InvitationExpiry: NewMultiIndex(
+			sb, checkers.IbcGamesInvitationExpiryIndexKey, "ibcGamesByInvitationExpiry",
+			collections.Int64Key, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			func(_ collections.Pair[string, uint64], ibcGame checkers.IbcGame) ([]int64, error) {
+				if ibcGame.Game.Status != checkers.GameStatus_GAME_STATUS_PENDING || ibcGame.PendingSequence != 0 {
+					return nil, nil
+				}
+				return []int64{ibcGame.UpdatedTime.Add(checkers.IbcInvitationTimeout).Unix()}, nil
+			},
+		),
+	}
+}
+
 type Keeper struct {
 	// 数据序列化和反序列化的编解码器
 	cdc codec.BinaryCodec
@@ -44,6 +204,23 @@ type Keeper struct {
 	// 通常设置为 x/gov 模块账户
 	authority string
 
+	// bankKeeper 用于托管和支付玩家的赌注
+	bankKeeper checkers.BankKeeper
+	// accountKeeper 用于检查模块账户的权限，可以为 nil，此时不能销毁 record 的费用
+	accountKeeper checkers.AccountKeeper
+	// distrKeeper 用于将 record 的费用转入社区池，可以为 nil
+	distrKeeper checkers.DistributionKeeper
+
+	// headerService 提供当前区块的高度和时间，record 不能使用本地时钟
+	headerService header.Service
+
+	// hooks 指向其他模块注册的回调，参见 SetHooks
+	// 使用指针，使得在设置回调之前复制的 Keeper（例如 depinject 输出的 Keeper）也能看到回调
+	hooks *checkers.CheckersHooks
+	// recordDenylist 缓存 params.RecordDenylist 编译后的正则表达式，参见 validateRecord
+	// 与 hooks 一样使用指针，复制的 Keeper 共享同一个缓存
+	recordDenylist *recordDenylistCache
+
 	// Schema 用于存储和管理模块的状态结构，类似于数据表
 	// 模块的存储空间将由 Schema 组织和管理
 	// Schema 用来定义模块需要的所有存储项（例如 collections.Item 和 sollections.Map）
@@ -56,10 +233,50 @@ type Keeper struct {
 	// collections.Map 是一种映射类型，用于存储键值对
 	// 它提供了一组方法来操作键值对，例如 Set、Get、Has、Remove 等
 	// 因为 collections.Item 是一个 noKey 的 Map，它重写了 Set, Get 等方法，数据操作与 Map 类似
-	StoredGames collections.Map[string, checkers.StoredGame]
+	// collections.IndexedMap 在 Map 的基础上维护了一组二级索引（参见 StoredGameIndexes）
+	// 以便按玩家和状态查找游戏，而无需遍历全部游戏
+	StoredGames *collections.IndexedMap[string, checkers.StoredGame, StoredGameIndexes]
+
+	// PlayerInfo 用于存储玩家的对局统计，键为玩家地址
+	PlayerInfo collections.Map[string, checkers.PlayerInfo]
+
+	// Queue 用于存储匹配队列中的玩家，键为玩家地址
+	Queue collections.Map[string, checkers.QueueEntry]
+	// QueueSize 为匹配队列中的玩家数量，由 enqueue 和 dequeue 维护，加入队列时不需要遍历队列
+	QueueSize collections.Item[uint64]
+	// Deadlines 为按 (deadline, 游戏索引) 排序的限时游戏集合
+	// EndBlock 只需从头遍历到当前区块高度，即可找出所有超时的游戏
+	Deadlines collections.KeySet[collections.Pair[int64, string]]
+
+	// Tournaments 用于存储锦标赛，键为锦标赛 id
+	Tournaments *collections.IndexedMap[uint64, checkers.Tournament, TournamentIndexes]
+	// TournamentSeq 用于生成锦标赛 id，从 1 开始
+	TournamentSeq collections.Sequence
+	// TournamentPlayers 用于存储锦标赛的参赛玩家，键为 (锦标赛 id, 玩家地址)
+	TournamentPlayers collections.Map[collections.Pair[uint64, string], checkers.TournamentPlayer]
 
-	// 用于测试 BeginBlocker 函数的测试字段
-	RecordList collections.KeySet[string]
+	// Records 用于存储 record，键为 record id，并按作者和区块高度建立索引
+	Records *collections.IndexedMap[uint64, checkers.Record, RecordIndexes]
+	// RecordSeq 用于生成 record id，从 0 开始
+	RecordSeq collections.Sequence
+	// RecordLogHead 为 record 链的头部，即最后添加的 record 的 id 和 hash
+	RecordLogHead collections.Item[checkers.RecordLogHead]
+	// RecordRateCounters 用于限制每个账户在一个区块窗口内添加的 record 数量，键为账户地址
+	RecordRateCounters collections.Map[string, checkers.RecordRateCounter]
+	// RemoteRecords 用于存储通过 IBC 从其他链收到的 record，键为 (本链的通道, 数据包序号)
+	RemoteRecords collections.Map[collections.Pair[string, uint64], checkers.RemoteRecord]
+	// OutgoingPackets 用于跟踪本链发送的数据包的状态，键为 (本链的通道, 数据包序号)
+	OutgoingPackets collections.Map[collections.Pair[string, uint64], checkers.OutgoingPacket]
+	// IbcGames 用于存储跨链游戏，键为 (本链的通道, 本链的游戏 id)
+	IbcGames *collections.IndexedMap[collections.Pair[string, uint64], checkers.IbcGame, IbcGameIndexes]
+	// IbcGameSeq 用于生成跨链游戏 id，从 1 开始，0 表示对方链上的 id 未知
+	IbcGameSeq collections.Sequence
+	// IbcChannels 用于存储模块的端口上已经开启的通道，键为本链的通道
+	IbcChannels collections.Map[string, checkers.IbcChannel]
+	// DefaultChannel 为跨链功能的默认通道，没有设置时不存在
+	DefaultChannel collections.Item[string]
+	// LegacyRecordList 为共识版本 1 中以字符串保存的 record，只在迁移中使用
+	LegacyRecordList collections.KeySet[string]
 
 	// IBC 相关 Keeper 字段
 	// IBC Keeper，包含 client, channel, port 等子 Keeper
@@ -81,6 +298,10 @@ func NewKeeper(
 	addressCodec address.Codec,
 	storeService storetypes.KVStoreService,
 	authority string,
+	bankKeeper checkers.BankKeeper,
+	accountKeeper checkers.AccountKeeper,
+	distrKeeper checkers.DistributionKeeper,
+	headerService header.Service,
 	ibcKeeperFn func() *ibckeeper.Keeper,
 	scopedKeeperFn func(string) capabilitykeeper.ScopedKeeper,
 ) Keeper {
@@ -118,18 +339,65 @@ func NewKeeper(
 	//     底层通过 cdc 将 checkers.StoredGame 编码为 bytes
 	//   - 读取值: storedGames.Get(ctx, key) 返回 checkers.StoredGame
 	//     底层通过 cdc 将 bytes 解码为 checkers.StoredGame
-	storedGames := collections.NewMap(sb, checkers.StoredGamesKey, "storedGames", collections.StringKey, codec.CollValue[checkers.StoredGame](cdc))
+	// collections.NewIndexedMap 的用法与 collections.NewMap 相同，额外传入需要维护的索引
+	storedGames := collections.NewIndexedMap(sb, checkers.StoredGamesKey, "storedGames", collections.StringKey, codec.CollValue[checkers.StoredGame](cdc), NewStoredGameIndexes(sb))
 
-	recordList := collections.NewKeySet(sb, checkers.RecordKey, "RecordList", collections.StringKey)
+	playerInfo := collections.NewMap(sb, checkers.PlayerInfoKey, "playerInfo", collections.StringKey, codec.CollValue[checkers.PlayerInfo](cdc))
+
+	queue := collections.NewMap(sb, checkers.QueueKey, "queue", collections.StringKey, codec.CollValue[checkers.QueueEntry](cdc))
+	queueSize := collections.NewItem(sb, checkers.QueueSizeKey, "queueSize", collections.Uint64Value)
+	deadlines := collections.NewKeySet(sb, checkers.DeadlineQueueKey, "deadlines", collections.PairKeyCodec(collections.Int64Key, collections.StringKey))
+
+	tournaments := collections.NewIndexedMap(sb, checkers.TournamentsKey, "tournaments", collections.Uint64Key, codec.CollValue[checkers.Tournament](cdc), NewTournamentIndexes(sb))
+	tournamentSeq := collections.NewSequence(sb, checkers.TournamentSeqKey, "tournamentSeq")
+	tournamentPlayers := collections.NewMap(sb, checkers.TournamentPlayersKey, "tournamentPlayers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[checkers.TournamentPlayer](cdc))
+
+	records := collections.NewIndexedMap(sb, checkers.RecordsKey, "records", collections.Uint64Key, codec.CollValue[checkers.Record](cdc), NewRecordIndexes(sb))
+	recordSeq := collections.NewSequence(sb, checkers.RecordSeqKey, "recordSeq")
+	recordLogHead := collections.NewItem(sb, checkers.RecordLogHeadKey, "recordLogHead", codec.CollValue[checkers.RecordLogHead](cdc))
+	recordRateCounters := collections.NewMap(sb, checkers.RecordRateCountersKey, "recordRateCounters", collections.StringKey, codec.CollValue[checkers.RecordRateCounter](cdc))
+	remoteRecords := collections.NewMap(sb, checkers.RemoteRecordsKey, "remoteRecords", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[checkers.RemoteRecord](cdc))
+	outgoingPackets := collections.NewMap(sb, checkers.OutgoingPacketsKey, "outgoingPackets", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[checkers.OutgoingPacket](cdc))
+	ibcGames := collections.NewIndexedMap(sb, checkers.IbcGamesKey, "ibcGames", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[checkers.IbcGame](cdc), NewIbcGameIndexes(sb))
+	ibcGameSeq := collections.NewSequence(sb, checkers.IbcGameSeqKey, "ibcGameSeq")
+	ibcChannels := collections.NewMap(sb, checkers.IbcChannelsKey, "ibcChannels", collections.StringKey, codec.CollValue[checkers.IbcChannel](cdc))
+	defaultChannel := collections.NewItem(sb, checkers.DefaultChannelKey, "defaultChannel", collections.StringValue)
+	legacyRecordList := collections.NewKeySet(sb, checkers.LegacyRecordKey, "RecordList", collections.StringKey)
 
 	k := Keeper{
-		cdc:          cdc,
-		addressCodec: addressCodec,
-		authority:    authority,
+		cdc:           cdc,
+		addressCodec:  addressCodec,
+		authority:     authority,
+		bankKeeper:    bankKeeper,
+		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		headerService: headerService,
+		hooks:         new(checkers.CheckersHooks),
+
+		recordDenylist: new(recordDenylistCache),
 
 		Params:      params,
 		StoredGames: storedGames,
-		RecordList:  recordList,
+		PlayerInfo:  playerInfo,
+		Queue:       queue,
+		QueueSize:   queueSize,
+		Deadlines:   deadlines,
+
+		Records:            records,
+		RecordSeq:          recordSeq,
+		RecordLogHead:      recordLogHead,
+		RecordRateCounters: recordRateCounters,
+		RemoteRecords:      remoteRecords,
+		OutgoingPackets:    outgoingPackets,
+		IbcGames:           ibcGames,
+		IbcGameSeq:         ibcGameSeq,
+		IbcChannels:        ibcChannels,
+		DefaultChannel:     defaultChannel,
+		LegacyRecordList:   legacyRecordList,
+
+		Tournaments:       tournaments,
+		TournamentSeq:     tournamentSeq,
+		TournamentPlayers: tournamentPlayers,
 
 		ibcKeeperFn:        ibcKeeperFn,
 		capabilityScopedFn: scopedKeeperFn,
@@ -148,6 +416,49 @@ func NewKeeper(
 	return k
 }
 
+// SetHooks 设置其他模块的回调，只能设置一次
+// 需要多个回调时使用 checkers.NewMultiCheckersHooks 组合
+func (k *Keeper) SetHooks(hooks checkers.CheckersHooks) *Keeper {
+	if *k.hooks != nil {
+		panic("cannot set checkers hooks twice")
+	}
+	*k.hooks = hooks
+	return k
+}
+
+// Hooks 返回其他模块的回调，没有设置时返回一个不做任何操作的回调
+func (k *Keeper) Hooks() checkers.CheckersHooks {
+	if *k.hooks == nil {
+		return checkers.MultiCheckersHooks{}
+	}
+	return *k.hooks
+}
+
+// endBlockKey 为上下文中标记正在执行 EndBlock 的键，参见 inEndBlock
+type endBlockKey struct{}
+
+// inEndBlock 标记 ctx 正在执行 EndBlock，此后调用的回调不会使区块失败，参见 callHook
+func inEndBlock(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(endBlockKey{}, true)
+}
+
+// callHook 调用其他模块的回调 name
+// 交易中回调返回的错误使交易失败；EndBlock 中回调在 CacheContext 中执行，
+// 返回错误时丢弃回调的修改并记录日志，以免其他模块的错误使链停止
+func (k *Keeper) callHook(ctx context.Context, name string, hook func(ctx context.Context) error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if endBlock, _ := sdkCtx.Value(endBlockKey{}).(bool); !endBlock {
+		return hook(ctx)
+	}
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		sdkCtx.Logger().Error("checkers hook failed in EndBlock, changes discarded", "hook", name, "err", err)
+		return nil
+	}
+	write()
+	return nil
+}
+
 // GetAuthority 返回模块的权限账户地址
 func (k *Keeper) GetAuthority() string {
 	return k.authority
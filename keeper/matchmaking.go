@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+)
+
+// queueGameIndexPrefix 为匹配队列自动创建的游戏索引的前缀
+const queueGameIndexPrefix = "queue"
+
+// MatchQueue 为匹配队列中的玩家配对并自动创建游戏
+// 在 EndBlock 中调用，按进入队列的先后顺序，为每位玩家寻找时限和赌注相同、
+// 等级分差在双方允许范围内且等级分最接近的对手，允许的范围随等待时间逐渐放宽
+// 配对需要两两比较，队列的长度由 params.MaxQueueSize 限制
+func (k *Keeper) MatchQueue(ctx context.Context) error {
+	ctx = inEndBlock(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var entries []checkers.QueueEntry
+	if err := k.Queue.Walk(ctx, nil, func(_ string, entry checkers.QueueEntry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	// 等待时间最长的玩家优先配对，同一高度进入队列的玩家按地址排序，保证结果确定
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].JoinedHeight != entries[j].JoinedHeight {
+			return entries[i].JoinedHeight < entries[j].JoinedHeight
+		}
+		return entries[i].Player < entries[j].Player
+	})
+
+	matched := make(map[string]bool, len(entries))
+	gameCount := 0
+	for i, entry := range entries {
+		if matched[entry.Player] {
+			continue
+		}
+		// 配对关系是对称的，排在前面且尚未配对的玩家已经与 entry 比较过
+		best := -1
+		var bestDiff uint64
+		for j := i + 1; j < len(entries); j++ {
+			candidate := entries[j]
+			if matched[candidate.Player] {
+				continue
+			}
+			diff, ok := matchRatingDiff(params, height, entry, candidate)
+			if ok && (best < 0 || diff < bestDiff) {
+				best, bestDiff = j, diff
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		if err := k.createMatchedGame(ctx, gameCount, entry, entries[best]); err != nil {
+			return err
+		}
+		matched[entry.Player] = true
+		matched[entries[best].Player] = true
+		gameCount++
+	}
+	return nil
+}
+
+// queueSize 返回匹配队列中的玩家数量
+func (k *Keeper) queueSize(ctx context.Context) (uint64, error) {
+	size, err := k.QueueSize.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return size, err
+}
+
+// enqueue 将 entry 加入匹配队列，调用者需要先检查玩家不在队列中
+func (k *Keeper) enqueue(ctx context.Context, entry checkers.QueueEntry) error {
+	size, err := k.queueSize(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.Queue.Set(ctx, entry.Player, entry); err != nil {
+		return err
+	}
+	return k.QueueSize.Set(ctx, size+1)
+}
+
+// dequeue 将 player 移出匹配队列，调用者需要先检查玩家在队列中
+func (k *Keeper) dequeue(ctx context.Context, player string) error {
+	size, err := k.queueSize(ctx)
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return errorsmod.Wrapf(checkers.ErrNotQueued, "queue is empty, cannot remove %s", player)
+	}
+	if err := k.Queue.Remove(ctx, player); err != nil {
+		return err
+	}
+	return k.QueueSize.Set(ctx, size-1)
+}
+
+// matchRatingDiff 返回两位玩家的等级分差，以及两人能否配对
+func matchRatingDiff(params checkers.Params, height int64, a, b checkers.QueueEntry) (uint64, bool) {
+	if a.TurnDuration != b.TurnDuration || !checkers.SameWager(a.Wager, b.Wager) {
+		return 0, false
+	}
+	diff := a.Rating - b.Rating
+	if b.Rating > a.Rating {
+		diff = b.Rating - a.Rating
+	}
+	return diff, diff <= a.RatingWindow(params, height) && diff <= b.RatingWindow(params, height)
+}
+
+// createMatchedGame 为配对的两位玩家创建一局已经开始的游戏，并将两人移出匹配队列
+// 等级分较低的玩家执黑先行，等级分相同时等待更久的 first 执黑
+// 双方的赌注在进入队列时已经托管，因此双方都视为已接受
+func (k *Keeper) createMatchedGame(ctx context.Context, gameCount int, first, second checkers.QueueEntry) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	black, red := first, second
+	if second.Rating < first.Rating {
+		black, red = second, first
+	}
+
+	index, err := k.nextGameIndex(ctx, fmt.Sprintf("%s-%d", queueGameIndexPrefix, sdkCtx.BlockHeight()), gameCount)
+	if err != nil {
+		return err
+	}
+
+	newBoard := rules.New()
+	storedGame := checkers.StoredGame{
+		Board:         newBoard.String(),
+		Turn:          rules.PieceStrings[newBoard.Turn],
+		Black:         black.Player,
+		Red:           red.Player,
+		Status:        checkers.GameStatus_GAME_STATUS_ACTIVE,
+		Winner:        rules.PieceStrings[rules.NO_PLAYER],
+		BlackAccepted: true,
+		RedAccepted:   true,
+		Wager:         first.Wager,
+		TurnDuration:  first.TurnDuration,
+	}
+	if err := k.scheduleDeadline(ctx, index, &storedGame); err != nil {
+		return err
+	}
+	if err := storedGame.Validate(); err != nil {
+		return err
+	}
+	if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+		return err
+	}
+	if err := k.dequeue(ctx, first.Player); err != nil {
+		return err
+	}
+	if err := k.dequeue(ctx, second.Player); err != nil {
+		return err
+	}
+
+	if err := k.afterGameCreated(ctx, index, "", storedGame); err != nil {
+		return err
+	}
+	return sdkCtx.EventManager().EmitTypedEvent(&checkers.EventMatchFound{
+		GameIndex: index,
+		Black:     black.Player,
+		Red:       red.Player,
+	})
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// 匹配队列的长度受 MaxQueueSize 限制，离开队列或配对成功后空出位置
+func TestJoinQueueRespectsMaxQueueSize(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	params := checkers.DefaultParams()
+	params.MaxQueueSize = 2
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+	p0, p1, p2 := testutil.Address(0), testutil.Address(1), testutil.Address(2)
+
+	for _, player := range []string{p0, p1} {
+		_, err := ms.JoinQueue(f.Ctx, &checkers.MsgJoinQueue{Creator: player})
+		require.NoError(t, err)
+	}
+	_, err := ms.JoinQueue(f.Ctx, &checkers.MsgJoinQueue{Creator: p2})
+	require.ErrorIs(t, err, checkers.ErrQueueFull)
+
+	_, err = ms.LeaveQueue(f.Ctx, &checkers.MsgLeaveQueue{Creator: p1})
+	require.NoError(t, err)
+	_, err = ms.JoinQueue(f.Ctx, &checkers.MsgJoinQueue{Creator: p2})
+	require.NoError(t, err)
+
+	require.NoError(t, f.Keeper.MatchQueue(f.Ctx))
+	size, err := f.Keeper.QueueSize.Get(f.Ctx)
+	require.NoError(t, err)
+	require.Zero(t, size)
+	_, err = ms.JoinQueue(f.Ctx, &checkers.MsgJoinQueue{Creator: p1})
+	require.NoError(t, err)
+	msg, broken := keeper.QueueSizeInvariant(f.Keeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
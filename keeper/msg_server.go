@@ -4,11 +4,13 @@ package keeper
 import (
 	"context"
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"errors"
-	"fmt"
 	"github.com/buzzing/checkers"
 	"github.com/buzzing/checkers/rules"
-	"strings"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 )
 
 type msgServer struct {
@@ -22,46 +24,693 @@ func NewMsgServerImpl(keeper Keeper) checkers.MsgServer {
 	return &msgServer{k: keeper}
 }
 
+// UpdateParams MsgUpdateParams 消息的 handler，只有 authority 可以更新模块参数
+func (ms msgServer) UpdateParams(ctx context.Context, msg *checkers.MsgUpdateParams) (*checkers.MsgUpdateParamsResponse, error) {
+	if msg.Authority != ms.k.authority {
+		return nil, errorsmod.Wrapf(checkers.ErrNotAuthority, "expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.k.CheckRecordFeeDestination(msg.Params); err != nil {
+		return nil, err
+	}
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventParamsUpdated{
+		Authority: msg.Authority,
+		Params:    msg.Params,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgUpdateParamsResponse{}, nil
+}
+
 // CreateGame MsgCreateGame 消息的 handler，创建游戏并将其存储在状态中
+// 游戏以邀请的形式创建，黑方和红方都通过 MsgAcceptGame 接受后才会开始
 func (ms msgServer) CreateGame(ctx context.Context, msg *checkers.MsgCreateGame) (*checkers.MsgCreateGameResponse, error) {
-	// 对 index 长度和重复性进行检查
-	// 与 genesis.go 中对创世状态中的检查逻辑类似
-	if length := len([]byte(msg.Index)); length < 1 || length > checkers.MaxIndexLength {
-		return nil, checkers.ErrIndexTooLong
+	if err := ms.k.CreateGame(ctx, msg.Index, checkers.NewGame{
+		Creator: msg.Creator,
+		Black:   msg.Black,
+		Red:     msg.Red,
+		Wager:   msg.Wager,
+	}); err != nil {
+		return nil, err
 	}
-	if _, err := ms.k.StoredGames.Get(ctx, msg.Index); err == nil || errors.Is(err, collections.ErrEncoding) {
-		return nil, fmt.Errorf("game already exists at index %s", msg.Index)
+
+	return &checkers.MsgCreateGameResponse{}, nil
+}
+
+// CreateOpenGame MsgCreateOpenGame 消息的 handler，在大厅中创建一局只有创建者一方的游戏
+func (ms msgServer) CreateOpenGame(ctx context.Context, msg *checkers.MsgCreateOpenGame) (*checkers.MsgCreateOpenGameResponse, error) {
+	if err := ms.k.checkNewGameIndex(ctx, msg.Index); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateWager(msg.Wager); err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkActiveGames(ctx, params, msg.Creator); err != nil {
+		return nil, err
 	}
 
 	newBoard := rules.New()
 	storedGame := checkers.StoredGame{
-		Board: newBoard.String(),
-		Turn:  rules.PieceStrings[newBoard.Turn],
-		Black: msg.Black,
-		Red:   msg.Red,
+		Board:        newBoard.String(),
+		Turn:         rules.PieceStrings[newBoard.Turn],
+		Status:       checkers.GameStatus_GAME_STATUS_OPEN,
+		Winner:       rules.PieceStrings[rules.NO_PLAYER],
+		Wager:        msg.Wager,
+		MinRating:    msg.MinRating,
+		TurnDuration: params.MaxTurnDuration,
+	}
+	switch msg.Color {
+	case rules.BLACK:
+		storedGame.Black = msg.Creator
+	case rules.RED:
+		storedGame.Red = msg.Creator
+	default:
+		return nil, errorsmod.Wrapf(checkers.ErrInvalidColor, "%s, expected %s or %s", msg.Color, rules.BLACK, rules.RED)
 	}
 	if err := storedGame.Validate(); err != nil {
 		return nil, err
 	}
+	if _, err := ms.k.acceptSeats(ctx, &storedGame, msg.Creator); err != nil {
+		return nil, err
+	}
 	if err := ms.k.StoredGames.Set(ctx, msg.Index, storedGame); err != nil {
 		return nil, err
 	}
 
-	return &checkers.MsgCreateGameResponse{}, nil
+	if err := ms.k.afterGameCreated(ctx, msg.Index, msg.Creator, storedGame); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgCreateOpenGameResponse{}, nil
+}
+
+// JoinGame MsgJoinGame 消息的 handler，加入大厅中的游戏，占据空着的一方，游戏随即开始
+func (ms msgServer) JoinGame(ctx context.Context, msg *checkers.MsgJoinGame) (*checkers.MsgJoinGameResponse, error) {
+	storedGame, err := ms.k.GetGame(ctx, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_OPEN {
+		return nil, errorsmod.Wrapf(checkers.ErrGameNotOpen, "game %s is %s", msg.GameIndex, storedGame.Status)
+	}
+	if storedGame.Black == msg.Creator || storedGame.Red == msg.Creator {
+		return nil, errorsmod.Wrapf(checkers.ErrCannotJoinOwnGame, "%s", msg.Creator)
+	}
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkActiveGames(ctx, params, msg.Creator); err != nil {
+		return nil, err
+	}
+	if storedGame.MinRating > 0 {
+		playerInfo, err := ms.k.getPlayerInfo(ctx, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		if playerInfo.Rating < storedGame.MinRating {
+			return nil, errorsmod.Wrapf(checkers.ErrRatingTooLow, "%d < %d", playerInfo.Rating, storedGame.MinRating)
+		}
+	}
+
+	color := rules.BLACK
+	if storedGame.Black == "" {
+		storedGame.Black = msg.Creator
+	} else {
+		storedGame.Red = msg.Creator
+		color = rules.RED
+	}
+	if _, err := ms.k.acceptSeats(ctx, &storedGame, msg.Creator); err != nil {
+		return nil, err
+	}
+	storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+	if err := ms.k.scheduleDeadline(ctx, msg.GameIndex, &storedGame); err != nil {
+		return nil, err
+	}
+	if err := storedGame.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.k.StoredGames.Set(ctx, msg.GameIndex, storedGame); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventGameJoined{
+		GameIndex: msg.GameIndex,
+		Player:    msg.Creator,
+		Color:     color,
+	}); err != nil {
+		return nil, err
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventGameStarted{
+		GameIndex: msg.GameIndex,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+		Deadline:  storedGame.Deadline,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgJoinGameResponse{Color: color}, nil
+}
+
+// JoinQueue MsgJoinQueue 消息的 handler，将玩家加入匹配队列并托管赌注
+// 玩家的等级分在进入队列时记录，配对由 EndBlock 中的 MatchQueue 完成
+func (ms msgServer) JoinQueue(ctx context.Context, msg *checkers.MsgJoinQueue) (*checkers.MsgJoinQueueResponse, error) {
+	queued, err := ms.k.Queue.Has(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if queued {
+		return nil, errorsmod.Wrapf(checkers.ErrAlreadyQueued, "%s", msg.Creator)
+	}
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateWager(msg.Wager); err != nil {
+		return nil, err
+	}
+	turnDuration, err := params.ValidateTurnDuration(msg.TurnDuration)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkActiveGames(ctx, params, msg.Creator); err != nil {
+		return nil, err
+	}
+	size, err := ms.k.queueSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if size >= params.MaxQueueSize {
+		return nil, errorsmod.Wrapf(checkers.ErrQueueFull, "%d players", size)
+	}
+
+	playerInfo, err := ms.k.getPlayerInfo(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	entry := checkers.QueueEntry{
+		Player:       msg.Creator,
+		Rating:       playerInfo.Rating,
+		TurnDuration: turnDuration,
+		Wager:        msg.Wager,
+		JoinedHeight: sdkCtx.BlockHeight(),
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.k.collectWager(ctx, entry.Wager, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := ms.k.enqueue(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventQueueJoined{
+		Player:       entry.Player,
+		Rating:       entry.Rating,
+		TurnDuration: entry.TurnDuration,
+		Wager:        entry.Wager,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgJoinQueueResponse{}, nil
+}
+
+// LeaveQueue MsgLeaveQueue 消息的 handler，将玩家移出匹配队列并退回托管的赌注
+func (ms msgServer) LeaveQueue(ctx context.Context, msg *checkers.MsgLeaveQueue) (*checkers.MsgLeaveQueueResponse, error) {
+	entry, err := ms.k.Queue.Get(ctx, msg.Creator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(checkers.ErrNotQueued, "%s", msg.Creator)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if entry.HasWager() {
+		if err := ms.k.payWager(ctx, msg.Creator, sdk.NewCoins(entry.Wager)); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.k.dequeue(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventQueueLeft{
+		Player: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgLeaveQueueResponse{}, nil
+}
+
+// AcceptGame MsgAcceptGame 消息的 handler，接受游戏邀请
+// 黑方和红方都接受后，游戏进入 GAME_STATUS_ACTIVE 状态
+func (ms msgServer) AcceptGame(ctx context.Context, msg *checkers.MsgAcceptGame) (*checkers.MsgAcceptGameResponse, error) {
+	storedGame, _, err := ms.k.getGameOfPlayer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(checkers.ErrGameNotPending, "game %s is %s", msg.GameIndex, storedGame.Status)
+	}
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.k.checkActiveGames(ctx, params, msg.Creator); err != nil {
+		return nil, err
+	}
+	accepted, err := ms.k.acceptSeats(ctx, &storedGame, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if accepted == 0 {
+		return nil, errorsmod.Wrapf(checkers.ErrAlreadyAccepted, "%s", msg.Creator)
+	}
+	started := storedGame.IsAccepted()
+	if started {
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+		if err := ms.k.scheduleDeadline(ctx, msg.GameIndex, &storedGame); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.k.StoredGames.Set(ctx, msg.GameIndex, storedGame); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventGameAccepted{
+		GameIndex: msg.GameIndex,
+		Player:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+	if started {
+		if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventGameStarted{
+			GameIndex: msg.GameIndex,
+			Black:     storedGame.Black,
+			Red:       storedGame.Red,
+			Deadline:  storedGame.Deadline,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &checkers.MsgAcceptGameResponse{Started: started}, nil
+}
+
+// PlayMove MsgPlayMove 消息的 handler，在游戏中走一步棋
+// 走棋的合法性由规则文件(rules/checkers.go)判断
+func (ms msgServer) PlayMove(ctx context.Context, msg *checkers.MsgPlayMove) (*checkers.MsgPlayMoveResponse, error) {
+	captured, winner, err := ms.k.ApplyMove(ctx, msg.GameIndex, msg.Creator,
+		rules.Pos{X: int(msg.FromX), Y: int(msg.FromY)},
+		rules.Pos{X: int(msg.ToX), Y: int(msg.ToY)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgPlayMoveResponse{
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Winner:    winner,
+	}, nil
 }
 
 // AddRecord MsgAddRecord 消息的 handler，将记录添加到链上存储中
 func (ms msgServer) AddRecord(ctx context.Context, msg *checkers.MsgAddRecord) (*checkers.MsgAddRecordResponse, error) {
-	// 手动设置一个错误触发条件
-	// 如果 record 中包含单词 "Monday" 则返回错误
-	if strings.Contains(msg.Value, "Monday") {
-		return nil, fmt.Errorf("cannot add record with value %s", msg.Value)
+	// record 的内容、数量和费用由参数决定，参见 Keeper.SubmitRecord
+	record, err := ms.k.SubmitRecord(ctx, msg.Creator, msg.Value, msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgAddRecordResponse{Id: record.Id}, nil
+}
+
+// SendRecord MsgSendRecord 消息的 handler，通过 IBC 发送一条 record
+func (ms msgServer) SendRecord(ctx context.Context, msg *checkers.MsgSendRecord) (*checkers.MsgSendRecordResponse, error) {
+	timeoutHeight := clienttypes.NewHeight(msg.TimeoutRevisionNumber, msg.TimeoutRevisionHeight)
+	sequence, err := ms.k.SendRecord(ctx, msg.Creator, msg.Port, msg.Channel, msg.RecordData, timeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgSendRecordResponse{Sequence: sequence}, nil
+}
+
+// RetryPacket MsgRetryPacket 消息的 handler，重新发送超时的数据包
+func (ms msgServer) RetryPacket(ctx context.Context, msg *checkers.MsgRetryPacket) (*checkers.MsgRetryPacketResponse, error) {
+	sequence, err := ms.k.RetryPacket(ctx, msg.Creator, msg.Channel, msg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgRetryPacketResponse{Sequence: sequence}, nil
+}
+
+// InviteIbcGame MsgInviteIbcGame 消息的 handler，邀请另一条链上的玩家进行跨链游戏
+func (ms msgServer) InviteIbcGame(ctx context.Context, msg *checkers.MsgInviteIbcGame) (*checkers.MsgInviteIbcGameResponse, error) {
+	gameId, sequence, err := ms.k.InviteIbcGame(ctx, msg.Creator, msg.Channel, msg.Invitee, msg.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgInviteIbcGameResponse{GameId: gameId, Sequence: sequence}, nil
+}
+
+// AcceptIbcGame MsgAcceptIbcGame 消息的 handler，接受另一条链上的玩家发来的跨链游戏邀请
+func (ms msgServer) AcceptIbcGame(ctx context.Context, msg *checkers.MsgAcceptIbcGame) (*checkers.MsgAcceptIbcGameResponse, error) {
+	sequence, err := ms.k.AcceptIbcGame(ctx, msg.Creator, msg.Channel, msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgAcceptIbcGameResponse{Sequence: sequence}, nil
+}
+
+// PlayIbcMove MsgPlayIbcMove 消息的 handler，在跨链游戏中走一步棋
+func (ms msgServer) PlayIbcMove(ctx context.Context, msg *checkers.MsgPlayIbcMove) (*checkers.MsgPlayIbcMoveResponse, error) {
+	captured, winner, sequence, err := ms.k.PlayIbcMove(ctx, msg.Creator, msg.Channel, msg.GameId,
+		rules.Pos{X: int(msg.FromX), Y: int(msg.FromY)},
+		rules.Pos{X: int(msg.ToX), Y: int(msg.ToY)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgPlayIbcMoveResponse{
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Winner:    winner,
+		Sequence:  sequence,
+	}, nil
+}
+
+// ResignIbcGame MsgResignIbcGame 消息的 handler，在跨链游戏中认输
+func (ms msgServer) ResignIbcGame(ctx context.Context, msg *checkers.MsgResignIbcGame) (*checkers.MsgResignIbcGameResponse, error) {
+	sequence, err := ms.k.ResignIbcGame(ctx, msg.Creator, msg.Channel, msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgResignIbcGameResponse{Sequence: sequence}, nil
+}
+
+// ClaimIbcGameTimeout MsgClaimIbcGameTimeout 消息的 handler，在对手超时没有走棋时宣布获胜
+func (ms msgServer) ClaimIbcGameTimeout(ctx context.Context, msg *checkers.MsgClaimIbcGameTimeout) (*checkers.MsgClaimIbcGameTimeoutResponse, error) {
+	sequence, err := ms.k.ClaimIbcGameTimeout(ctx, msg.Creator, msg.Channel, msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgClaimIbcGameTimeoutResponse{Sequence: sequence}, nil
+}
+
+// DeleteRecord MsgDeleteRecord 消息的 handler，作者删除自己的 record
+func (ms msgServer) DeleteRecord(ctx context.Context, msg *checkers.MsgDeleteRecord) (*checkers.MsgDeleteRecordResponse, error) {
+	if err := ms.k.DeleteRecord(ctx, msg.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgDeleteRecordResponse{}, nil
+}
+
+// PruneRecords MsgPruneRecords 消息的 handler，只有 authority 可以删除 record
+func (ms msgServer) PruneRecords(ctx context.Context, msg *checkers.MsgPruneRecords) (*checkers.MsgPruneRecordsResponse, error) {
+	if msg.Authority != ms.k.authority {
+		return nil, errorsmod.Wrapf(checkers.ErrNotAuthority, "expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+	if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); msg.BeforeHeight <= 0 || msg.BeforeHeight > height {
+		return nil, errorsmod.Wrapf(checkers.ErrInvalidPruneHeight, "before height %d, current height %d", msg.BeforeHeight, height)
+	}
+
+	pruned, err := ms.k.PruneRecords(ctx, msg.BeforeHeight, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgPruneRecordsResponse{Pruned: pruned}, nil
+}
+
+// SetDefaultChannel MsgSetDefaultChannel 消息的 handler，只有 authority 可以设置跨链功能的默认通道
+func (ms msgServer) SetDefaultChannel(ctx context.Context, msg *checkers.MsgSetDefaultChannel) (*checkers.MsgSetDefaultChannelResponse, error) {
+	if msg.Authority != ms.k.authority {
+		return nil, errorsmod.Wrapf(checkers.ErrNotAuthority, "expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+	if err := ms.k.SetDefaultChannel(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgSetDefaultChannelResponse{}, nil
+}
+
+// Resign MsgResign 消息的 handler，认输并结束一局正在进行的游戏，对手获胜
+func (ms msgServer) Resign(ctx context.Context, msg *checkers.MsgResign) (*checkers.MsgResignResponse, error) {
+	_, color, err := ms.k.getGameOfPlayer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	winner := checkers.OpponentColor(color)
+	if err := ms.k.EndGame(ctx, msg.GameIndex, winner, checkers.GameEndReason_GAME_END_REASON_RESIGNED); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgResignResponse{}, nil
+}
+
+// Reject MsgReject 消息的 handler，拒绝一局还没有任何一方走棋的游戏并将其删除
+// 也可以用于拒绝还在等待接受的游戏邀请，或者撤回大厅中还没有对手加入的游戏
+// 已经托管的赌注将被退回
+func (ms msgServer) Reject(ctx context.Context, msg *checkers.MsgReject) (*checkers.MsgRejectResponse, error) {
+	storedGame, _, err := ms.k.getGameOfPlayer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	switch storedGame.Status {
+	case checkers.GameStatus_GAME_STATUS_PENDING, checkers.GameStatus_GAME_STATUS_OPEN, checkers.GameStatus_GAME_STATUS_ACTIVE:
+	default:
+		return nil, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s is %s", msg.GameIndex, storedGame.Status)
+	}
+	if storedGame.TournamentId != 0 {
+		return nil, errorsmod.Wrapf(checkers.ErrTournamentGame, "game %s belongs to tournament %d", msg.GameIndex, storedGame.TournamentId)
+	}
+	if storedGame.MoveCount > 0 {
+		return nil, errorsmod.Wrapf(checkers.ErrGameAlreadyPlayed, "game %s has %d moves", msg.GameIndex, storedGame.MoveCount)
+	}
+
+	if err := ms.k.removeGame(ctx, msg.GameIndex, storedGame, &checkers.EventGameRejected{
+		GameIndex: msg.GameIndex,
+		Player:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgRejectResponse{}, nil
+}
+
+// OfferDraw MsgOfferDraw 消息的 handler，向对手提出和棋
+// 和棋提议保存在游戏中，直到对手接受、拒绝或走棋
+func (ms msgServer) OfferDraw(ctx context.Context, msg *checkers.MsgOfferDraw) (*checkers.MsgOfferDrawResponse, error) {
+	storedGame, color, err := ms.k.getGameOfPlayer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s is %s", msg.GameIndex, storedGame.Status)
+	}
+	if storedGame.DrawOffer != "" {
+		return nil, errorsmod.Wrapf(checkers.ErrDrawOfferPending, "offered by %s", storedGame.DrawOffer)
+	}
+
+	storedGame.DrawOffer = color
+	if err := ms.k.StoredGames.Set(ctx, msg.GameIndex, storedGame); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventDrawOffered{
+		GameIndex: msg.GameIndex,
+		Player:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgOfferDrawResponse{}, nil
+}
+
+// AcceptDraw MsgAcceptDraw 消息的 handler，接受对手的和棋提议，游戏以和棋结束
+func (ms msgServer) AcceptDraw(ctx context.Context, msg *checkers.MsgAcceptDraw) (*checkers.MsgAcceptDrawResponse, error) {
+	if _, err := ms.getDrawOfferToPlayer(ctx, msg.GameIndex, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.EndGame(ctx, msg.GameIndex, rules.PieceStrings[rules.NO_PLAYER], checkers.GameEndReason_GAME_END_REASON_DRAW_AGREED); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgAcceptDrawResponse{}, nil
+}
+
+// DeclineDraw MsgDeclineDraw 消息的 handler，拒绝对手的和棋提议
+func (ms msgServer) DeclineDraw(ctx context.Context, msg *checkers.MsgDeclineDraw) (*checkers.MsgDeclineDrawResponse, error) {
+	storedGame, err := ms.getDrawOfferToPlayer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.DrawOffer = ""
+	if err := ms.k.StoredGames.Set(ctx, msg.GameIndex, storedGame); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventDrawDeclined{
+		GameIndex: msg.GameIndex,
+		Player:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgDeclineDrawResponse{}, nil
+}
+
+// getDrawOfferToPlayer 读取一局正在进行的游戏，并确认对手向 player 提出了和棋
+func (ms msgServer) getDrawOfferToPlayer(ctx context.Context, index string, player string) (checkers.StoredGame, error) {
+	storedGame, color, err := ms.k.getGameOfPlayer(ctx, index, player)
+	if err != nil {
+		return storedGame, err
+	}
+	if storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return storedGame, errorsmod.Wrapf(checkers.ErrGameNotActive, "game %s is %s", index, storedGame.Status)
+	}
+	// 同一地址同时执黑和执红时，可以回应自己的和棋提议
+	if storedGame.DrawOffer == "" || (storedGame.DrawOffer == color && storedGame.Black != storedGame.Red) {
+		return storedGame, errorsmod.Wrapf(checkers.ErrNoDrawOffer, "game %s", index)
+	}
+	return storedGame, nil
+}
+
+// CreateTournament MsgCreateTournament 消息的 handler，创建一个处于报名阶段的锦标赛
+func (ms msgServer) CreateTournament(ctx context.Context, msg *checkers.MsgCreateTournament) (*checkers.MsgCreateTournamentResponse, error) {
+	if msg.Sanctioned && msg.Creator != ms.k.authority {
+		return nil, errorsmod.Wrapf(checkers.ErrNotAuthority, "expected %s, got %s", ms.k.authority, msg.Creator)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.StartHeight <= sdkCtx.BlockHeight() {
+		return nil, errorsmod.Wrapf(checkers.ErrInvalidTournament, "start height %d is not after %d", msg.StartHeight, sdkCtx.BlockHeight())
+	}
+
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	turnDuration, err := params.ValidateTurnDuration(msg.TurnDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ms.k.TournamentSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 锦标赛 id 从 1 开始，0 表示游戏不属于任何锦标赛
+	id++
+	tournament := checkers.Tournament{
+		Id:           id,
+		Creator:      msg.Creator,
+		Name:         msg.Name,
+		Format:       msg.Format,
+		Status:       checkers.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION,
+		Sanctioned:   msg.Sanctioned,
+		EntryFee:     msg.EntryFee,
+		PrizeShares:  msg.PrizeShares,
+		MaxPlayers:   msg.MaxPlayers,
+		StartHeight:  msg.StartHeight,
+		TurnDuration: turnDuration,
+		Rounds:       msg.Rounds,
+	}
+	if len(tournament.PrizeShares) == 0 {
+		tournament.PrizeShares = checkers.DefaultPrizeShares
+	}
+	if checkers.HasWager(tournament.EntryFee) {
+		tournament.PrizePool = sdk.NewCoin(tournament.EntryFee.Denom, math.ZeroInt())
+	}
+	if err := tournament.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.k.Tournaments.Set(ctx, id, tournament); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&checkers.EventTournamentCreated{
+		TournamentId: id,
+		Creator:      msg.Creator,
+		Format:       tournament.Format,
+		Sanctioned:   tournament.Sanctioned,
+		StartHeight:  tournament.StartHeight,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &checkers.MsgCreateTournamentResponse{TournamentId: id}, nil
+}
+
+// JoinTournament MsgJoinTournament 消息的 handler，报名参加锦标赛，报名费计入奖池
+func (ms msgServer) JoinTournament(ctx context.Context, msg *checkers.MsgJoinTournament) (*checkers.MsgJoinTournamentResponse, error) {
+	tournament, err := ms.k.Tournaments.Get(ctx, msg.TournamentId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(checkers.ErrTournamentNotFound, "%d", msg.TournamentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tournament.Status != checkers.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION {
+		return nil, errorsmod.Wrapf(checkers.ErrTournamentNotOpen, "tournament %d is %s", msg.TournamentId, tournament.Status)
+	}
+	if tournament.HasPlayer(msg.Creator) {
+		return nil, errorsmod.Wrapf(checkers.ErrAlreadyRegistered, "%s", msg.Creator)
+	}
+	if uint64(len(tournament.Players)) >= tournament.MaxPlayers {
+		return nil, errorsmod.Wrapf(checkers.ErrTournamentFull, "%d players", tournament.MaxPlayers)
+	}
+
+	if err := ms.k.collectWager(ctx, tournament.EntryFee, msg.Creator); err != nil {
+		return nil, err
+	}
+	if checkers.HasWager(tournament.EntryFee) {
+		tournament.PrizePool = tournament.PrizePool.Add(tournament.EntryFee)
+	}
+	tournament.Players = append(tournament.Players, msg.Creator)
+	if err := ms.k.Tournaments.Set(ctx, tournament.Id, tournament); err != nil {
+		return nil, err
+	}
+	tournamentPlayer := checkers.TournamentPlayer{TournamentId: tournament.Id, Player: msg.Creator}
+	if err := ms.k.TournamentPlayers.Set(ctx, collections.Join(tournament.Id, msg.Creator), tournamentPlayer); err != nil {
+		return nil, err
 	}
 
-	// 存储状态
-	if err := ms.k.RecordList.Set(ctx, msg.Value); err != nil {
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventTournamentJoined{
+		TournamentId: tournament.Id,
+		Player:       msg.Creator,
+	}); err != nil {
 		return nil, err
 	}
 
-	return &checkers.MsgAddRecordResponse{}, nil
+	return &checkers.MsgJoinTournamentResponse{}, nil
 }
@@ -2,13 +2,19 @@
 // RPC 服务和方法定义参见 query.proto
 // 这里需要做:
 //   - 实现 GetGame 函数
+//   - 实现 ListGames 函数
 package keeper
 
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -26,6 +32,18 @@ func NewQueryServerImpl(keeper Keeper) checkers.QueryServer {
 	return queryServer{k: keeper}
 }
 
+// Params QueryParamsRequest 消息的 handler，获取模块参数
+func (qs queryServer) Params(ctx context.Context, req *checkers.QueryParamsRequest) (*checkers.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryParamsResponse{Params: params}, nil
+}
+
 // GetGame QueryGetGameRequest 消息的 handler，获取游戏内容
 func (qs queryServer) GetGame(ctx context.Context, req *checkers.QueryGetGameRequest) (*checkers.QueryGetGameResponse, error) {
 	game, err := qs.k.StoredGames.Get(ctx, req.Index)
@@ -42,16 +60,453 @@ func (qs queryServer) GetGame(ctx context.Context, req *checkers.QueryGetGameReq
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
-// GetRecordList QueryGetRecordRequest 消息的 handler，获取全部记录内容
+// GetRecordList QueryGetRecordListRequest 消息的 handler，按 id 分页列出 record
 func (qs queryServer) GetRecordList(ctx context.Context, req *checkers.QueryGetRecordListRequest) (*checkers.QueryGetRecordListResponse, error) {
-	var recordList []string
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, qs.k.Records, req.Pagination,
+		func(_ uint64, record checkers.Record) (checkers.Record, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &checkers.QueryGetRecordListResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// GetRecord QueryGetRecordRequest 消息的 handler，按 id 获取 record
+func (qs queryServer) GetRecord(ctx context.Context, req *checkers.QueryGetRecordRequest) (*checkers.QueryGetRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	record, err := qs.k.Records.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "record %d not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetRecordResponse{Record: record}, nil
+}
+
+// RecordLogHead QueryRecordLogHeadRequest 消息的 handler，获取 record 链的头部
+func (qs queryServer) RecordLogHead(ctx context.Context, req *checkers.QueryRecordLogHeadRequest) (*checkers.QueryRecordLogHeadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	head, err := qs.k.RecordLogHead.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "no record has been added")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryRecordLogHeadResponse{Head: head}, nil
+}
+
+// VerifyRecordRange QueryVerifyRecordRangeRequest 消息的 handler，验证一段 record 的 hash 和链接
+// record 被篡改或缺失时返回 valid 为 false 以及原因，而不是错误
+func (qs queryServer) VerifyRecordRange(ctx context.Context, req *checkers.QueryVerifyRecordRangeRequest) (*checkers.QueryVerifyRecordRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checked, brokenId, err := qs.k.VerifyRecordRange(ctx, req.From, req.To)
+	switch {
+	case checkers.ErrInvalidRecordRange.Is(err):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case checkers.ErrRecordChainBroken.Is(err):
+		return &checkers.QueryVerifyRecordRangeResponse{Checked: checked, BrokenId: brokenId, Reason: err.Error()}, nil
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryVerifyRecordRangeResponse{Valid: true, Checked: checked}, nil
+}
+
+// GetRemoteRecords QueryGetRemoteRecordsRequest 消息的 handler，按数据包序号分页列出从一个通道收到的 record
+func (qs queryServer) GetRemoteRecords(ctx context.Context, req *checkers.QueryGetRemoteRecordsRequest) (*checkers.QueryGetRemoteRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.Channel); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel: %s", err)
+	}
+
+	remoteRecords, pageRes, err := query.CollectionPaginate(ctx, qs.k.RemoteRecords, req.Pagination,
+		func(_ collections.Pair[string, uint64], remoteRecord checkers.RemoteRecord) (checkers.RemoteRecord, error) {
+			return remoteRecord, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Channel),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetRemoteRecordsResponse{RemoteRecords: remoteRecords, Pagination: pageRes}, nil
+}
+
+// GetOutgoingPacket QueryGetOutgoingPacketRequest 消息的 handler，获取发送的数据包的状态
+func (qs queryServer) GetOutgoingPacket(ctx context.Context, req *checkers.QueryGetOutgoingPacketRequest) (*checkers.QueryGetOutgoingPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	packet, err := qs.k.OutgoingPackets.Get(ctx, collections.Join(req.Channel, req.Sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "outgoing packet %s/%d not found", req.Channel, req.Sequence)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetOutgoingPacketResponse{Packet: packet}, nil
+}
+
+// GetPacketStatus QueryGetPacketStatusRequest 消息的 handler，获取本链发送的数据包的状态和对方链返回的结果
+func (qs queryServer) GetPacketStatus(ctx context.Context, req *checkers.QueryGetPacketStatusRequest) (*checkers.QueryGetPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	packet, err := qs.k.OutgoingPackets.Get(ctx, collections.Join(req.Channel, req.Sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "outgoing packet %s/%d not found", req.Channel, req.Sequence)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetPacketStatusResponse{
+		Status:         packet.Status,
+		Result:         packet.Result,
+		ResolvedHeight: packet.ResolvedHeight,
+		RetrySequence:  packet.RetrySequence,
+	}, nil
+}
+
+// ListOutgoingPackets QueryListOutgoingPacketsRequest 消息的 handler，按数据包序号分页列出从一个通道发送的数据包
+func (qs queryServer) ListOutgoingPackets(ctx context.Context, req *checkers.QueryListOutgoingPacketsRequest) (*checkers.QueryListOutgoingPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.Channel); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel: %s", err)
+	}
+
+	packets, pageRes, err := query.CollectionPaginate(ctx, qs.k.OutgoingPackets, req.Pagination,
+		func(_ collections.Pair[string, uint64], packet checkers.OutgoingPacket) (checkers.OutgoingPacket, error) {
+			return packet, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Channel),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryListOutgoingPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// GetIbcGame QueryGetIbcGameRequest 消息的 handler，获取一局跨链游戏
+func (qs queryServer) GetIbcGame(ctx context.Context, req *checkers.QueryGetIbcGameRequest) (*checkers.QueryGetIbcGameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ibcGame, err := qs.k.IbcGames.Get(ctx, collections.Join(req.Channel, req.Id))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "cross-chain game %s/%d not found", req.Channel, req.Id)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetIbcGameResponse{IbcGame: ibcGame}, nil
+}
+
+// ListIbcGames QueryListIbcGamesRequest 消息的 handler，按 id 分页列出一个通道上的跨链游戏
+func (qs queryServer) ListIbcGames(ctx context.Context, req *checkers.QueryListIbcGamesRequest) (*checkers.QueryListIbcGamesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.Channel); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel: %s", err)
+	}
+
+	ibcGames, pageRes, err := query.CollectionPaginate(ctx, qs.k.IbcGames, req.Pagination,
+		func(_ collections.Pair[string, uint64], ibcGame checkers.IbcGame) (checkers.IbcGame, error) {
+			return ibcGame, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Channel),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryListIbcGamesResponse{IbcGames: ibcGames, Pagination: pageRes}, nil
+}
+
+// GetRecordsByAuthor QueryGetRecordsByAuthorRequest 消息的 handler，通过作者索引按 id 分页列出作者的 record
+func (qs queryServer) GetRecordsByAuthor(ctx context.Context, req *checkers.QueryGetRecordsByAuthorRequest) (*checkers.QueryGetRecordsByAuthorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := qs.k.addressCodec.StringToBytes(req.Author); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid author address: %s", err)
+	}
+
+	iter, err := qs.k.Records.Indexes.Author.MatchExact(ctx, req.Author)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	start, end, pageRes, err := paginateIds(ids, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	records := make([]checkers.Record, 0, end-start)
+	for _, id := range ids[start:end] {
+		record, err := qs.k.Records.Get(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		records = append(records, record)
+	}
+	return &checkers.QueryGetRecordsByAuthorResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// paginateIds 对升序排列的 id 列表进行分页，返回当前页在列表中的范围
+// 与 query.CollectionPaginate 一致，NextKey 为下一页第一个 id 的大端编码
+func paginateIds(ids []uint64, pageReq *query.PageRequest) (int, int, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return 0, 0, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := 0
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, fmt.Errorf("invalid pagination key %X", pageReq.Key)
+		}
+		key := sdk.BigEndianToUint64(pageReq.Key)
+		start = sort.Search(len(ids), func(i int) bool { return ids[i] >= key })
+	} else {
+		start = int(min(pageReq.Offset, uint64(len(ids))))
+	}
+	end := int(min(uint64(start)+limit, uint64(len(ids))))
+
+	pageRes := &query.PageResponse{}
+	if end < len(ids) {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(ids[end])
+	}
+	if pageReq.CountTotal && pageReq.Key == nil {
+		pageRes.Total = uint64(len(ids))
+	}
+	return start, end, pageRes, nil
+}
 
-	if err := qs.k.RecordList.Walk(ctx, nil, func(record string) (bool, error) {
-		recordList = append(recordList, record)
-		return false, nil
-	}); err != nil {
-		return nil, err
+// ListGames QueryListGamesRequest 消息的 handler，分页列出满足过滤条件的游戏
+//   - 指定 player 时，对玩家索引中该玩家参与的游戏分页，并按其余条件过滤
+//   - 仅指定 status 时，对状态索引中处于该状态的游戏分页
+//   - 未指定过滤条件时，直接对 StoredGames 分页
+func (qs queryServer) ListGames(ctx context.Context, req *checkers.QueryListGamesRequest) (*checkers.QueryListGamesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.AwaitingMove && req.Player == "" {
+		return nil, status.Error(codes.InvalidArgument, "awaiting_move requires a player")
 	}
+	if _, ok := checkers.GameStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown game status %d", req.Status)
+	}
+
+	// 判断游戏是否满足除 player 以外的过滤条件
+	match := func(storedGame checkers.StoredGame) bool {
+		if req.Status != checkers.GameStatus_GAME_STATUS_UNSPECIFIED && storedGame.Status != req.Status {
+			return false
+		}
+		return !req.AwaitingMove || storedGame.IsAwaitingMove(req.Player)
+	}
+	toIndexed := func(index string, storedGame checkers.StoredGame) (checkers.IndexedStoredGame, error) {
+		return checkers.IndexedStoredGame{Index: index, StoredGame: storedGame}, nil
+	}
+
+	if req.Player == "" && req.Status == checkers.GameStatus_GAME_STATUS_UNSPECIFIED {
+		games, pageRes, err := query.CollectionPaginate(ctx, qs.k.StoredGames, req.Pagination, toIndexed)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &checkers.QueryListGamesResponse{Games: games, Pagination: pageRes}, nil
+	}
+
+	var (
+		games   []checkers.IndexedStoredGame
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Player != "" {
+		if _, err := qs.k.addressCodec.StringToBytes(req.Player); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid player address: %s", err)
+		}
+		games, pageRes, err = paginateGameIndex(ctx, &qs.k, qs.k.StoredGames.Indexes.Player, req.Player, req.Pagination, match)
+	} else {
+		games, pageRes, err = paginateGameIndex(ctx, &qs.k, qs.k.StoredGames.Indexes.Status, int32(req.Status), req.Pagination, match)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &checkers.QueryListGamesResponse{Games: games, Pagination: pageRes}, nil
+}
+
+// OpenGames QueryOpenGamesRequest 消息的 handler，分页列出大厅中等待玩家加入的游戏
+func (qs queryServer) OpenGames(ctx context.Context, req *checkers.QueryOpenGamesRequest) (*checkers.QueryOpenGamesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	games, pageRes, err := paginateGameIndex(ctx, &qs.k, qs.k.StoredGames.Indexes.Status, int32(checkers.GameStatus_GAME_STATUS_OPEN), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &checkers.QueryOpenGamesResponse{Games: games, Pagination: pageRes}, nil
+}
+
+// paginateGameIndex 通过 StoredGames 的二级索引对引用键为 refKey 的游戏分页，match 为空时不过滤
+// 与 query.CollectionPaginate 一致，NextKey 为下一条索引项的游戏索引，limit 限制的是读取的索引项数量
+func paginateGameIndex[R any](
+	ctx context.Context,
+	k *Keeper,
+	index *MultiIndex[R, string, checkers.StoredGame],
+	refKey R,
+	pageReq *query.PageRequest,
+	match func(checkers.StoredGame) bool,
+) ([]checkers.IndexedStoredGame, *query.PageResponse, error) {
+	// transform 紧接着 predicate 对同一条索引项调用，直接使用 predicate 读取的游戏
+	var storedGame checkers.StoredGame
+	predicate := func(key collections.Pair[R, string], _ collections.NoValue) (bool, error) {
+		var err error
+		if storedGame, err = k.StoredGames.Get(ctx, key.K2()); err != nil {
+			return false, err
+		}
+		return match == nil || match(storedGame), nil
+	}
+	transform := func(key collections.Pair[R, string], _ collections.NoValue) (checkers.IndexedStoredGame, error) {
+		return checkers.IndexedStoredGame{Index: key.K2(), StoredGame: storedGame}, nil
+	}
+	return query.CollectionFilteredPaginate(ctx, index.RefKeys, pageReq, predicate, transform,
+		query.WithCollectionPaginationPairPrefix[R, string](refKey))
+}
 
-	return &checkers.QueryGetRecordListResponse{Records: recordList}, nil
+// GetPlayerInfo QueryGetPlayerInfoRequest 消息的 handler，获取玩家的对局统计
+func (qs queryServer) GetPlayerInfo(ctx context.Context, req *checkers.QueryGetPlayerInfoRequest) (*checkers.QueryGetPlayerInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid player address: %s", err)
+	}
+	playerInfo, err := qs.k.getPlayerInfo(ctx, req.Player)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &checkers.QueryGetPlayerInfoResponse{PlayerInfo: playerInfo}, nil
+}
+
+// GetTournament QueryGetTournamentRequest 消息的 handler，获取锦标赛
+func (qs queryServer) GetTournament(ctx context.Context, req *checkers.QueryGetTournamentRequest) (*checkers.QueryGetTournamentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	tournament, err := qs.k.Tournaments.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "tournament %d not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryGetTournamentResponse{Tournament: tournament}, nil
+}
+
+// ListTournaments QueryListTournamentsRequest 消息的 handler，按 id 分页列出锦标赛
+func (qs queryServer) ListTournaments(ctx context.Context, req *checkers.QueryListTournamentsRequest) (*checkers.QueryListTournamentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	tournaments, pageRes, err := query.CollectionPaginate(ctx, qs.k.Tournaments, req.Pagination,
+		func(_ uint64, tournament checkers.Tournament) (checkers.Tournament, error) {
+			return tournament, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryListTournamentsResponse{Tournaments: tournaments, Pagination: pageRes}, nil
+}
+
+// TournamentStandings QueryTournamentStandingsRequest 消息的 handler，获取锦标赛的排名
+func (qs queryServer) TournamentStandings(ctx context.Context, req *checkers.QueryTournamentStandingsRequest) (*checkers.QueryTournamentStandingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	tournament, err := qs.k.Tournaments.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "tournament %d not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	standings, err := qs.k.getTournamentPlayers(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	checkers.SortStandings(tournament, standings)
+	return &checkers.QueryTournamentStandingsResponse{Standings: standings}, nil
+}
+
+// Invariants QueryInvariantsRequest 消息的 handler，检查模块的不变量
+func (qs queryServer) Invariants(ctx context.Context, req *checkers.QueryInvariantsRequest) (*checkers.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	response := &checkers.QueryInvariantsResponse{}
+	for _, route := range invariantRoutes {
+		if req.Route != "" && req.Route != route.route {
+			continue
+		}
+		msg, broken := route.invariant(&qs.k)(sdkCtx)
+		response.Results = append(response.Results, checkers.InvariantResult{
+			Route:   route.route,
+			Broken:  broken,
+			Message: msg,
+		})
+	}
+	if len(response.Results) == 0 {
+		return nil, status.Errorf(codes.NotFound, "invariant route %s", req.Route)
+	}
+	return response, nil
+}
+
+// Channels QueryChannelsRequest 消息的 handler，按通道分页列出模块的端口上已经开启的通道
+func (qs queryServer) Channels(ctx context.Context, req *checkers.QueryChannelsRequest) (*checkers.QueryChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	channels, pageRes, err := query.CollectionPaginate(ctx, qs.k.IbcChannels, req.Pagination,
+		func(_ string, ibcChannel checkers.IbcChannel) (checkers.IbcChannel, error) {
+			return ibcChannel, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defaultChannel, err := qs.k.DefaultChannel.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &checkers.QueryChannelsResponse{Channels: channels, DefaultChannel: defaultChannel, Pagination: pageRes}, nil
 }
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/rules"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// setGames 保存以下游戏，玩家 0 参与了 1、2、4、6，其中 2 是大厅中的游戏
+func setGames(t *testing.T, f *testutil.Fixture) {
+	t.Helper()
+	p0, p1, p2 := testutil.Address(0), testutil.Address(1), testutil.Address(2)
+	games := map[string]checkers.StoredGame{
+		"1": {Black: p0, Red: p1, Status: checkers.GameStatus_GAME_STATUS_ACTIVE, Turn: "b"},
+		"2": {Black: p0, Status: checkers.GameStatus_GAME_STATUS_OPEN, Turn: "b"},
+		"3": {Black: p1, Red: p2, Status: checkers.GameStatus_GAME_STATUS_ACTIVE, Turn: "b"},
+		"4": {Black: p1, Red: p0, Status: checkers.GameStatus_GAME_STATUS_ACTIVE, Turn: "b"},
+		"5": {Red: p2, Status: checkers.GameStatus_GAME_STATUS_OPEN, Turn: "b"},
+		"6": {Black: p2, Red: p0, Status: checkers.GameStatus_GAME_STATUS_FINISHED, Turn: "b", Winner: "b"},
+	}
+	for index, storedGame := range games {
+		storedGame.Board = rules.New().String()
+		require.NoError(t, f.Keeper.StoredGames.Set(f.Ctx, index, storedGame))
+	}
+}
+
+func gameIndexes(games []checkers.IndexedStoredGame) []string {
+	indexes := make([]string, len(games))
+	for i, game := range games {
+		indexes[i] = game.Index
+	}
+	return indexes
+}
+
+func TestListGamesByPlayerPaginatesIndex(t *testing.T) {
+	f := testutil.NewFixture(t)
+	setGames(t, f)
+	qs := keeper.NewQueryServerImpl(*f.Keeper)
+	player := testutil.Address(0)
+
+	res, err := qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{
+		Player:     player,
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "4"}, gameIndexes(res.Games))
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.Equal(t, []byte("6"), res.Pagination.NextKey)
+
+	res, err = qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{
+		Player:     player,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"6"}, gameIndexes(res.Games))
+	require.Nil(t, res.Pagination.NextKey)
+
+	// 玩家参与的游戏再按状态和是否轮到该玩家过滤
+	res, err = qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{
+		Player: player,
+		Status: checkers.GameStatus_GAME_STATUS_ACTIVE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "4"}, gameIndexes(res.Games))
+	res, err = qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{Player: player, AwaitingMove: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, gameIndexes(res.Games))
+}
+
+func TestListGamesByStatusPaginatesIndex(t *testing.T) {
+	f := testutil.NewFixture(t)
+	setGames(t, f)
+	qs := keeper.NewQueryServerImpl(*f.Keeper)
+
+	res, err := qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{
+		Status:     checkers.GameStatus_GAME_STATUS_ACTIVE,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"3"}, gameIndexes(res.Games))
+	require.Equal(t, []byte("4"), res.Pagination.NextKey)
+
+	open, err := qs.OpenGames(f.Ctx, &checkers.QueryOpenGamesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "5"}, gameIndexes(open.Games))
+
+	// 游戏的状态改变后，索引随之更新
+	storedGame, err := f.Keeper.StoredGames.Get(f.Ctx, "2")
+	require.NoError(t, err)
+	storedGame.Red = testutil.Address(3)
+	storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+	require.NoError(t, f.Keeper.StoredGames.Set(f.Ctx, "2", storedGame))
+	open, err = qs.OpenGames(f.Ctx, &checkers.QueryOpenGamesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"5"}, gameIndexes(open.Games))
+	res, err = qs.ListGames(f.Ctx, &checkers.QueryListGamesRequest{Player: testutil.Address(3)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, gameIndexes(res.Games))
+}
+
+func TestGetPlayerInfoRejectsInvalidRequests(t *testing.T) {
+	f := testutil.NewFixture(t)
+	qs := keeper.NewQueryServerImpl(*f.Keeper)
+
+	_, err := qs.GetPlayerInfo(f.Ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.GetPlayerInfo(f.Ctx, &checkers.QueryGetPlayerInfoRequest{Player: "not an address"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := qs.GetPlayerInfo(f.Ctx, &checkers.QueryGetPlayerInfoRequest{Player: testutil.Address(0)})
+	require.NoError(t, err)
+	require.Zero(t, res.PlayerInfo.WonCount)
+}
@@ -0,0 +1,368 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// AddRecord 在当前区块添加一条 record，creator 为 record 的作者，由模块添加时为空
+// record 的高度和时间取自区块头，而不是本地时钟，以保证各验证者的状态一致
+// 这里不检查参数中的内容限制，MsgAddRecord 提交的 record 由 SubmitRecord 检查
+func (k *Keeper) AddRecord(ctx context.Context, source checkers.RecordSource, text string, creator string, tags []string) (checkers.Record, error) {
+	id, err := k.RecordSeq.Next(ctx)
+	if err != nil {
+		return checkers.Record{}, err
+	}
+
+	headerInfo := k.headerService.GetHeaderInfo(ctx)
+	record := checkers.Record{
+		Id:      id,
+		Height:  headerInfo.Height,
+		Time:    headerInfo.Time,
+		Source:  source,
+		Text:    text,
+		Creator: creator,
+		Tags:    tags,
+	}
+	if err := record.Validate(); err != nil {
+		return checkers.Record{}, err
+	}
+
+	// 将 record 连接到链的头部，并成为新的头部
+	head, err := k.RecordLogHead.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return checkers.Record{}, err
+	}
+	record.PrevHash = head.Hash
+	record.Hash = record.ComputeHash()
+	if err := k.Records.Set(ctx, record.Id, record); err != nil {
+		return checkers.Record{}, err
+	}
+	if err := k.RecordLogHead.Set(ctx, checkers.RecordLogHead{Id: record.Id, Hash: record.Hash}); err != nil {
+		return checkers.Record{}, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventRecordAdded{
+		Record:  record.Text,
+		Creator: creator,
+		Id:      record.Id,
+		Height:  record.Height,
+		Source:  record.Source,
+	}); err != nil {
+		return checkers.Record{}, err
+	}
+
+	return record, nil
+}
+
+// SubmitRecord 添加一条由 creator 通过 MsgAddRecord 提交的 record
+// 依次检查参数中的内容限制和账户的数量限制，并收取 record 的费用
+func (k *Keeper) SubmitRecord(ctx context.Context, creator string, text string, tags []string) (checkers.Record, error) {
+	params, creatorAddr, err := k.checkRecordPolicy(ctx, creator, text)
+	if err != nil {
+		return checkers.Record{}, err
+	}
+	if err := k.chargeRecordFee(ctx, params, creatorAddr); err != nil {
+		return checkers.Record{}, err
+	}
+	return k.AddRecord(ctx, checkers.RecordSource_RECORD_SOURCE_TX, text, creator, tags)
+}
+
+// recordDenylistCache 为最近一次编译的 params.RecordDenylist 及其正则表达式
+// CheckTx 和区块的执行可能并发进行，因此需要加锁
+type recordDenylistCache struct {
+	mu       sync.Mutex
+	denylist []string
+	patterns []*regexp.Regexp
+}
+
+// recordDenylistPatterns 返回 params.RecordDenylist 编译后的正则表达式
+// 只在参数的 denylist 变化后重新编译，编译的结果与缓存无关，不影响共识
+func (k *Keeper) recordDenylistPatterns(params checkers.Params) ([]*regexp.Regexp, error) {
+	cache := k.recordDenylist
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.patterns != nil && slices.Equal(cache.denylist, params.RecordDenylist) {
+		return cache.patterns, nil
+	}
+	patterns, err := params.RecordDenylistPatterns()
+	if err != nil {
+		return nil, err
+	}
+	cache.denylist = slices.Clone(params.RecordDenylist)
+	cache.patterns = patterns
+	return patterns, nil
+}
+
+// validateRecord 使用缓存的正则表达式检查 record 是否满足参数的限制，参见 Params.ValidateRecord
+func (k *Keeper) validateRecord(params checkers.Params, text string) error {
+	denylist, err := k.recordDenylistPatterns(params)
+	if err != nil {
+		return err
+	}
+	return params.ValidateRecord(text, denylist)
+}
+
+// checkRecordPolicy 对账户提交的 record 检查参数中的内容限制和账户的数量限制，返回当前的参数和账户地址
+func (k *Keeper) checkRecordPolicy(ctx context.Context, creator string, text string) (checkers.Params, sdk.AccAddress, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return checkers.Params{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return checkers.Params{}, nil, err
+	}
+	if err := k.validateRecord(params, text); err != nil {
+		return checkers.Params{}, nil, err
+	}
+	if err := k.countRecord(ctx, params, creator); err != nil {
+		return checkers.Params{}, nil, err
+	}
+	return params, creatorAddr, nil
+}
+
+// DeleteRecord 删除 id 对应的 record，只有 record 的作者可以删除
+// 已经计入数量限制的 record 被删除后不会退还额度，费用也不会退还
+func (k *Keeper) DeleteRecord(ctx context.Context, id uint64, creator string) error {
+	record, err := k.Records.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(checkers.ErrRecordNotFound, "%d", id)
+	}
+	if err != nil {
+		return err
+	}
+	if record.Creator == "" || record.Creator != creator {
+		return errorsmod.Wrapf(checkers.ErrNotRecordAuthor, "record %d, author %q, got %s", id, record.Creator, creator)
+	}
+	if err := k.Records.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventRecordDeleted{
+		Id:      id,
+		Creator: creator,
+	})
+}
+
+// countRecord 将 creator 在当前窗口内添加的 record 数量加一，超过 params.RecordRateLimit 时返回错误
+// 窗口从高度为 RecordRateWindow 整数倍的区块开始，进入新的窗口时重新计数
+func (k *Keeper) countRecord(ctx context.Context, params checkers.Params, creator string) error {
+	if params.RecordRateLimit == 0 {
+		return nil
+	}
+	height := k.headerService.GetHeaderInfo(ctx).Height
+	windowStart := height - height%int64(params.RecordRateWindow)
+
+	counter, err := k.RecordRateCounters.Get(ctx, creator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err != nil || counter.WindowStart != windowStart {
+		counter = checkers.RecordRateCounter{Author: creator, WindowStart: windowStart}
+	}
+	if counter.Count >= params.RecordRateLimit {
+		return errorsmod.Wrapf(checkers.ErrRecordRateLimited, "%d records since height %d, at most %d per %d blocks",
+			counter.Count, windowStart, params.RecordRateLimit, params.RecordRateWindow)
+	}
+	counter.Count++
+	return k.RecordRateCounters.Set(ctx, creator, counter)
+}
+
+// uncountRecord 撤销 creator 在 sentHeight 添加的 record 的计数，已经进入新的窗口时不做处理
+func (k *Keeper) uncountRecord(ctx context.Context, creator string, sentHeight int64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.RecordRateLimit == 0 {
+		return nil
+	}
+	counter, err := k.RecordRateCounters.Get(ctx, creator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	windowStart := sentHeight - sentHeight%int64(params.RecordRateWindow)
+	if counter.WindowStart != windowStart || counter.Count == 0 {
+		return nil
+	}
+	counter.Count--
+	return k.RecordRateCounters.Set(ctx, creator, counter)
+}
+
+// escrowRecordFee 将 params.RecordFee 从 creator 转入模块账户托管，返回托管的费用
+// 托管的费用由 settleRecordFee 结算，或者退回 creator
+func (k *Keeper) escrowRecordFee(ctx context.Context, params checkers.Params, creator sdk.AccAddress) (sdk.Coin, error) {
+	if !params.HasRecordFee() {
+		return sdk.Coin{}, nil
+	}
+	if err := k.CheckRecordFeeDestination(params); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, checkers.ModuleName, sdk.NewCoins(params.RecordFee)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return params.RecordFee, nil
+}
+
+// CheckRecordFeeDestination 检查 params 中收取的 record 费用能否按 RecordFeeDestination 结算
+// 销毁费用需要模块账户有 authtypes.Burner 权限，转入社区池需要 distribution 模块
+// 在参数生效之前检查，避免之后每一次结算费用（包括 IBC 确认中的结算）都失败
+func (k *Keeper) CheckRecordFeeDestination(params checkers.Params) error {
+	if !params.HasRecordFee() {
+		return nil
+	}
+	switch params.RecordFeeDestination {
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_BURN:
+		if k.accountKeeper == nil {
+			return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "module account permissions are not available")
+		}
+		if _, permissions := k.accountKeeper.GetModuleAddressAndPermissions(checkers.ModuleName); !slices.Contains(permissions, authtypes.Burner) {
+			return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "module account %s lacks the %s permission", checkers.ModuleName, authtypes.Burner)
+		}
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL:
+		if k.distrKeeper == nil {
+			return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "community pool is not available")
+		}
+	}
+	return nil
+}
+
+// settleRecordFee 按 destination 销毁托管在模块账户中的费用或将其转入社区池
+func (k *Keeper) settleRecordFee(ctx context.Context, fee sdk.Coin, destination checkers.RecordFeeDestination) error {
+	if !checkers.HasWager(fee) {
+		return nil
+	}
+	fees := sdk.NewCoins(fee)
+	switch destination {
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_BURN:
+		return k.bankKeeper.BurnCoins(ctx, checkers.ModuleName, fees)
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL:
+		if k.distrKeeper == nil {
+			return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "community pool is not available")
+		}
+		return k.distrKeeper.FundCommunityPool(ctx, fees, authtypes.NewModuleAddress(checkers.ModuleName))
+	default:
+		return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "destination %s", destination)
+	}
+}
+
+// chargeRecordFee 从 creator 收取 params.RecordFee，按 params.RecordFeeDestination 销毁或转入社区池
+func (k *Keeper) chargeRecordFee(ctx context.Context, params checkers.Params, creator sdk.AccAddress) error {
+	if !params.HasRecordFee() {
+		return nil
+	}
+	if err := k.CheckRecordFeeDestination(params); err != nil {
+		return err
+	}
+	fee := sdk.NewCoins(params.RecordFee)
+	switch params.RecordFeeDestination {
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_BURN:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, checkers.ModuleName, fee); err != nil {
+			return err
+		}
+		return k.bankKeeper.BurnCoins(ctx, checkers.ModuleName, fee)
+	case checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, fee, creator)
+	default:
+		return errorsmod.Wrapf(checkers.ErrInvalidRecordFee, "destination %s", params.RecordFeeDestination)
+	}
+}
+
+// PruneRecords 删除 beforeHeight 之前（不含）的所有 record，返回删除的数量
+// authority 只用于事件，由 EndBlock 按保留期删除时为空
+func (k *Keeper) PruneRecords(ctx context.Context, beforeHeight int64, authority string) (uint64, error) {
+	// 通过高度索引找到需要删除的 record，先收集 id，避免在遍历的同时修改存储
+	var ids []uint64
+	if err := k.Records.Indexes.Height.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](beforeHeight-1), func(_ int64, id uint64) (bool, error) {
+		ids = append(ids, id)
+		return false, nil
+	}); err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		if err := k.Records.Remove(ctx, id); err != nil {
+			return 0, err
+		}
+	}
+
+	pruned := uint64(len(ids))
+	if pruned == 0 {
+		return 0, nil
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventRecordsPruned{
+		BeforeHeight: beforeHeight,
+		Count:        pruned,
+		Authority:    authority,
+	}); err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
+// PruneExpiredRecords 删除超过 params.RecordRetention 个区块的 record，在 EndBlock 中调用
+// 当前区块添加的 record 保留 RecordRetention 个区块（含当前区块）
+func (k *Keeper) PruneExpiredRecords(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	beforeHeight := k.headerService.GetHeaderInfo(ctx).Height - int64(params.RecordRetention) + 1
+	if beforeHeight <= 0 {
+		return nil
+	}
+	_, err = k.PruneRecords(ctx, beforeHeight, "")
+	return err
+}
+
+// VerifyRecordRange 验证 id 在 [from, to] 之间的 record，返回验证通过的 record 数量
+// 范围内的 record 必须全部存在，被删除或超过保留期的 record 视为验证失败
+// 前一条 record 已被删除时，范围内第一条 record 的 prev_hash 无法验证
+func (k *Keeper) VerifyRecordRange(ctx context.Context, from, to uint64) (checked uint64, brokenId uint64, err error) {
+	if from > to || to-from >= checkers.MaxVerifyRecordRange {
+		return 0, 0, errorsmod.Wrapf(checkers.ErrInvalidRecordRange, "[%d, %d], at most %d records", from, to, checkers.MaxVerifyRecordRange)
+	}
+
+	var prevHash []byte
+	hasPrev := from == 0
+	if from > 0 {
+		prev, err := k.Records.Get(ctx, from-1)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return 0, 0, err
+		}
+		prevHash, hasPrev = prev.Hash, err == nil
+	}
+	for id := from; id <= to; id++ {
+		record, err := k.Records.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			return checked, id, errorsmod.Wrapf(checkers.ErrRecordChainBroken, "record %d not found, it may have been deleted or pruned", id)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if !hasPrev {
+			prevHash, hasPrev = record.PrevHash, true
+		}
+		if err := record.VerifyHash(prevHash); err != nil {
+			return checked, id, err
+		}
+		prevHash = record.Hash
+		checked++
+	}
+	return checked, 0, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// 销毁 record 的费用需要模块账户有 Burner 权限，转入社区池需要 distribution 模块，
+// 不能结算费用的参数在生效之前被拒绝
+func TestRecordFeeDestinationNeedsPermission(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	params := checkers.DefaultParams()
+	params.RecordFee = sdk.NewCoin("stake", sdkmath.NewInt(10))
+	params.RecordFeeDestination = checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_BURN
+
+	_, err := ms.UpdateParams(f.Ctx, &checkers.MsgUpdateParams{Authority: f.Authority, Params: params})
+	require.NoError(t, err)
+
+	f.Account.Permissions[checkers.ModuleName] = nil
+	_, err = ms.UpdateParams(f.Ctx, &checkers.MsgUpdateParams{Authority: f.Authority, Params: params})
+	require.ErrorIs(t, err, checkers.ErrInvalidRecordFee)
+	gs := checkers.NewGenesisState()
+	gs.Params = params
+	require.ErrorIs(t, f.Keeper.InitGenesis(f.Ctx, gs), checkers.ErrInvalidRecordFee)
+
+	// 已经生效的参数在失去权限后，新的 record 在收取费用之前被拒绝
+	creator := testutil.Address(0)
+	f.Bank.Fund(sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(params.RecordFee))
+	_, err = ms.AddRecord(f.Ctx, &checkers.MsgAddRecord{Creator: creator, Value: "record"})
+	require.ErrorIs(t, err, checkers.ErrInvalidRecordFee)
+	require.Equal(t, sdk.NewCoins(params.RecordFee), f.Bank.Balances[creator])
+
+	params.RecordFeeDestination = checkers.RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL
+	_, err = ms.UpdateParams(f.Ctx, &checkers.MsgUpdateParams{Authority: f.Authority, Params: params})
+	require.ErrorIs(t, err, checkers.ErrInvalidRecordFee)
+}
+
+// 编译后的 denylist 被缓存，参数变化后使用新的 denylist
+func TestRecordDenylistFollowsParams(t *testing.T) {
+	f := testutil.NewFixture(t)
+	creator := testutil.Address(0)
+	params := checkers.DefaultParams()
+	params.RecordDenylist = []string{"^spam"}
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+
+	for i := 0; i < 2; i++ {
+		_, err := f.Keeper.SubmitRecord(f.Ctx, creator, "spam record", nil)
+		require.ErrorIs(t, err, checkers.ErrRecordDenied)
+	}
+	_, err := f.Keeper.SubmitRecord(f.Ctx, creator, "ham record", nil)
+	require.NoError(t, err)
+
+	params.RecordDenylist = []string{"^ham"}
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+	_, err = f.Keeper.SubmitRecord(f.Ctx, creator, "spam record", nil)
+	require.NoError(t, err)
+	_, err = f.Keeper.SubmitRecord(f.Ctx, creator, "ham record", nil)
+	require.ErrorIs(t, err, checkers.ErrRecordDenied)
+}
@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/buzzing/checkers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// playerGameIndexes 返回 player 作为黑方或红方参与的所有游戏的索引，按升序排列
+func (k *Keeper) playerGameIndexes(ctx context.Context, player string) ([]string, error) {
+	iter, err := k.StoredGames.Indexes.Player.MatchExact(ctx, player)
+	if err != nil {
+		return nil, err
+	}
+	return iter.PrimaryKeys()
+}
+
+// finishGame 结束一局游戏，记录获胜方，支付赌注并更新双方玩家的对局统计和等级分
+// winner 的取值与 StoredGame.Winner 相同，为 "*" 时表示和棋
+func (k *Keeper) finishGame(ctx context.Context, index string, storedGame checkers.StoredGame, winner string, reason checkers.GameEndReason) error {
+	storedGame.Status = checkers.GameStatus_GAME_STATUS_FINISHED
+	storedGame.Winner = winner
+	storedGame.DrawOffer = ""
+	if err := k.scheduleDeadline(ctx, index, &storedGame); err != nil {
+		return err
+	}
+	if err := storedGame.Validate(); err != nil {
+		return err
+	}
+	if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+		return err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventGameEnded{
+		GameIndex: index,
+		Winner:    winner,
+		Reason:    reason,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+		MoveCount: storedGame.MoveCount,
+	}); err != nil {
+		return err
+	}
+
+	winnerAddress, found := storedGame.PlayerAddress(winner)
+	if !found {
+		// 和棋，双方的赌注各自退回
+		if err := k.refundWagers(ctx, storedGame); err != nil {
+			return err
+		}
+	} else if err := k.payWinnings(ctx, storedGame, winnerAddress); err != nil {
+		return err
+	}
+	if err := k.updatePlayerStats(ctx, storedGame); err != nil {
+		return err
+	}
+
+	return k.callHook(ctx, "AfterGameEnded", func(ctx context.Context) error {
+		return k.Hooks().AfterGameEnded(ctx, index, storedGame)
+	})
+}
+
+// removeGame 删除一局还没有开始下棋就被取消的游戏（被拒绝或邀请过期），退回赌注，
+// 发出 event 并调用 AfterGameEnded 回调
+// 回调收到的是删除之前的游戏，状态不是 GAME_STATUS_FINISHED，可以据此与下完的游戏区分
+func (k *Keeper) removeGame(ctx context.Context, index string, storedGame checkers.StoredGame, event proto.Message) error {
+	if err := k.refundWagers(ctx, storedGame); err != nil {
+		return err
+	}
+	if storedGame.Deadline != 0 {
+		if err := k.Deadlines.Remove(ctx, collections.Join(storedGame.Deadline, index)); err != nil {
+			return err
+		}
+	}
+	if err := k.StoredGames.Remove(ctx, index); err != nil {
+		return err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		return err
+	}
+
+	return k.callHook(ctx, "AfterGameEnded", func(ctx context.Context) error {
+		return k.Hooks().AfterGameEnded(ctx, index, storedGame)
+	})
+}
+
+// updatePlayerStats 根据已经结束的游戏更新双方玩家的对局统计和等级分
+func (k *Keeper) updatePlayerStats(ctx context.Context, storedGame checkers.StoredGame) error {
+	winnerAddress, found := storedGame.PlayerAddress(storedGame.Winner)
+	loserAddress, _ := storedGame.PlayerAddress(checkers.OpponentColor(storedGame.Winner))
+	draw := !found
+	if draw {
+		// 统计时双方对称，顺序不影响结果
+		winnerAddress, loserAddress = storedGame.Black, storedGame.Red
+	}
+
+	// 自己与自己对局不计入统计
+	if winnerAddress == loserAddress {
+		return nil
+	}
+	// 只有官方锦标赛的对局计入统计
+	if storedGame.TournamentId != 0 {
+		tournament, err := k.Tournaments.Get(ctx, storedGame.TournamentId)
+		if err != nil {
+			return err
+		}
+		if !tournament.Sanctioned {
+			return nil
+		}
+	}
+	winnerInfo, err := k.getPlayerInfo(ctx, winnerAddress)
+	if err != nil {
+		return err
+	}
+	loserInfo, err := k.getPlayerInfo(ctx, loserAddress)
+	if err != nil {
+		return err
+	}
+	if draw {
+		winnerInfo.DrawnCount++
+		loserInfo.DrawnCount++
+	} else {
+		winnerInfo.WonCount++
+		loserInfo.LostCount++
+	}
+	winnerInfo.Rating, loserInfo.Rating = checkers.UpdateRatings(winnerInfo.Rating, loserInfo.Rating, draw)
+	if err := k.PlayerInfo.Set(ctx, winnerAddress, winnerInfo); err != nil {
+		return err
+	}
+	return k.PlayerInfo.Set(ctx, loserAddress, loserInfo)
+}
+
+// acceptSeats 将 player 在游戏中占据的每一方都标记为已接受，并为每一方托管一份赌注
+// 返回新接受的席位数量，player 的所有席位都已接受时返回 0
+func (k *Keeper) acceptSeats(ctx context.Context, storedGame *checkers.StoredGame, player string) (int, error) {
+	accepted := 0
+	if storedGame.Black == player && !storedGame.BlackAccepted {
+		if err := k.collectWager(ctx, storedGame.Wager, player); err != nil {
+			return accepted, err
+		}
+		storedGame.BlackAccepted = true
+		accepted++
+	}
+	if storedGame.Red == player && !storedGame.RedAccepted {
+		if err := k.collectWager(ctx, storedGame.Wager, player); err != nil {
+			return accepted, err
+		}
+		storedGame.RedAccepted = true
+		accepted++
+	}
+	return accepted, nil
+}
+
+// getPlayerInfo 读取玩家的对局统计，玩家没有统计记录时返回初始的统计
+func (k *Keeper) getPlayerInfo(ctx context.Context, player string) (checkers.PlayerInfo, error) {
+	playerInfo, err := k.PlayerInfo.Get(ctx, player)
+	if errors.Is(err, collections.ErrNotFound) {
+		return checkers.PlayerInfo{Rating: checkers.DefaultRating}, nil
+	}
+	return playerInfo, err
+}
+
+// ExpireInvitations 删除在当前区块高度已经过期、仍未被双方接受的游戏邀请
+// 在 EndBlock 中调用，通过 InvitationExpiry 索引只遍历已经过期的邀请
+func (k *Keeper) ExpireInvitations(ctx context.Context) error {
+	ctx = inEndBlock(ctx)
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// 先收集再删除，避免在遍历索引时修改索引
+	var expired []string
+	if err := k.StoredGames.Indexes.InvitationExpiry.RefKeys.Walk(ctx, collections.NewPrefixUntilPairRange[int64, string](height-1), func(key collections.Pair[int64, string]) (bool, error) {
+		expired = append(expired, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, index := range expired {
+		storedGame, err := k.StoredGames.Get(ctx, index)
+		if err != nil {
+			return err
+		}
+		if err := k.removeGame(ctx, index, storedGame, &checkers.EventGameExpired{GameIndex: index}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleDeadline 重新计算游戏当前回合的 deadline，并同步维护 Deadlines 集合
+// 只有限时的、正在进行的游戏才会加入 Deadlines，否则 deadline 被清零
+// 调用者需要在之后保存 storedGame
+func (k *Keeper) scheduleDeadline(ctx context.Context, index string, storedGame *checkers.StoredGame) error {
+	if storedGame.Deadline != 0 {
+		if err := k.Deadlines.Remove(ctx, collections.Join(storedGame.Deadline, index)); err != nil {
+			return err
+		}
+		storedGame.Deadline = 0
+	}
+	if storedGame.TurnDuration == 0 || storedGame.Status != checkers.GameStatus_GAME_STATUS_ACTIVE {
+		return nil
+	}
+	storedGame.Deadline = sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(storedGame.TurnDuration)
+	return k.Deadlines.Set(ctx, collections.Join(storedGame.Deadline, index))
+}
+
+// ForfeitTimedOutGames 结束所有在当前区块高度已经超时的游戏，超时一方判负
+// 在 EndBlock 中调用，通过 Deadlines 只遍历已经超时的游戏
+func (k *Keeper) ForfeitTimedOutGames(ctx context.Context) error {
+	ctx = inEndBlock(ctx)
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// deadline 当天仍可以走棋，因此只处理 deadline 小于当前高度的游戏
+	// 先收集再处理，避免在遍历时修改集合
+	var timedOut []collections.Pair[int64, string]
+	if err := k.Deadlines.Walk(ctx, collections.NewPrefixUntilPairRange[int64, string](height-1), func(key collections.Pair[int64, string]) (bool, error) {
+		timedOut = append(timedOut, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range timedOut {
+		index := key.K2()
+		storedGame, err := k.StoredGames.Get(ctx, index)
+		if err != nil {
+			return err
+		}
+		winner := checkers.OpponentColor(storedGame.Turn)
+		if err := k.finishGame(ctx, index, storedGame, winner, checkers.GameEndReason_GAME_END_REASON_TIMED_OUT); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextGameIndex 返回一个尚未被使用的、由模块自动创建的游戏索引，格式为 <prefix>-<n>
+// 玩家可能已经用同样的索引创建了游戏，此时递增 n 直到找到空闲的索引
+func (k *Keeper) nextGameIndex(ctx context.Context, prefix string, n int) (string, error) {
+	for ; ; n++ {
+		index := fmt.Sprintf("%s-%d", prefix, n)
+		found, err := k.StoredGames.Has(ctx, index)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return index, nil
+		}
+	}
+}
+
+// ActiveGameCount 返回 player 已经接受的、尚未结束的游戏数量，锦标赛的游戏不计入
+// 其他账户发来的、player 还没有接受的邀请不计入，以免大量的邀请占满 player 的名额
+func (k *Keeper) ActiveGameCount(ctx context.Context, player string) (uint64, error) {
+	indexes, err := k.playerGameIndexes(ctx, player)
+	if err != nil {
+		return 0, err
+	}
+	active := uint64(0)
+	for _, index := range indexes {
+		storedGame, err := k.StoredGames.Get(ctx, index)
+		if err != nil {
+			return 0, err
+		}
+		if storedGame.Status == checkers.GameStatus_GAME_STATUS_FINISHED || storedGame.TournamentId != 0 {
+			continue
+		}
+		if (storedGame.Black == player && storedGame.BlackAccepted) || (storedGame.Red == player && storedGame.RedAccepted) {
+			active++
+		}
+	}
+	return active, nil
+}
+
+// checkActiveGames 检查 player 已经接受的、尚未结束的游戏数量是否已经达到 params.MaxActiveGames
+// 在 player 接受一局游戏之前调用，包括创建邀请、接受邀请、加入大厅中的游戏和加入匹配队列
+func (k *Keeper) checkActiveGames(ctx context.Context, params checkers.Params, player string) error {
+	active, err := k.ActiveGameCount(ctx, player)
+	if err != nil {
+		return err
+	}
+	if active >= params.MaxActiveGames {
+		return errorsmod.Wrapf(checkers.ErrTooManyActiveGames, "%s has %d games", player, active)
+	}
+	return nil
+}
+
+// afterGameCreated 在游戏创建并保存后调用，发出 EventGameCreated 事件并调用 AfterGameCreated 回调
+// 游戏创建时已经开始的，同时发出 EventGameStarted 事件
+// creator 为空时表示游戏由模块自动创建
+func (k *Keeper) afterGameCreated(ctx context.Context, index string, creator string, storedGame checkers.StoredGame) error {
+	eventManager := sdk.UnwrapSDKContext(ctx).EventManager()
+	if err := eventManager.EmitTypedEvent(&checkers.EventGameCreated{
+		GameIndex:    index,
+		Creator:      creator,
+		Black:        storedGame.Black,
+		Red:          storedGame.Red,
+		Status:       storedGame.Status,
+		Wager:        storedGame.Wager,
+		TurnDuration: storedGame.TurnDuration,
+		TournamentId: storedGame.TournamentId,
+	}); err != nil {
+		return err
+	}
+	if storedGame.Status == checkers.GameStatus_GAME_STATUS_ACTIVE {
+		if err := eventManager.EmitTypedEvent(&checkers.EventGameStarted{
+			GameIndex: index,
+			Black:     storedGame.Black,
+			Red:       storedGame.Red,
+			Deadline:  storedGame.Deadline,
+		}); err != nil {
+			return err
+		}
+	}
+	return k.callHook(ctx, "AfterGameCreated", func(ctx context.Context) error {
+		return k.Hooks().AfterGameCreated(ctx, index, storedGame)
+	})
+}
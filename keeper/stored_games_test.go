@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// invitationExpiries 返回 InvitationExpiry 索引中的所有 (过期高度, 游戏索引)
+func invitationExpiries(t *testing.T, f *testutil.Fixture) []collections.Pair[int64, string] {
+	t.Helper()
+	iter, err := f.Keeper.StoredGames.Indexes.InvitationExpiry.RefKeys.Iterate(f.Ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	return keys
+}
+
+func TestExpireInvitationsWalksExpiryIndex(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	p0, p1 := testutil.Address(0), testutil.Address(1)
+	expiry := int64(checkers.DefaultParams().InvitationExpiryBlocks)
+
+	for _, index := range []string{"1", "2"} {
+		_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: p0, Index: index, Black: p0, Red: p1})
+		require.NoError(t, err)
+	}
+	later := testutil.WithHeight(f.Ctx, 10)
+	_, err := ms.CreateGame(later, &checkers.MsgCreateGame{Creator: p0, Index: "3", Black: p0, Red: p1})
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[int64, string]{
+		collections.Join(1+expiry, "1"),
+		collections.Join(1+expiry, "2"),
+		collections.Join(10+expiry, "3"),
+	}, invitationExpiries(t, f))
+
+	// 被接受的邀请离开索引，不会过期
+	_, err = ms.AcceptGame(later, &checkers.MsgAcceptGame{Creator: p1, GameIndex: "2"})
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[int64, string]{
+		collections.Join(1+expiry, "1"),
+		collections.Join(10+expiry, "3"),
+	}, invitationExpiries(t, f))
+
+	// 过期高度当块仍然有效
+	require.NoError(t, f.Keeper.ExpireInvitations(testutil.WithHeight(f.Ctx, 1+expiry)))
+	require.Len(t, invitationExpiries(t, f), 2)
+
+	require.NoError(t, f.Keeper.ExpireInvitations(testutil.WithHeight(f.Ctx, 2+expiry)))
+	_, err = f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.Equal(t, []collections.Pair[int64, string]{collections.Join(10+expiry, "3")}, invitationExpiries(t, f))
+	storedGame, err := f.Keeper.StoredGames.Get(f.Ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_ACTIVE, storedGame.Status)
+}
+
+// 其他账户发来的邀请不占用名额，只有玩家自己接受的游戏才计入 MaxActiveGames
+func TestActiveGamesCountOnlyAcceptedSeats(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := checkers.DefaultParams()
+	params.MaxActiveGames = 2
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	victim, attacker, friend := testutil.Address(0), testutil.Address(1), testutil.Address(2)
+
+	for _, index := range []string{"spam-1", "spam-2"} {
+		_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: attacker, Index: index, Black: attacker, Red: victim})
+		require.NoError(t, err)
+	}
+	active, err := f.Keeper.ActiveGameCount(f.Ctx, victim)
+	require.NoError(t, err)
+	require.Zero(t, active)
+
+	// 发出的邀请计入创建者的名额
+	_, err = ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: attacker, Index: "spam-3", Black: attacker, Red: victim})
+	require.ErrorIs(t, err, checkers.ErrTooManyActiveGames)
+
+	// 被邀请的一方仍然可以创建和接受游戏，直到自己接受的游戏达到上限
+	_, err = ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: victim, Index: "own", Black: victim, Red: friend})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: victim, GameIndex: "spam-1"})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: victim, GameIndex: "spam-2"})
+	require.ErrorIs(t, err, checkers.ErrTooManyActiveGames)
+	active, err = f.Keeper.ActiveGameCount(f.Ctx, victim)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), active)
+}
+
+// 被拒绝和邀请过期而删除的游戏同样调用 AfterGameEnded，回调收到删除之前的游戏
+func TestRemovedGamesCallAfterGameEnded(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	p0, p1 := testutil.Address(0), testutil.Address(1)
+	ended := make(map[string]checkers.GameStatus)
+	f.Keeper.SetHooks(testutil.Hooks{
+		GameEnded: func(ctx context.Context, index string, storedGame checkers.StoredGame) error {
+			has, err := f.Keeper.StoredGames.Has(ctx, index)
+			require.NoError(t, err)
+			require.False(t, has)
+			ended[index] = storedGame.Status
+			return nil
+		},
+	})
+
+	for _, index := range []string{"1", "2"} {
+		_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: p0, Index: index, Black: p0, Red: p1})
+		require.NoError(t, err)
+	}
+	_, err := ms.Reject(f.Ctx, &checkers.MsgReject{Creator: p1, GameIndex: "1"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]checkers.GameStatus{"1": checkers.GameStatus_GAME_STATUS_PENDING}, ended)
+
+	expiry := int64(checkers.DefaultParams().InvitationExpiryBlocks)
+	require.NoError(t, f.Keeper.ExpireInvitations(testutil.WithHeight(f.Ctx, 2+expiry)))
+	require.Equal(t, map[string]checkers.GameStatus{
+		"1": checkers.GameStatus_GAME_STATUS_PENDING,
+		"2": checkers.GameStatus_GAME_STATUS_PENDING,
+	}, ended)
+}
@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+)
+
+// tournamentGameIndexPrefix 为锦标赛自动创建的游戏索引的前缀
+const tournamentGameIndexPrefix = "tournament"
+
+// tournamentIDs 返回处于 status 状态的所有锦标赛的 id，按升序排列
+func (k *Keeper) tournamentIDs(ctx context.Context, status checkers.TournamentStatus) ([]uint64, error) {
+	iter, err := k.Tournaments.Indexes.Status.MatchExact(ctx, int32(status))
+	if err != nil {
+		return nil, err
+	}
+	return iter.PrimaryKeys()
+}
+
+// getTournamentPlayers 返回锦标赛的所有参赛玩家，按地址排列
+func (k *Keeper) getTournamentPlayers(ctx context.Context, id uint64) ([]checkers.TournamentPlayer, error) {
+	var players []checkers.TournamentPlayer
+	err := k.TournamentPlayers.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](id), func(_ collections.Pair[uint64, string], player checkers.TournamentPlayer) (bool, error) {
+		players = append(players, player)
+		return false, nil
+	})
+	return players, err
+}
+
+// setTournamentPlayers 保存锦标赛的参赛玩家
+func (k *Keeper) setTournamentPlayers(ctx context.Context, players []checkers.TournamentPlayer) error {
+	for _, player := range players {
+		if err := k.TournamentPlayers.Set(ctx, collections.Join(player.TournamentId, player.Player), player); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AdvanceTournaments 推进所有锦标赛
+// 在 EndBlock 中调用，当前轮次的游戏全部结束（包括超时判负）后记录成绩并开始下一轮，
+// 最后一轮结束后发放奖金；报名截止的锦标赛开始第一轮，报名人数不足时取消
+func (k *Keeper) AdvanceTournaments(ctx context.Context) error {
+	ctx = inEndBlock(ctx)
+	runningIDs, err := k.tournamentIDs(ctx, checkers.TournamentStatus_TOURNAMENT_STATUS_RUNNING)
+	if err != nil {
+		return err
+	}
+	for _, id := range runningIDs {
+		tournament, err := k.Tournaments.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		finished, err := k.isRoundFinished(ctx, tournament)
+		if err != nil {
+			return err
+		}
+		if !finished {
+			continue
+		}
+		if err := k.recordRound(ctx, tournament); err != nil {
+			return err
+		}
+		if tournament.CurrentRound >= tournament.Rounds {
+			err = k.completeTournament(ctx, tournament)
+		} else {
+			err = k.startRound(ctx, tournament)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	registrationIDs, err := k.tournamentIDs(ctx, checkers.TournamentStatus_TOURNAMENT_STATUS_REGISTRATION)
+	if err != nil {
+		return err
+	}
+	for _, id := range registrationIDs {
+		tournament, err := k.Tournaments.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if tournament.StartHeight > height {
+			continue
+		}
+		if err := k.startTournament(ctx, tournament); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startTournament 在报名截止时开始锦标赛的第一轮，报名人数不足两人时取消锦标赛并退回报名费
+func (k *Keeper) startTournament(ctx context.Context, tournament checkers.Tournament) error {
+	if len(tournament.Players) < 2 {
+		for _, player := range tournament.Players {
+			if checkers.HasWager(tournament.EntryFee) {
+				if err := k.payWager(ctx, player, sdk.NewCoins(tournament.EntryFee)); err != nil {
+					return err
+				}
+			}
+		}
+		tournament.Status = checkers.TournamentStatus_TOURNAMENT_STATUS_CANCELLED
+		tournament.PrizePool = sdk.Coin{}
+		if err := k.Tournaments.Set(ctx, tournament.Id, tournament); err != nil {
+			return err
+		}
+		return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventTournamentCancelled{
+			TournamentId: tournament.Id,
+		})
+	}
+
+	tournament.Status = checkers.TournamentStatus_TOURNAMENT_STATUS_RUNNING
+	if tournament.Format == checkers.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN {
+		tournament.Rounds = checkers.RoundRobinRounds(len(tournament.Players))
+	}
+	return k.startRound(ctx, tournament)
+}
+
+// startRound 开始锦标赛的下一轮，为每一对玩家创建一局已经开始的限时游戏，轮空的玩家直接得分
+func (k *Keeper) startRound(ctx context.Context, tournament checkers.Tournament) error {
+	tournament.CurrentRound++
+
+	players, err := k.getTournamentPlayers(ctx, tournament.Id)
+	if err != nil {
+		return err
+	}
+	var pairings []checkers.TournamentPairing
+	switch tournament.Format {
+	case checkers.TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN:
+		pairings = checkers.RoundRobinPairings(tournament.Players, tournament.CurrentRound)
+	case checkers.TournamentFormat_TOURNAMENT_FORMAT_SWISS:
+		checkers.SortStandings(tournament, players)
+		pairings = checkers.SwissPairings(players)
+	}
+
+	byPlayer := make(map[string]*checkers.TournamentPlayer, len(players))
+	for i := range players {
+		byPlayer[players[i].Player] = &players[i]
+	}
+
+	prefix := fmt.Sprintf("%s-%d-%d", tournamentGameIndexPrefix, tournament.Id, tournament.CurrentRound)
+	tournament.RoundGames = nil
+	var byes []string
+	for _, pairing := range pairings {
+		if pairing.Red == "" {
+			byes = append(byes, pairing.Black)
+			bye := byPlayer[pairing.Black]
+			bye.Score += uint64(checkers.TournamentByePoints)
+			bye.Results = append(bye.Results, checkers.TournamentResult{
+				Round:  tournament.CurrentRound,
+				Points: checkers.TournamentByePoints,
+			})
+			continue
+		}
+
+		index, err := k.nextGameIndex(ctx, prefix, len(tournament.RoundGames))
+		if err != nil {
+			return err
+		}
+		newBoard := rules.New()
+		storedGame := checkers.StoredGame{
+			Board:         newBoard.String(),
+			Turn:          rules.PieceStrings[newBoard.Turn],
+			Black:         pairing.Black,
+			Red:           pairing.Red,
+			Status:        checkers.GameStatus_GAME_STATUS_ACTIVE,
+			Winner:        rules.PieceStrings[rules.NO_PLAYER],
+			BlackAccepted: true,
+			RedAccepted:   true,
+			TurnDuration:  tournament.TurnDuration,
+			TournamentId:  tournament.Id,
+		}
+		if err := k.scheduleDeadline(ctx, index, &storedGame); err != nil {
+			return err
+		}
+		if err := storedGame.Validate(); err != nil {
+			return err
+		}
+		if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+			return err
+		}
+		if err := k.afterGameCreated(ctx, index, "", storedGame); err != nil {
+			return err
+		}
+		tournament.RoundGames = append(tournament.RoundGames, index)
+	}
+
+	if err := k.setTournamentPlayers(ctx, players); err != nil {
+		return err
+	}
+	if err := k.Tournaments.Set(ctx, tournament.Id, tournament); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&checkers.EventTournamentRoundStarted{
+		TournamentId: tournament.Id,
+		Round:        tournament.CurrentRound,
+		GameIndexes:  tournament.RoundGames,
+		Byes:         byes,
+	})
+}
+
+// isRoundFinished 返回锦标赛当前轮次的游戏是否全部结束
+// 锦标赛的游戏都是限时的，且不能被拒绝，因此每一轮最终都会结束
+func (k *Keeper) isRoundFinished(ctx context.Context, tournament checkers.Tournament) (bool, error) {
+	for _, index := range tournament.RoundGames {
+		storedGame, err := k.StoredGames.Get(ctx, index)
+		if err != nil {
+			return false, err
+		}
+		if storedGame.Status != checkers.GameStatus_GAME_STATUS_FINISHED {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// recordRound 将当前轮次所有游戏的结果计入双方的成绩，并重新计算所有玩家的 tie-break
+func (k *Keeper) recordRound(ctx context.Context, tournament checkers.Tournament) error {
+	players, err := k.getTournamentPlayers(ctx, tournament.Id)
+	if err != nil {
+		return err
+	}
+	byPlayer := make(map[string]*checkers.TournamentPlayer, len(players))
+	for i := range players {
+		byPlayer[players[i].Player] = &players[i]
+	}
+
+	blackColor := rules.PieceStrings[rules.BLACK_PLAYER]
+	redColor := rules.PieceStrings[rules.RED_PLAYER]
+	for _, index := range tournament.RoundGames {
+		storedGame, err := k.StoredGames.Get(ctx, index)
+		if err != nil {
+			return err
+		}
+		blackPoints, redPoints := checkers.TournamentDrawPoints, checkers.TournamentDrawPoints
+		switch storedGame.Winner {
+		case blackColor:
+			blackPoints, redPoints = checkers.TournamentWinPoints, checkers.TournamentLossPoints
+		case redColor:
+			blackPoints, redPoints = checkers.TournamentLossPoints, checkers.TournamentWinPoints
+		}
+
+		black, red := byPlayer[storedGame.Black], byPlayer[storedGame.Red]
+		black.Score += uint64(blackPoints)
+		black.Results = append(black.Results, checkers.TournamentResult{
+			Round:     tournament.CurrentRound,
+			Opponent:  storedGame.Red,
+			Color:     blackColor,
+			Points:    blackPoints,
+			GameIndex: index,
+		})
+		red.Score += uint64(redPoints)
+		red.Results = append(red.Results, checkers.TournamentResult{
+			Round:     tournament.CurrentRound,
+			Opponent:  storedGame.Black,
+			Color:     redColor,
+			Points:    redPoints,
+			GameIndex: index,
+		})
+	}
+
+	checkers.ComputeTieBreaks(players)
+	return k.setTournamentPlayers(ctx, players)
+}
+
+// completeTournament 结束锦标赛，按最终排名发放奖金
+func (k *Keeper) completeTournament(ctx context.Context, tournament checkers.Tournament) error {
+	players, err := k.getTournamentPlayers(ctx, tournament.Id)
+	if err != nil {
+		return err
+	}
+	checkers.SortStandings(tournament, players)
+
+	event := checkers.EventTournamentFinished{TournamentId: tournament.Id}
+	for _, player := range players {
+		event.Standings = append(event.Standings, player.Player)
+	}
+	if checkers.HasWager(tournament.PrizePool) {
+		prizes := checkers.PrizeAmounts(tournament.PrizePool.Amount, tournament.PrizeShares, len(players))
+		for i, prize := range prizes {
+			event.Prizes = append(event.Prizes, sdk.NewCoin(tournament.PrizePool.Denom, prize))
+			if !prize.IsPositive() {
+				continue
+			}
+			if err := k.payWager(ctx, players[i].Player, sdk.NewCoins(event.Prizes[i])); err != nil {
+				return err
+			}
+		}
+	}
+
+	tournament.Status = checkers.TournamentStatus_TOURNAMENT_STATUS_FINISHED
+	if err := k.Tournaments.Set(ctx, tournament.Id, tournament); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&event)
+}
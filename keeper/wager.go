@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// collectWager 将玩家的赌注从玩家账户托管到模块账户
+// 没有赌注时不做任何操作
+func (k *Keeper) collectWager(ctx context.Context, wager sdk.Coin, player string) error {
+	if !checkers.HasWager(wager) {
+		return nil
+	}
+	address, err := k.addressCodec.StringToBytes(player)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, checkers.ModuleName, sdk.NewCoins(wager))
+}
+
+// refundWagers 将已经托管的赌注退回给已经接受游戏的玩家
+// 用于游戏被拒绝、过期或以和棋结束的情况
+func (k *Keeper) refundWagers(ctx context.Context, storedGame checkers.StoredGame) error {
+	if !storedGame.HasWager() {
+		return nil
+	}
+	if storedGame.BlackAccepted {
+		if err := k.payWager(ctx, storedGame.Black, sdk.NewCoins(storedGame.Wager)); err != nil {
+			return err
+		}
+	}
+	if storedGame.RedAccepted {
+		if err := k.payWager(ctx, storedGame.Red, sdk.NewCoins(storedGame.Wager)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// payWinnings 将双方托管的赌注全部支付给获胜方
+func (k *Keeper) payWinnings(ctx context.Context, storedGame checkers.StoredGame, winner string) error {
+	if !storedGame.HasWager() {
+		return nil
+	}
+	winnings := sdk.NewCoins(storedGame.Wager.Add(storedGame.Wager))
+	return k.payWager(ctx, winner, winnings)
+}
+
+// payWager 将托管在模块账户中的赌注支付给 player
+func (k *Keeper) payWager(ctx context.Context, player string, amount sdk.Coins) error {
+	address, err := k.addressCodec.StringToBytes(player)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, checkers.ModuleName, address, amount)
+}
@@ -13,8 +13,8 @@ const (
 	// ModuleName 定义模块的名称
 	ModuleName = "checkers"
 
-	// Version 定义 IBC 模块支持的版本
-	Version = "checkers-1"
+	// Version 定义 IBC 模块支持的版本，checkers-2 的数据包为 JSON 编码的 CheckersPacketData
+	Version = "checkers-2"
 
 	// PortId 定义模块的默认绑定端口
 	PortId = "checkers"
@@ -26,5 +26,50 @@ const MaxIndexLength = 256
 var (
 	ParamsKey      = collections.NewPrefix("Params")
 	StoredGamesKey = collections.NewPrefix("StoredGames/value/")
-	RecordKey      = collections.NewPrefix("Record/value/")
+	// RecordsKey 为按 id 保存的 record
+	RecordsKey   = collections.NewPrefix("Records/id/")
+	RecordSeqKey = collections.NewPrefix("Records/seq/")
+	// RecordLogHeadKey 为 record 链的头部
+	RecordLogHeadKey = collections.NewPrefix("Records/head/")
+	// Records 的二级索引，按作者和区块高度索引 record
+	RecordsAuthorIndexKey = collections.NewPrefix("Records/index/author/")
+	RecordsHeightIndexKey = collections.NewPrefix("Records/index/height/")
+	// RecordRateCountersKey 为各账户在当前窗口内添加的 record 数量
+	RecordRateCountersKey = collections.NewPrefix("Records/rate/")
+	// LegacyRecordKey 为共识版本 1 中以字符串为键的 RecordList，只在迁移中使用
+	LegacyRecordKey = collections.NewPrefix("Record/value/")
+	// RemoteRecordsKey 为按 (通道, 数据包序号) 保存的、通过 IBC 收到的 record
+	RemoteRecordsKey = collections.NewPrefix("RemoteRecords/value/")
+	// OutgoingPacketsKey 为按 (通道, 数据包序号) 保存的、本链发送的数据包
+	OutgoingPacketsKey = collections.NewPrefix("OutgoingPackets/value/")
+	// IbcGamesKey 为按 (通道, 本链的游戏 id) 保存的跨链游戏
+	IbcGamesKey   = collections.NewPrefix("IbcGames/value/")
+	IbcGameSeqKey = collections.NewPrefix("IbcGames/seq/")
+	// IbcGames 的二级索引，按邀请的过期时间索引跨链游戏
+	IbcGamesInvitationExpiryIndexKey = collections.NewPrefix("IbcGames/index/invitation_expiry/")
+	PlayerInfoKey                    = collections.NewPrefix("PlayerInfo/value/")
+	QueueKey                         = collections.NewPrefix("Queue/value/")
+	// QueueSizeKey 为匹配队列中的玩家数量
+	QueueSizeKey = collections.NewPrefix("Queue/size/")
+
+	// IbcChannelsKey 为按通道保存的、模块的端口上已经开启的通道
+	IbcChannelsKey = collections.NewPrefix("IbcChannels/value/")
+	// DefaultChannelKey 为跨链功能的默认通道
+	DefaultChannelKey = collections.NewPrefix("IbcChannels/default/")
+
+	TournamentsKey       = collections.NewPrefix("Tournaments/value/")
+	TournamentSeqKey     = collections.NewPrefix("Tournaments/seq/")
+	TournamentPlayersKey = collections.NewPrefix("TournamentPlayers/value/")
+	// Tournaments 的二级索引，按锦标赛状态索引锦标赛
+	TournamentsStatusIndexKey = collections.NewPrefix("Tournaments/index/status/")
+
+	// DeadlineQueueKey 为按 (deadline, 游戏索引) 排序的限时游戏队列
+	DeadlineQueueKey = collections.NewPrefix("Deadline/value/")
+
+	// StoredGames 的二级索引，分别按黑方、红方、双方玩家、游戏状态和邀请的过期高度索引游戏
+	StoredGamesBlackIndexKey            = collections.NewPrefix("StoredGames/index/black/")
+	StoredGamesRedIndexKey              = collections.NewPrefix("StoredGames/index/red/")
+	StoredGamesPlayerIndexKey           = collections.NewPrefix("StoredGames/index/player/")
+	StoredGamesStatusIndexKey           = collections.NewPrefix("StoredGames/index/status/")
+	StoredGamesInvitationExpiryIndexKey = collections.NewPrefix("StoredGames/index/invitation_expiry/")
 )
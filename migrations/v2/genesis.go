@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/buzzing/checkers"
+)
+
+// MigrateGenesis 将版本 1 导出的创世状态转换为版本 2 的创世状态，对版本 2 的创世状态不做任何修改
+//   - Params 为空消息时设置为默认参数
+//   - 没有状态的游戏按 MigrateStoredGame 转换，正在进行的游戏从 headerInfo.Height 开始计时
+//   - recordList 中的 record 按 MigrateRecords 转换，连接在 records 的链之后
+//
+// 与存储迁移相同，版本 1 的 recordList 在导出时已经按字典序排列
+func MigrateGenesis(gs *checkers.GenesisState, headerInfo header.Info) error {
+	if isEmptyParams(gs.Params) {
+		gs.Params = checkers.DefaultParams()
+	}
+
+	for i, indexedStoredGame := range gs.IndexedStoredGameList {
+		if indexedStoredGame.StoredGame.Status != checkers.GameStatus_GAME_STATUS_UNSPECIFIED {
+			continue
+		}
+		storedGame, err := MigrateStoredGame(indexedStoredGame.StoredGame, gs.Params, headerInfo.Height)
+		if err != nil {
+			return errorsmod.Wrapf(err, "game %s", indexedStoredGame.Index)
+		}
+		gs.IndexedStoredGameList[i].StoredGame = storedGame
+	}
+
+	legacyRecords := gs.RecordList
+	if len(legacyRecords) == 0 {
+		return nil
+	}
+	var prevHash []byte
+	if gs.RecordLogHead != nil {
+		prevHash = gs.RecordLogHead.Hash
+	}
+	records := MigrateRecords(legacyRecords, gs.RecordCount, prevHash, headerInfo)
+	gs.RecordList = nil
+	if len(records) == 0 {
+		return nil
+	}
+	gs.Records = append(gs.Records, records...)
+	last := records[len(records)-1]
+	gs.RecordCount = last.Id + 1
+	gs.RecordLogHead = &checkers.RecordLogHead{Id: last.Id, Hash: last.Hash}
+	return nil
+}
+
+// isEmptyParams 判断 params 是否为版本 1 中的空消息，即所有字段都为零值
+func isEmptyParams(params checkers.Params) bool {
+	bz, err := params.Marshal()
+	if err != nil {
+		return false
+	}
+	empty, err := (&checkers.Params{}).Marshal()
+	return err == nil && bytes.Equal(bz, empty)
+}
@@ -0,0 +1,173 @@
+// Package v2 将模块的存储从共识版本 1 迁移到共识版本 2
+//
+// 版本 1 的 StoredGame 只有 board、turn、black 和 red 四个字段，Params 为空，
+// record 以字符串的形式保存在 RecordList 中，内容包含验证者的本地时间
+// 版本 2 为游戏增加了状态、获胜方、双方是否接受、时限等字段和二级索引，引入了模块参数，
+// record 按 id 保存，记录区块高度、时间和来源，并通过 hash 连接成链
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/rules"
+)
+
+// MigrateStore 执行从版本 1 到版本 2 的迁移
+//   - Params 为空或无效时设置为默认参数
+//   - 按棋盘推断每一局游戏的状态和获胜方，正在进行的游戏使用最长时限并加入 Deadlines
+//   - 通过 keeper 重新保存游戏，建立二级索引
+//   - RecordList 中的 record 以 LEGACY 为来源按 id 保存，并连接成链
+func MigrateStore(ctx context.Context, k *keeper.Keeper) error {
+	params, err := migrateParams(ctx, k)
+	if err != nil {
+		return err
+	}
+	if err := migrateStoredGames(ctx, k, params); err != nil {
+		return err
+	}
+	return migrateRecords(ctx, k)
+}
+
+// migrateParams 在版本 1 中 Params 是一个空消息，保存的值解码后所有字段均为 0，无法通过验证
+func migrateParams(ctx context.Context, k *keeper.Keeper) (checkers.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err == nil && params.Validate() == nil {
+		return params, nil
+	}
+	params = checkers.DefaultParams()
+	return params, k.Params.Set(ctx, params)
+}
+
+// migrateStoredGames 将所有游戏迁移到版本 2
+// 版本 2 的 StoredGame 与版本 1 的字段编号兼容，因此可以直接用新的类型读取旧的游戏
+func migrateStoredGames(ctx context.Context, k *keeper.Keeper, params checkers.Params) error {
+	// 先收集再保存，避免在遍历时修改存储
+	var indexes []string
+	var games []checkers.StoredGame
+	if err := k.StoredGames.Walk(ctx, nil, func(index string, storedGame checkers.StoredGame) (bool, error) {
+		indexes = append(indexes, index)
+		games = append(games, storedGame)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for i, index := range indexes {
+		storedGame, err := MigrateStoredGame(games[i], params, height)
+		if err != nil {
+			return errorsmod.Wrapf(err, "game %s", index)
+		}
+		if err := k.StoredGames.Set(ctx, index, storedGame); err != nil {
+			return err
+		}
+		if storedGame.Deadline != 0 {
+			if err := k.Deadlines.Set(ctx, collections.Join(storedGame.Deadline, index)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MigrateStoredGame 将一局版本 1 的游戏转换为版本 2 的游戏
+// 版本 1 的游戏在创建时即开始，没有赌注和邀请，因此双方都视为已接受
+//   - 棋盘上只剩一方的棋子，或者轮到的一方无棋可走时，游戏结束，与走棋相同，参见 checkers.MoveWinner
+//   - 否则游戏正在进行，使用 params.MaxTurnDuration 作为时限，从 height 开始计时
+//
+// 版本 1 没有记录步数，棋盘与开局不同的游戏至少走过一步，记为 1，以免被 MsgReject 删除
+func MigrateStoredGame(storedGame checkers.StoredGame, params checkers.Params, height int64) (checkers.StoredGame, error) {
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return storedGame, err
+	}
+
+	storedGame.BlackAccepted = true
+	storedGame.RedAccepted = true
+	storedGame.TurnDuration = params.MaxTurnDuration
+	storedGame.Winner = rules.PieceStrings[rules.NO_PLAYER]
+	if storedGame.Board != rules.New().String() {
+		storedGame.MoveCount = 1
+	}
+
+	if winner := checkers.MoveWinner(game); winner != rules.NO_PLAYER {
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_FINISHED
+		storedGame.Winner = rules.PieceStrings[winner]
+	} else {
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+		storedGame.Deadline = height + int64(storedGame.TurnDuration)
+	}
+
+	return storedGame, storedGame.Validate()
+}
+
+// migrateRecords 将 RecordList 中的 record 按原有的字典序分配 id 保存到 Records 中，然后清空 RecordList
+func migrateRecords(ctx context.Context, k *keeper.Keeper) error {
+	var legacyRecords []string
+	if err := k.LegacyRecordList.Walk(ctx, nil, func(record string) (bool, error) {
+		legacyRecords = append(legacyRecords, record)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, text := range legacyRecords {
+		if err := k.LegacyRecordList.Remove(ctx, text); err != nil {
+			return err
+		}
+	}
+	firstId, err := k.RecordSeq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := k.RecordLogHead.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	records := MigrateRecords(legacyRecords, firstId, head.Hash, sdk.UnwrapSDKContext(ctx).HeaderInfo())
+	for _, record := range records {
+		if err := k.Records.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	last := records[len(records)-1]
+	if err := k.RecordSeq.Set(ctx, last.Id+1); err != nil {
+		return err
+	}
+	return k.RecordLogHead.Set(ctx, checkers.RecordLogHead{Id: last.Id, Hash: last.Hash})
+}
+
+// MigrateRecords 将版本 1 的 record 转换为从 firstId 开始、以 LEGACY 为来源的 record，
+// 第一条 record 连接在 hash 为 prevHash 的 record 之后
+// 原有的高度和时间未知，使用迁移时的区块；record 的内容原样保留，空的 record 被丢弃
+func MigrateRecords(legacyRecords []string, firstId uint64, prevHash []byte, headerInfo header.Info) []checkers.Record {
+	var records []checkers.Record
+	for _, text := range legacyRecords {
+		if text == "" {
+			continue
+		}
+		record := checkers.Record{
+			Id:       firstId + uint64(len(records)),
+			Height:   headerInfo.Height,
+			Time:     headerInfo.Time,
+			Source:   checkers.RecordSource_RECORD_SOURCE_LEGACY,
+			Text:     text,
+			PrevHash: prevHash,
+		}
+		record.Hash = record.ComputeHash()
+		records = append(records, record)
+		prevHash = record.Hash
+	}
+	return records
+}
@@ -0,0 +1,144 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	v2 "github.com/buzzing/checkers/migrations/v2"
+	"github.com/buzzing/checkers/rules"
+	"github.com/buzzing/checkers/testutil"
+)
+
+const (
+	// 只剩黑方的棋子，黑方获胜
+	blackWonBoard = "*b******|********|********|********|********|********|********|********"
+	// 轮到黑方，双方的棋子互相挡住，黑方无棋可走，与走棋时一样判负
+	blockedBoard = "********|********|********|********|***b****|b*b*b*b*|*r*b*b*b|r*r*r*r*"
+)
+
+// v1Game 返回版本 1 的游戏，版本 1 的 StoredGame 只有前四个字段
+func v1Game(board, turn string) checkers.StoredGame {
+	return checkers.StoredGame{Board: board, Turn: turn, Black: testutil.Address(0), Red: testutil.Address(1)}
+}
+
+// writeV1Store 按版本 1 的存储结构写入空的参数、三局游戏和 RecordList
+func writeV1Store(t *testing.T, f *testutil.Fixture, cdc codec.BinaryCodec) {
+	t.Helper()
+	sb := collections.NewSchemaBuilder(f.StoreService)
+	params := collections.NewItem(sb, collections.NewPrefix("Params"), "params", collections.BytesValue)
+	storedGames := collections.NewMap(sb, collections.NewPrefix("StoredGames/value/"), "storedGames", collections.StringKey, codec.CollValue[checkers.StoredGame](cdc))
+	recordList := collections.NewKeySet(sb, collections.NewPrefix("Record/value/"), "RecordList", collections.StringKey)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, params.Set(f.Ctx, []byte{}))
+	require.NoError(t, storedGames.Set(f.Ctx, "1", v1Game(rules.New().String(), "b")))
+	require.NoError(t, storedGames.Set(f.Ctx, "2", v1Game(blackWonBoard, "r")))
+	require.NoError(t, storedGames.Set(f.Ctx, "3", v1Game(blockedBoard, "b")))
+	for _, record := range []string{"b record", "", "a record"} {
+		require.NoError(t, recordList.Set(f.Ctx, record))
+	}
+}
+
+func TestMigrateStoreFromV1(t *testing.T) {
+	f := testutil.NewFixture(t)
+	writeV1Store(t, f, f.Cdc)
+	ctx := testutil.WithHeight(f.Ctx, 100)
+	k := f.Keeper
+
+	require.NoError(t, v2.MigrateStore(ctx, k))
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	defaultParams := checkers.DefaultParams()
+	require.Equal(t, defaultParams.String(), params.String())
+
+	// 开局的游戏正在进行，从迁移的区块开始计时
+	game1, err := k.StoredGames.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_ACTIVE, game1.Status)
+	require.Equal(t, "*", game1.Winner)
+	require.Zero(t, game1.MoveCount)
+	require.True(t, game1.BlackAccepted)
+	require.True(t, game1.RedAccepted)
+	require.Equal(t, params.MaxTurnDuration, game1.TurnDuration)
+	require.Equal(t, int64(100)+int64(params.MaxTurnDuration), game1.Deadline)
+	has, err := k.Deadlines.Has(ctx, collections.Join(game1.Deadline, "1"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	game2, err := k.StoredGames.Get(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, game2.Status)
+	require.Equal(t, "b", game2.Winner)
+	require.Equal(t, uint64(1), game2.MoveCount)
+	require.Zero(t, game2.Deadline)
+
+	game3, err := k.StoredGames.Get(ctx, "3")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, game3.Status)
+	require.Equal(t, "r", game3.Winner)
+	require.Zero(t, game3.Deadline)
+
+	// 迁移后的游戏建立了二级索引
+	var active []string
+	require.NoError(t, k.Deadlines.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		active = append(active, key.K2())
+		return false, nil
+	}))
+	require.Equal(t, []string{"1"}, active)
+	statusIter, err := k.StoredGames.Indexes.Status.MatchExact(ctx, int32(checkers.GameStatus_GAME_STATUS_FINISHED))
+	require.NoError(t, err)
+	finished, err := statusIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3"}, finished)
+	blackIter, err := k.StoredGames.Indexes.Black.MatchExact(ctx, testutil.Address(0))
+	require.NoError(t, err)
+	blackGames, err := blackIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, blackGames)
+
+	// RecordList 按字典序迁移到 Records，空的 record 被丢弃
+	var records []checkers.Record
+	require.NoError(t, k.Records.Walk(ctx, nil, func(_ uint64, record checkers.Record) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	}))
+	require.Len(t, records, 2)
+	for i, text := range []string{"a record", "b record"} {
+		require.Equal(t, uint64(i), records[i].Id)
+		require.Equal(t, text, records[i].Text)
+		require.Equal(t, checkers.RecordSource_RECORD_SOURCE_LEGACY, records[i].Source)
+		require.Equal(t, int64(100), records[i].Height)
+		require.Equal(t, ctx.BlockTime(), records[i].Time)
+		require.Empty(t, records[i].Creator)
+	}
+	require.Empty(t, records[0].PrevHash)
+	require.Equal(t, records[0].Hash, records[1].PrevHash)
+	head, err := k.RecordLogHead.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, checkers.RecordLogHead{Id: 1, Hash: records[1].Hash}, head)
+	_, err = checkers.VerifyRecordChain(records, &head)
+	require.NoError(t, err)
+
+	legacyIter, err := k.LegacyRecordList.Iterate(ctx, nil)
+	require.NoError(t, err)
+	legacyRecords, err := legacyIter.Keys()
+	require.NoError(t, err)
+	require.Empty(t, legacyRecords)
+
+	// 迁移后的状态可以导出为有效的创世状态
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, gs.Validate())
+
+	// 新的 record 连接在迁移的 record 之后
+	record, err := k.AddRecord(ctx, checkers.RecordSource_RECORD_SOURCE_END_BLOCK, "by EndBlocker", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), record.Id)
+	require.Equal(t, records[1].Hash, record.PrevHash)
+}
@@ -15,6 +15,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: checkersv1.Query_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Get the current module parameters",
+				},
 				{
 					RpcMethod: "GetGame",
 					// index 为所需的参数
@@ -26,10 +31,150 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "index"},
 					},
 				},
+				{
+					RpcMethod: "ListGames",
+					Use:       "list-games",
+					Short:     "List games, optionally filtered by player, status and whose turn it is",
+					// 未作为位置参数的字段会自动生成为命令行 flag
+					// 例如 --player, --status, --awaiting-move 以及分页相关的 flag
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"player":        {Usage: "only list games in which this address plays black or red"},
+						"status":        {Usage: "only list games in this status (GAME_STATUS_PENDING, GAME_STATUS_OPEN, GAME_STATUS_ACTIVE, GAME_STATUS_FINISHED)"},
+						"awaiting_move": {Usage: "only list active games in which it is the player's turn, requires --player"},
+					},
+				},
+				{
+					RpcMethod: "OpenGames",
+					Use:       "open-games",
+					Short:     "List games in the lobby waiting for an opponent to join",
+				},
+				{
+					RpcMethod: "GetPlayerInfo",
+					Use:       "get-player-info player",
+					Short:     "Get the won, lost and drawn game counts and the rating of a player",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "player"},
+					},
+				},
+				{
+					RpcMethod: "GetTournament",
+					Use:       "get-tournament id",
+					Short:     "Get a tournament by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ListTournaments",
+					Use:       "list-tournaments",
+					Short:     "List all tournaments",
+				},
+				{
+					RpcMethod: "TournamentStandings",
+					Use:       "tournament-standings id",
+					Short:     "Get the standings of a tournament ordered by score, Sonneborn-Berger and Buchholz",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "Invariants",
+					Use:       "invariants [route]",
+					Short:     "Check the module invariants, or only the one registered under route",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "route", Optional: true},
+					},
+				},
 				{
 					RpcMethod: "GetRecordList",
 					Use:       "get-record-list",
-					Short:     "Get the list of records",
+					Short:     "Get the list of records ordered by id",
+				},
+				{
+					RpcMethod: "GetRecord",
+					Use:       "get-record id",
+					Short:     "Get a record by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "RecordLogHead",
+					Use:       "record-log-head",
+					Short:     "Get the id and hash of the last record in the record chain",
+				},
+				{
+					RpcMethod: "VerifyRecordRange",
+					Use:       "verify-record-range from to",
+					Short:     "Verify the hashes and links of the records with ids between from and to, inclusive",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from"},
+						{ProtoField: "to"},
+					},
+				},
+				{
+					RpcMethod: "GetRemoteRecords",
+					Use:       "get-remote-records channel",
+					Short:     "Get the list of records received over IBC on a channel, ordered by packet sequence",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+					},
+				},
+				{
+					RpcMethod: "GetOutgoingPacket",
+					Use:       "get-outgoing-packet channel sequence",
+					Short:     "Get a packet sent from this chain, including its data and escrowed fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "sequence"},
+					},
+				},
+				{
+					RpcMethod: "GetPacketStatus",
+					Use:       "get-packet-status channel sequence",
+					Short:     "Get the status of a packet sent from this chain and the result returned by the counterparty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "sequence"},
+					},
+				},
+				{
+					RpcMethod: "ListOutgoingPackets",
+					Use:       "list-outgoing-packets channel",
+					Short:     "Get the list of packets sent from this chain on a channel, ordered by packet sequence",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+					},
+				},
+				{
+					RpcMethod: "GetIbcGame",
+					Use:       "get-ibc-game channel id",
+					Short:     "Get a cross-chain game by its id on this chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ListIbcGames",
+					Use:       "list-ibc-games channel",
+					Short:     "Get the list of cross-chain games played over a channel, ordered by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+					},
+				},
+				{
+					RpcMethod: "Channels",
+					Use:       "channels",
+					Short:     "Get the list of channels opened on the module port with their packet stats, and the default channel",
+				},
+				{
+					RpcMethod: "GetRecordsByAuthor",
+					Use:       "get-records-by-author author",
+					Short:     "Get the list of records added by an author, ordered by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "author"},
+					},
 				},
 			},
 		},
@@ -45,7 +190,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					// index black red 为所需的参数
 					Use: "create index black red",
 					// Short 指定了命令的简短描述
-					Short: "Creates a new checkers game at the index for the black and red players",
+					Short: "Invites the black and red players to a new checkers game at the index",
 					// PositionalArgs 定义命令的参数及其顺序
 					// 每个参数通过 ProtoField 指定其对应的字段
 					// proto 文件中定义的 CreateGame 方法参数为 MsgCreateGame (参见 tx.proto)
@@ -55,15 +200,232 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "black"},
 						{ProtoField: "red"},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"wager": {Usage: "optional wager escrowed from each player when they accept, e.g. 10stake"},
+					},
+				},
+				{
+					RpcMethod: "CreateOpenGame",
+					Use:       "create-open index color",
+					Short:     "Creates a new checkers game in the lobby, the creator plays color (black or red)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "index"},
+						{ProtoField: "color"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"min_rating": {Usage: "optional minimum rating of the joining player"},
+						"wager":      {Usage: "optional wager escrowed from each player, e.g. 10stake"},
+					},
+				},
+				{
+					RpcMethod: "JoinGame",
+					Use:       "join-game game_index",
+					Short:     "Join a game in the lobby, the game starts immediately",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					// UpdateParams 只能由 authority 通过治理提案发送，不提供 CLI 命令
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
+				{
+					// PruneRecords 只能由 authority 通过治理提案发送，不提供 CLI 命令
+					RpcMethod: "PruneRecords",
+					Skip:      true,
+				},
+				{
+					// SetDefaultChannel 只能由 authority 通过治理提案发送，不提供 CLI 命令
+					RpcMethod: "SetDefaultChannel",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "JoinQueue",
+					Use:       "join-queue",
+					Short:     "Join the matchmaking queue, a game is created once an opponent with a close rating is found",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"turn_duration": {Usage: "optional number of blocks allowed for each move, defaults to the max turn duration param"},
+						"wager":         {Usage: "optional wager escrowed while queued, e.g. 10stake"},
+					},
+				},
+				{
+					RpcMethod: "LeaveQueue",
+					Use:       "leave-queue",
+					Short:     "Leave the matchmaking queue and get the escrowed wager back",
+				},
+				{
+					RpcMethod: "CreateTournament",
+					Use:       "create-tournament name format max_players start_height turn_duration",
+					Short:     "Creates a tournament (format round-robin or swiss) open for registration until start_height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "format"},
+						{ProtoField: "max_players"},
+						{ProtoField: "start_height"},
+						{ProtoField: "turn_duration"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"rounds":       {Usage: "number of rounds of a swiss tournament"},
+						"entry_fee":    {Usage: "optional entry fee escrowed from each player into the prize pool, e.g. 10stake"},
+						"prize_shares": {Usage: "optional percentages of the prize pool for each place, summing to 100"},
+						"sanctioned":   {Usage: "create an officially sanctioned tournament, the signer must be the module authority"},
+					},
+				},
+				{
+					RpcMethod: "JoinTournament",
+					Use:       "join-tournament tournament_id",
+					Short:     "Register for a tournament and pay its entry fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tournament_id"},
+					},
+				},
+				{
+					RpcMethod: "AcceptGame",
+					Use:       "accept-game game_index",
+					Short:     "Accept an invitation to a game, the game starts once both players accepted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					RpcMethod: "PlayMove",
+					Use:       "play-move game_index from_x from_y to_x to_y",
+					Short:     "Play a move in a game, coordinates range from 0 to 7",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+						{ProtoField: "from_x"},
+						{ProtoField: "from_y"},
+						{ProtoField: "to_x"},
+						{ProtoField: "to_y"},
+					},
 				},
 				{
 					RpcMethod: "AddRecord",
 					Use:       "add-record record_value",
-					Short:     "Add a record to the chain storage",
+					Short:     "Add a record to the chain storage, tags can be set with --tags",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "value"},
 					},
 				},
+				{
+					RpcMethod: "SendRecord",
+					Use:       "send-record port channel record_data",
+					Short:     "Send a record to another chain over IBC",
+					Long:      "Send a record to another chain over IBC. Without --timeout-revision-height and --timeout-timestamp, the packet times out 1000 blocks after the latest counterparty height known by the client or 10 minutes after the current block time, whichever comes first. An empty port and channel (\"\" \"\") send the record over the default channel.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "port"},
+						{ProtoField: "channel"},
+						{ProtoField: "record_data"},
+					},
+				},
+				{
+					RpcMethod: "RetryPacket",
+					Use:       "retry-packet channel sequence",
+					Short:     "Resend a timed out packet sent by the signer, with the default timeout",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "sequence"},
+					},
+				},
+				{
+					RpcMethod: "InviteIbcGame",
+					Use:       "invite-ibc-game channel invitee color",
+					Short:     "Invite a player on another chain to a cross-chain game, the sender plays color (b or r)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "invitee"},
+						{ProtoField: "color"},
+					},
+				},
+				{
+					RpcMethod: "AcceptIbcGame",
+					Use:       "accept-ibc-game channel game_id",
+					Short:     "Accept a cross-chain game invitation received from another chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "game_id"},
+					},
+				},
+				{
+					RpcMethod: "PlayIbcMove",
+					Use:       "play-ibc-move channel game_id from_x from_y to_x to_y",
+					Short:     "Play a move in a cross-chain game, the move is reverted if the other chain rejects it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "game_id"},
+						{ProtoField: "from_x"},
+						{ProtoField: "from_y"},
+						{ProtoField: "to_x"},
+						{ProtoField: "to_y"},
+					},
+				},
+				{
+					RpcMethod: "ResignIbcGame",
+					Use:       "resign-ibc-game channel game_id",
+					Short:     "Resign a cross-chain game, the opponent wins",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "game_id"},
+					},
+				},
+				{
+					RpcMethod: "ClaimIbcGameTimeout",
+					Use:       "claim-ibc-game-timeout channel game_id",
+					Short:     "Win a cross-chain game when the opponent has not moved for 24 hours",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "game_id"},
+					},
+				},
+				{
+					RpcMethod: "DeleteRecord",
+					Use:       "delete-record id",
+					Short:     "Delete a record added by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "Resign",
+					Use:       "resign game_index",
+					Short:     "Resign an active game, the opponent wins",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					RpcMethod: "Reject",
+					Use:       "reject game_index",
+					Short:     "Reject a game invitation or a game in which no move has been played yet, the game is deleted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					RpcMethod: "OfferDraw",
+					Use:       "offer-draw game_index",
+					Short:     "Offer a draw to the opponent, the offer lapses when the opponent plays a move",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					RpcMethod: "AcceptDraw",
+					Use:       "accept-draw game_index",
+					Short:     "Accept the opponent's draw offer, the game ends as a draw",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
+				{
+					RpcMethod: "DeclineDraw",
+					Use:       "decline-draw game_index",
+					Short:     "Decline the opponent's draw offer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "game_index"},
+					},
+				},
 			},
 		},
 	}
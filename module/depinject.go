@@ -4,8 +4,12 @@
 package module
 
 import (
+	"fmt"
+	"sort"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 
@@ -15,6 +19,7 @@ import (
 	ibcporttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	"github.com/buzzing/checkers"
 	modulev1 "github.com/buzzing/checkers/api/module/v1"
 	"github.com/buzzing/checkers/keeper"
 )
@@ -42,6 +47,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetCheckersHooks),
 	)
 }
 
@@ -56,10 +62,27 @@ type ModuleInputs struct {
 	StoreService store.KVStoreService
 	// AddressCodec 地址编解码器，用于地址的编解码
 	AddressCodec address.Codec
+	// HeaderService 区块头服务，用于获取当前区块的高度和时间
+	HeaderService header.Service
 
 	// Config 模块配置信息，模块的元数据
 	Config *modulev1.Module
 
+	// BankKeeper 用于托管和支付玩家的赌注以及收取 record 的费用，是必需的依赖
+	//
+	// 模块账户的权限（maccPerms，即 app 配置中 auth 模块的 module_account_permissions）：
+	//   - 托管和支付赌注、转入社区池不需要额外的权限，但模块账户需要在 maccPerms 中注册
+	//   - Params.RecordFeeDestination 为 BURN 时需要 authtypes.Burner 权限，
+	//     例如 {account: "checkers", permissions: ["burner"]}
+	//     没有该权限时 MsgUpdateParams 和 InitGenesis 拒绝销毁 record 费用的参数，
+	//     参见 keeper.Keeper.CheckRecordFeeDestination
+	BankKeeper checkers.BankKeeper
+	// AccountKeeper 用于检查模块账户的权限，以及在模拟测试中生成交易
+	// 没有 AccountKeeper 时不能销毁 record 的费用
+	AccountKeeper checkers.AccountKeeper `optional:"true"`
+	// DistributionKeeper 用于将 record 的费用转入社区池
+	DistributionKeeper checkers.DistributionKeeper `optional:"true"`
+
 	// IBC 相关依赖
 	IBCKeeperFn        func() *ibckeeper.Keeper                   `optional:"true"`
 	CapabilityScopedFn func(string) capabilitykeeper.ScopedKeeper `optional:"true"`
@@ -73,6 +96,7 @@ type ModuleOutputs struct {
 	// Module 模块实例，供应用框架使用
 	Module appmodule.AppModule
 	// Keeper 模块的核心逻辑管理器，供其他模块或组件调用
+	// Keeper 的副本共享 InvokeSetCheckersHooks 设置的回调
 	Keeper keeper.Keeper
 }
 
@@ -91,10 +115,53 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		in.StoreService,
 		authority.String(),
+		in.BankKeeper,
+		in.AccountKeeper,
+		in.DistributionKeeper,
+		in.HeaderService,
 		in.IBCKeeperFn,
 		in.CapabilityScopedFn,
 	)
-	m := NewAppModule(in.Cdc, k)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{Module: m, Keeper: k}
 }
+
+// InvokeSetCheckersHooks 收集其他模块通过 depinject 提供的 checkers.CheckersHooksWrapper，
+// 按配置中的 hooks_order（默认为模块名称的字母顺序）组合后设置到 Keeper 中
+// map 的键为提供回调的模块名称（depinject.ModuleKey）
+func InvokeSetCheckersHooks(
+	config *modulev1.Module,
+	keeper keeper.Keeper,
+	checkersHooks map[string]checkers.CheckersHooksWrapper,
+) error {
+	// invoker 的所有参数都是可选的，模块没有被使用时直接返回
+	if config == nil {
+		return nil
+	}
+
+	order := config.HooksOrder
+	if len(order) == 0 {
+		for name := range checkersHooks {
+			order = append(order, name)
+		}
+		sort.Strings(order)
+	}
+	if len(order) != len(checkersHooks) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, checkersHooks)
+	}
+	if len(checkersHooks) == 0 {
+		return nil
+	}
+
+	var multiHooks checkers.MultiCheckersHooks
+	for _, name := range order {
+		hooks, ok := checkersHooks[name]
+		if !ok {
+			return fmt.Errorf("can't find checkers hooks for module %s", name)
+		}
+		multiHooks = append(multiHooks, hooks)
+	}
+	keeper.SetHooks(multiHooks)
+	return nil
+}
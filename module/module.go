@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/buzzing/checkers"
 	"github.com/buzzing/checkers/keeper"
+	v2 "github.com/buzzing/checkers/migrations/v2"
 )
 
 var (
@@ -36,22 +38,36 @@ var (
 	_ appmodule.HasBeginBlocker = AppModule{}
 	// 包含 EndBlock 方法
 	_ appmodule.HasEndBlocker = AppModule{}
+
+	// HasInvariants 向 crisis 模块注册模块的不变量
+	_ module.HasInvariants = AppModule{}
+
+	// AppModuleSimulation 使模块可以参与应用的模拟测试，参见 module/simulation.go
+	_ module.AppModuleSimulation = AppModule{}
 )
 
 // ConsensusVersion 定义当前模块的共识版本
-const ConsensusVersion = 1
+// 修改存储的结构时需要增加版本，并在 RegisterServices 中注册从上一个版本开始的迁移
+// 已经发布的迁移不能再修改，之后的变化都放在新版本的迁移中
+const ConsensusVersion = 2
 
 type AppModule struct {
 	cdc codec.Codec
 	// AppModule 通过 keeper 实现对模块功能的访问
 	keeper keeper.Keeper
+
+	// accountKeeper 和 bankKeeper 用于在模拟测试中生成和发送交易
+	accountKeeper checkers.AccountKeeper
+	bankKeeper    checkers.BankKeeper
 }
 
 // NewAppModule 创建一个新的 AppModule 实例
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper checkers.AccountKeeper, bankKeeper checkers.BankKeeper) AppModule {
 	return AppModule{
-		cdc:    cdc,
-		keeper: keeper,
+		cdc:           cdc,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
@@ -110,6 +126,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// keeper.NewQueryServerImpl(am.keeper) 返回实现了 proto 中定义的 QueryServer 接口的对象
 	// 通过 RegisterQueryServer 将其绑定到 gRPC 服务注册器
 	checkers.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	// 注册存储迁移，升级时由 x/upgrade 按共识版本依次执行
+	// RegisterMigration 的版本号为迁移前的版本，迁移的实现参见 migrations 目录
+	if err := cfg.RegisterMigration(checkers.ModuleName, 1, func(ctx sdk.Context) error {
+		return v2.MigrateStore(ctx, &am.keeper)
+	}); err != nil {
+		panic(fmt.Errorf("failed to register %s migration from version 1: %w", checkers.ModuleName, err))
+	}
+}
+
+// RegisterInvariants 注册模块的不变量，参见 keeper/invariants.go
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, &am.keeper)
 }
 
 // DefaultGenesis 返回默认的创世状态，并进行序列化
@@ -118,19 +147,27 @@ func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis 验证创世状态是否有效，包括解析二进制数据并验证字段
+// 版本 1 导出的创世状态先转换为当前版本再验证，与 InitGenesis 一致
 func (AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var data checkers.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", checkers.ModuleName, err)
 	}
+	if err := v2.MigrateGenesis(&data, header.Info{}); err != nil {
+		return fmt.Errorf("failed to migrate %s genesis state: %w", checkers.ModuleName, err)
+	}
 
 	return data.Validate()
 }
 
 // InitGenesis 初始化模块的创世状态
+// 版本 1 导出的创世状态在导入时转换为当前版本，正在进行的游戏从创世区块开始计时
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
 	var genesisState checkers.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
+	if err := v2.MigrateGenesis(&genesisState, ctx.HeaderInfo()); err != nil {
+		panic(fmt.Errorf("failed to migrate %s genesis state: %w", checkers.ModuleName, err))
+	}
 
 	if err := am.keeper.InitGenesis(ctx, &genesisState); err != nil {
 		panic(fmt.Errorf("failed to initialize %s genesis state: %w", checkers.ModuleName, err))
@@ -148,23 +185,34 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 func (am AppModule) BeginBlock(goCtx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(goCtx)
-	height := sdkCtx.BlockHeight()
-	record := fmt.Sprintf("%s by BeginBlocker at %d", checkers.GetFormatDates(), height)
-	err := am.keeper.RecordList.Set(goCtx, record)
-	if err != nil {
-		return err
-	}
-	return nil
+	_, err := am.keeper.AddRecord(goCtx, checkers.RecordSource_RECORD_SOURCE_BEGIN_BLOCK, "by BeginBlocker", "", nil)
+	return err
 }
 
 func (am AppModule) EndBlock(goCtx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(goCtx)
-	height := sdkCtx.BlockHeight()
-	record := fmt.Sprintf("%s by EndBlocker at %d", checkers.GetFormatDates(), height)
-	err := am.keeper.RecordList.Set(goCtx, record)
-	if err != nil {
+	// 删除过期的游戏邀请
+	if err := am.keeper.ExpireInvitations(goCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpireIbcInvitations(goCtx); err != nil {
+		return err
+	}
+	// 超时的一方判负
+	if err := am.keeper.ForfeitTimedOutGames(goCtx); err != nil {
+		return err
+	}
+	// 为匹配队列中的玩家配对
+	if err := am.keeper.MatchQueue(goCtx); err != nil {
+		return err
+	}
+	// 推进锦标赛，在超时判负之后进行，使超时结束的一轮可以在同一区块推进
+	if err := am.keeper.AdvanceTournaments(goCtx); err != nil {
+		return err
+	}
+
+	if _, err := am.keeper.AddRecord(goCtx, checkers.RecordSource_RECORD_SOURCE_END_BLOCK, "by EndBlocker", "", nil); err != nil {
 		return err
 	}
-	return nil
+	// 删除超过保留期的 record
+	return am.keeper.PruneExpiredRecords(goCtx)
 }
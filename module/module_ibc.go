@@ -1,8 +1,6 @@
 package module
 
 import (
-	"fmt"
-
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -98,7 +96,8 @@ func (am AppModule) OnChanOpenAck(
 		return errorsmod.Wrapf(checkers.ErrInvalidVersion,
 			"invalid counterparty version: %s, expected %s", counterpartyVersion, checkers.Version)
 	}
-	return nil
+	// 握手完成，保存通道
+	return am.keeper.RegisterChannel(ctx, portId, channelId)
 }
 
 // OnChanOpenConfirm 在通道开启最终确认时被调用
@@ -107,7 +106,8 @@ func (am AppModule) OnChanOpenConfirm(
 	portId string,
 	channelId string,
 ) error {
-	return nil
+	// 握手完成，保存通道
+	return am.keeper.RegisterChannel(ctx, portId, channelId)
 }
 
 // OnChanCloseInit 在通道关闭初始化时被调用
@@ -126,40 +126,97 @@ func (am AppModule) OnChanCloseConfirm(
 	portId string,
 	channelId string,
 ) error {
-	return nil
+	return am.keeper.CloseChannel(ctx, channelId)
 }
 
-// OneRecvPacket 在接收数据包时被调用
-// 当模块收到一个 IBC 数据包时，此函数被调用
+// OnRecvPacket 在接收数据包时被调用
+// 当模块收到一个 IBC 数据包时，此函数被调用，按 CheckersPacketData 中数据包的类型分别处理
+// 成功处理的数据包计入通道的统计，返回错误确认时状态被撤销，不计入统计
 func (am AppModule) OnRecvPacket(
 	ctx sdk.Context,
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var ack channeltypes.Acknowledgement
+	ack := am.onRecvPacket(ctx, modulePacket)
+	if !ack.Success() {
+		return ack
+	}
+	if err := am.keeper.CountReceivedPacket(ctx, modulePacket.DestinationChannel); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
 
-	var modulePacketData checkers.RecordPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+// onRecvPacket 解析收到的数据包，按数据包的类型分别处理
+func (am AppModule) onRecvPacket(ctx sdk.Context, modulePacket channeltypes.Packet) ibcexported.Acknowledgement {
+	modulePacketData, err := checkers.UnmarshalCheckersPacketData(modulePacket.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	switch packet := modulePacketData.Packet.(type) {
+	case *checkers.CheckersPacketData_RecordPacket:
+		return am.onRecvRecordPacket(ctx, modulePacket, *packet.RecordPacket)
+	case *checkers.CheckersPacketData_GameInvitePacket:
+		return onRecvGamePacket(am.keeper.OnRecvGameInvitePacket(ctx, modulePacket, *packet.GameInvitePacket))
+	case *checkers.CheckersPacketData_GameAcceptPacket:
+		return onRecvGamePacket(am.keeper.OnRecvGameAcceptPacket(ctx, modulePacket, *packet.GameAcceptPacket))
+	case *checkers.CheckersPacketData_GameMovePacket:
+		return onRecvGamePacket(am.keeper.OnRecvGameMovePacket(ctx, modulePacket, *packet.GameMovePacket))
+	case *checkers.CheckersPacketData_GameResignPacket:
+		return onRecvGamePacket(am.keeper.OnRecvGameResignPacket(ctx, modulePacket, *packet.GameResignPacket))
+	case *checkers.CheckersPacketData_GameResultPacket:
+		return onRecvGamePacket(am.keeper.OnRecvGameResultPacket(ctx, modulePacket, *packet.GameResultPacket))
+	default:
 		return channeltypes.NewErrorAcknowledgement(
-			errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()),
+			errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type %T", packet),
 		)
 	}
+}
 
+// onRecvRecordPacket 处理收到的 RecordPacketData，返回编码后的 RecordPacketAck 或错误确认
+func (am AppModule) onRecvRecordPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	modulePacketData checkers.RecordPacketData,
+) ibcexported.Acknowledgement {
 	packetAck, err := am.keeper.OnRecvRecordPacket(ctx, modulePacket, modulePacketData)
+	if err == nil {
+		err = ctx.EventManager().EmitTypedEvent(&checkers.EventRecordPacketReceived{
+			SourcePort:    modulePacket.SourcePort,
+			SourceChannel: modulePacket.SourceChannel,
+			Sequence:      modulePacket.Sequence,
+			Sender:        modulePacketData.Sender,
+			RecordData:    modulePacketData.RecordData,
+		})
+	}
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
-	} else {
-		// 成功处理后，创建 ACK 消息并编码
-		packetAckBytes, err := checkers.ModuleCdc.MarshalJSON(&packetAck)
-		if err != nil {
-			return channeltypes.NewErrorAcknowledgement(
-				errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal packet acknowledgement: %s", err.Error()),
-			)
-		}
-		ack = channeltypes.NewResultAcknowledgement(packetAckBytes)
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	return ack
+	// 成功处理后，创建 ACK 消息并编码
+	packetAckBytes, err := checkers.ModuleCdc.MarshalJSON(&packetAck)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal packet acknowledgement: %s", err.Error()),
+		)
+	}
+	return channeltypes.NewResultAcknowledgement(packetAckBytes)
+}
+
+// onRecvGamePacket 将跨链游戏数据包的处理结果编码为确认
+// 处理失败时返回错误确认，IBC 会回滚处理过程中对状态的修改，发送方随即恢复乐观执行的操作
+func onRecvGamePacket(packetAck checkers.GamePacketAck, err error) ibcexported.Acknowledgement {
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	packetAckBytes, err := checkers.ModuleCdc.MarshalJSON(&packetAck)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal packet acknowledgement: %s", err.Error()),
+		)
+	}
+	return channeltypes.NewResultAcknowledgement(packetAckBytes)
 }
 
 // OnAcknowledgementPacket 在数据包确认时被调用
@@ -174,45 +231,52 @@ func (am AppModule) OnAcknowledgementPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
 	}
 
-	var modulePacketData checkers.RecordPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	modulePacketData, err := checkers.UnmarshalCheckersPacketData(modulePacket.GetData())
+	if err != nil {
+		return err
+	}
+	if err := am.keeper.ResolveOutgoingPacket(ctx, modulePacket, &ack); err != nil {
+		return err
 	}
 
-	var eventType string
+	switch packet := modulePacketData.Packet.(type) {
+	case *checkers.CheckersPacketData_RecordPacket:
+		return am.onAcknowledgementRecordPacket(ctx, modulePacket, *packet.RecordPacket, ack)
+	case *checkers.CheckersPacketData_GameInvitePacket,
+		*checkers.CheckersPacketData_GameAcceptPacket,
+		*checkers.CheckersPacketData_GameMovePacket,
+		*checkers.CheckersPacketData_GameResignPacket,
+		*checkers.CheckersPacketData_GameResultPacket:
+		return am.keeper.OnAcknowledgementGamePacket(ctx, modulePacket, modulePacketData, ack)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type %T", packet)
+	}
+}
 
-	err := am.keeper.OnAcknowledgementRecordPacket(ctx, modulePacket, modulePacketData, ack)
-	if err != nil {
+// onAcknowledgementRecordPacket 处理已发送的 RecordPacketData 的确认
+func (am AppModule) onAcknowledgementRecordPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	modulePacketData checkers.RecordPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	if err := am.keeper.OnAcknowledgementRecordPacket(ctx, modulePacket, modulePacketData, ack); err != nil {
 		return err
 	}
-	eventType = checkers.EventTypeIbcRecordPacket
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			eventType,
-			sdk.NewAttribute(sdk.AttributeKeyModule, checkers.ModuleName),
-			sdk.NewAttribute(checkers.AttributeKeyAck, fmt.Sprintf("%v", ack)),
-		),
-	)
 
+	event := checkers.EventRecordPacketAcknowledged{
+		SourcePort:    modulePacket.SourcePort,
+		SourceChannel: modulePacket.SourceChannel,
+		Sequence:      modulePacket.Sequence,
+	}
 	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				eventType,
-				sdk.NewAttribute(checkers.AttributeKeyAckSuccess, string(resp.Result)),
-			),
-		)
+		event.Success = true
+		event.Result = string(resp.Result)
 	case *channeltypes.Acknowledgement_Error:
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				eventType,
-				sdk.NewAttribute(checkers.AttributeKeyAckError, resp.Error),
-			),
-		)
+		event.Error = resp.Error
 	}
-
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&event)
 }
 
 // OnTimeoutPacket 在数据包超时时被调用
@@ -221,10 +285,31 @@ func (am AppModule) OnTimeoutPacket(
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var modulePacketData checkers.RecordPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	modulePacketData, err := checkers.UnmarshalCheckersPacketData(modulePacket.GetData())
+	if err != nil {
+		return err
+	}
+	if err := am.keeper.ResolveOutgoingPacket(ctx, modulePacket, nil); err != nil {
+		return err
 	}
 
-	return am.keeper.OnTimeoutRecordPacket(ctx, modulePacket, modulePacketData)
+	switch packet := modulePacketData.Packet.(type) {
+	case *checkers.CheckersPacketData_RecordPacket:
+		if err := am.keeper.OnTimeoutRecordPacket(ctx, modulePacket, *packet.RecordPacket); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&checkers.EventRecordPacketTimedOut{
+			SourcePort:    modulePacket.SourcePort,
+			SourceChannel: modulePacket.SourceChannel,
+			Sequence:      modulePacket.Sequence,
+		})
+	case *checkers.CheckersPacketData_GameInvitePacket,
+		*checkers.CheckersPacketData_GameAcceptPacket,
+		*checkers.CheckersPacketData_GameMovePacket,
+		*checkers.CheckersPacketData_GameResignPacket,
+		*checkers.CheckersPacketData_GameResultPacket:
+		return am.keeper.OnTimeoutGamePacket(ctx, modulePacket, modulePacketData)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type %T", packet)
+	}
 }
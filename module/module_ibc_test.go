@@ -0,0 +1,170 @@
+package module_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/module"
+	"github.com/buzzing/checkers/testutil"
+)
+
+const (
+	testPort    = "checkers"
+	testChannel = "channel-0"
+)
+
+// recvPacket 依次编码、解码数据包，检查往返之后不变，再交给 OnRecvPacket 处理
+func recvPacket(t *testing.T, f *testutil.Fixture, am module.AppModule, sequence uint64, packetData checkers.CheckersPacketData) channeltypes.Acknowledgement {
+	t.Helper()
+	bz, err := packetData.GetBytes()
+	require.NoError(t, err)
+	decoded, err := checkers.UnmarshalCheckersPacketData(bz)
+	require.NoError(t, err)
+	require.Equal(t, packetData, decoded)
+	return recvBytes(t, f, am, sequence, bz)
+}
+
+func recvBytes(t *testing.T, f *testutil.Fixture, am module.AppModule, sequence uint64, bz []byte) channeltypes.Acknowledgement {
+	t.Helper()
+	ack := am.OnRecvPacket(f.Ctx, channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         testPort,
+		SourceChannel:      "channel-7",
+		DestinationPort:    testPort,
+		DestinationChannel: testChannel,
+		Data:               bz,
+	}, nil)
+	channelAck, ok := ack.(channeltypes.Acknowledgement)
+	require.True(t, ok)
+	return channelAck
+}
+
+// requireGameAck 检查跨链游戏数据包的确认是成功的，并返回确认中本链的游戏 id
+func requireGameAck(t *testing.T, ack channeltypes.Acknowledgement) uint64 {
+	t.Helper()
+	require.True(t, ack.Success(), ack.GetError())
+	var packetAck checkers.GamePacketAck
+	require.NoError(t, checkers.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck))
+	return packetAck.GameId
+}
+
+// 每一种数据包都可以编码后解码还原，并由 OnRecvPacket 交给对应的处理函数
+func TestOnRecvPacketDispatchesEveryPacketType(t *testing.T) {
+	f := testutil.NewFixture(t)
+	am := module.NewAppModule(f.Cdc, *f.Keeper, nil, f.Bank)
+	local, remote := testutil.Address(0), testutil.Address(1)
+	require.NoError(t, f.Keeper.IbcChannels.Set(f.Ctx, testChannel, checkers.IbcChannel{ChannelId: testChannel, PortId: testPort}))
+
+	ack := recvPacket(t, f, am, 1, checkers.NewRecordPacket("remote record", remote))
+	require.True(t, ack.Success(), ack.GetError())
+	var recordAck checkers.RecordPacketAck
+	require.NoError(t, checkers.ModuleCdc.UnmarshalJSON(ack.GetResult(), &recordAck))
+	has, err := f.Keeper.RemoteRecords.Has(f.Ctx, collections.Join(testChannel, uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	inviteId := requireGameAck(t, recvPacket(t, f, am, 2, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameInvitePacket{GameInvitePacket: &checkers.GameInvitePacketData{
+			SenderGameId: 5,
+			Inviter:      remote,
+			Invitee:      local,
+			InviterColor: "b",
+		}},
+	}))
+	invited, err := f.Keeper.IbcGames.Get(f.Ctx, collections.Join(testChannel, inviteId))
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_PENDING, invited.Game.Status)
+	require.Equal(t, uint64(5), invited.RemoteId)
+
+	// 本链发出的两个邀请，对方执黑先走
+	for _, id := range []uint64{inviteId + 1, inviteId + 2} {
+		ibcGame := checkers.NewIbcGame(testChannel, local, remote, "r", "r")
+		ibcGame.Id = id
+		require.NoError(t, f.Keeper.IbcGames.Set(f.Ctx, collections.Join(testChannel, id), ibcGame))
+		require.Equal(t, id, requireGameAck(t, recvPacket(t, f, am, 2*id, checkers.CheckersPacketData{
+			Packet: &checkers.CheckersPacketData_GameAcceptPacket{GameAcceptPacket: &checkers.GameAcceptPacketData{
+				SenderGameId:   10 + id,
+				ReceiverGameId: id,
+				Player:         remote,
+			}},
+		})))
+		require.Equal(t, id, requireGameAck(t, recvPacket(t, f, am, 2*id+1, checkers.CheckersPacketData{
+			Packet: &checkers.CheckersPacketData_GameMovePacket{GameMovePacket: &checkers.GameMovePacketData{
+				SenderGameId:   10 + id,
+				ReceiverGameId: id,
+				Player:         remote,
+				FromX:          1,
+				FromY:          2,
+				ToX:            2,
+				ToY:            3,
+			}},
+		})))
+	}
+
+	require.Equal(t, inviteId+1, requireGameAck(t, recvPacket(t, f, am, 20, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameResignPacket{GameResignPacket: &checkers.GameResignPacketData{
+			SenderGameId:   11 + inviteId,
+			ReceiverGameId: inviteId + 1,
+			Player:         remote,
+			MoveCount:      1,
+		}},
+	})))
+	require.Equal(t, inviteId+2, requireGameAck(t, recvPacket(t, f, am, 21, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameResultPacket{GameResultPacket: &checkers.GameResultPacketData{
+			SenderGameId:   12 + inviteId,
+			ReceiverGameId: inviteId + 2,
+			Player:         remote,
+			MoveCount:      1,
+			Winner:         "b",
+			Reason:         checkers.GameEndReason_GAME_END_REASON_TIMED_OUT,
+		}},
+	})))
+	for id, winner := range map[uint64]string{inviteId + 1: "r", inviteId + 2: "b"} {
+		ibcGame, err := f.Keeper.IbcGames.Get(f.Ctx, collections.Join(testChannel, id))
+		require.NoError(t, err)
+		require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, ibcGame.Game.Status)
+		require.Equal(t, uint64(1), ibcGame.Game.MoveCount)
+		require.Equal(t, winner, ibcGame.Game.Winner)
+	}
+
+	// 处理失败的数据包返回错误确认，不计入通道的统计
+	ack = recvPacket(t, f, am, 22, checkers.CheckersPacketData{
+		Packet: &checkers.CheckersPacketData_GameResignPacket{GameResignPacket: &checkers.GameResignPacketData{
+			SenderGameId:   11 + inviteId,
+			ReceiverGameId: inviteId + 1,
+			Player:         remote,
+			MoveCount:      1,
+		}},
+	})
+	require.False(t, ack.Success())
+	ibcChannel, err := f.Keeper.IbcChannels.Get(f.Ctx, testChannel)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), ibcChannel.PacketsReceived)
+}
+
+// 没有数据包或者数据包类型未知时，编码失败，收到这样的数据包返回错误确认
+func TestOnRecvPacketRejectsEmptyAndUnknownPackets(t *testing.T) {
+	f := testutil.NewFixture(t)
+	am := module.NewAppModule(f.Cdc, *f.Keeper, nil, f.Bank)
+
+	empty := checkers.CheckersPacketData{}
+	_, err := empty.GetBytes()
+	require.Error(t, err)
+
+	for _, bz := range [][]byte{
+		[]byte(`{}`),
+		[]byte(`{"unknownPacket":{"senderGameId":"1"}}`),
+		[]byte(`not json`),
+		nil,
+	} {
+		_, err := checkers.UnmarshalCheckersPacketData(bz)
+		require.Error(t, err, "%s", bz)
+		ack := recvBytes(t, f, am, 1, bz)
+		require.False(t, ack.Success(), "%s", bz)
+		require.NotEmpty(t, ack.GetError())
+	}
+}
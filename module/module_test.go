@@ -0,0 +1,140 @@
+package module_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+	"github.com/buzzing/checkers/module"
+	"github.com/buzzing/checkers/rules"
+	"github.com/buzzing/checkers/testutil"
+)
+
+// 模块自己添加的 record 不受 RecordMaxLength 的限制，参数过小时区块仍然可以正常执行
+func TestBlockRecordsIgnoreRecordMaxLength(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := checkers.DefaultParams()
+	params.RecordMaxLength = 5
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+
+	am := module.NewAppModule(nil, *f.Keeper, nil, f.Bank)
+	require.NoError(t, am.BeginBlock(f.Ctx))
+	require.NoError(t, am.EndBlock(f.Ctx))
+
+	var texts []string
+	require.NoError(t, f.Keeper.Records.Walk(f.Ctx, nil, func(_ uint64, record checkers.Record) (bool, error) {
+		texts = append(texts, record.Text)
+		return false, nil
+	}))
+	require.Equal(t, []string{"by BeginBlocker", "by EndBlocker"}, texts)
+
+	// MsgAddRecord 仍然受参数限制
+	_, err := f.Keeper.SubmitRecord(f.Ctx, testutil.Address(0), "too long", nil)
+	require.ErrorIs(t, err, checkers.ErrRecordTooLong)
+
+	gs, err := f.Keeper.ExportGenesis(f.Ctx)
+	require.NoError(t, err)
+	require.NoError(t, gs.Validate())
+}
+
+// blockedBoard 轮到黑方，双方的棋子互相挡住，黑方无棋可走
+const blockedBoard = "********|********|********|********|***b****|b*b*b*b*|*r*b*b*b|r*r*r*r*"
+
+// 版本 1 导出的创世状态没有游戏状态、参数为空，record 以字符串保存，导入时转换为当前版本
+func TestImportV1Genesis(t *testing.T) {
+	f := testutil.NewFixture(t)
+	black, red := testutil.Address(0), testutil.Address(1)
+	v1Genesis := `{
+		"params": {},
+		"indexedStoredGameList": [
+			{"index": "1", "storedGame": {"board": "` + rules.New().String() + `", "turn": "b", "black": "` + black + `", "red": "` + red + `"}},
+			{"index": "2", "storedGame": {"board": "` + blockedBoard + `", "turn": "b", "black": "` + black + `", "red": "` + red + `"}}
+		],
+		"recordList": ["b record", "a record"]
+	}`
+
+	am := module.NewAppModule(f.Cdc, *f.Keeper, nil, f.Bank)
+	require.NoError(t, am.ValidateGenesis(f.Cdc, nil, json.RawMessage(v1Genesis)))
+
+	ctx := testutil.WithHeight(f.Ctx, 10)
+	am.InitGenesis(ctx, f.Cdc, json.RawMessage(v1Genesis))
+
+	storedGame, err := f.Keeper.StoredGames.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_ACTIVE, storedGame.Status)
+	params, err := f.Keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10)+int64(params.MaxTurnDuration), storedGame.Deadline)
+	has, err := f.Keeper.Deadlines.Has(ctx, collections.Join(storedGame.Deadline, "1"))
+	require.NoError(t, err)
+	require.True(t, has)
+	// 轮到的一方无棋可走，与走棋时一样判负
+	storedGame, err = f.Keeper.StoredGames.Get(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, storedGame.Status)
+	require.Equal(t, "r", storedGame.Winner)
+
+	gs, err := f.Keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, gs.Validate())
+	require.Empty(t, gs.RecordList)
+	require.Len(t, gs.Records, 2)
+	for i, text := range []string{"b record", "a record"} {
+		require.Equal(t, text, gs.Records[i].Text)
+		require.Equal(t, checkers.RecordSource_RECORD_SOURCE_LEGACY, gs.Records[i].Source)
+	}
+	require.Equal(t, uint64(2), gs.RecordCount)
+	require.Equal(t, &checkers.RecordLogHead{Id: 1, Hash: gs.Records[1].Hash}, gs.RecordLogHead)
+
+	// 当前版本的创世状态不受影响，遗留的 recordList 不能直接通过验证
+	gs.RecordList = []string{"legacy"}
+	require.ErrorIs(t, gs.Validate(), checkers.ErrInvalidRecord)
+}
+
+// EndBlock 中回调返回错误时只丢弃回调的修改，超时判负照常完成，区块不会失败
+func TestEndBlockDiscardsFailingHooks(t *testing.T) {
+	f := testutil.NewFixture(t)
+	ms := keeper.NewMsgServerImpl(*f.Keeper)
+	black, red := testutil.Address(0), testutil.Address(1)
+	_, err := ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: black, Index: "1", Black: black, Red: red})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: red, GameIndex: "1"})
+	require.NoError(t, err)
+	storedGame, err := f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.NoError(t, err)
+
+	hookErr := errors.New("hook failed")
+	var ended []string
+	f.Keeper.SetHooks(testutil.Hooks{
+		GameEnded: func(ctx context.Context, index string, _ checkers.StoredGame) error {
+			ended = append(ended, index)
+			if err := f.Keeper.StoredGames.Remove(ctx, index); err != nil {
+				return err
+			}
+			return hookErr
+		},
+	})
+
+	am := module.NewAppModule(f.Cdc, *f.Keeper, nil, f.Bank)
+	require.NoError(t, am.EndBlock(testutil.WithHeight(f.Ctx, storedGame.Deadline+1)))
+	require.Equal(t, []string{"1"}, ended)
+	forfeited, err := f.Keeper.StoredGames.Get(f.Ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, checkers.GameStatus_GAME_STATUS_FINISHED, forfeited.Status)
+	require.Equal(t, "r", forfeited.Winner)
+
+	// 交易中回调的错误仍然使交易失败
+	_, err = ms.CreateGame(f.Ctx, &checkers.MsgCreateGame{Creator: black, Index: "2", Black: black, Red: red})
+	require.NoError(t, err)
+	_, err = ms.AcceptGame(f.Ctx, &checkers.MsgAcceptGame{Creator: red, GameIndex: "2"})
+	require.NoError(t, err)
+	_, err = ms.Resign(f.Ctx, &checkers.MsgResign{Creator: black, GameIndex: "2"})
+	require.ErrorIs(t, err, hookErr)
+}
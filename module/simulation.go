@@ -0,0 +1,24 @@
+package module
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/simulation"
+)
+
+// GenerateGenesisState 生成模拟测试使用的随机创世状态
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder 注册模块存储的解码器，用于打印模拟测试中存储的差异
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[checkers.ModuleName] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations 返回模拟测试中模块的随机操作及其权重
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, &am.keeper)
+}
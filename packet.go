@@ -0,0 +1,43 @@
+package checkers
+
+import (
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRecordPacket 返回包含一条 record 的数据包
+func NewRecordPacket(recordData string, sender string) CheckersPacketData {
+	return CheckersPacketData{
+		Packet: &CheckersPacketData_RecordPacket{
+			RecordPacket: &RecordPacketData{
+				RecordData: recordData,
+				Sender:     sender,
+			},
+		},
+	}
+}
+
+// GetBytes 返回数据包的规范编码，即 ModuleCdc 的 JSON 编码
+// 发送数据包和解析收到的数据包都必须使用这个编码，参见 UnmarshalCheckersPacketData
+func (packetData *CheckersPacketData) GetBytes() ([]byte, error) {
+	if packetData.Packet == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "empty packet")
+	}
+	bz, err := ModuleCdc.MarshalJSON(packetData)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %v", err)
+	}
+	return bz, nil
+}
+
+// UnmarshalCheckersPacketData 解析由 GetBytes 编码的数据包，数据包的类型不能为空
+func UnmarshalCheckersPacketData(bz []byte) (CheckersPacketData, error) {
+	var packetData CheckersPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return CheckersPacketData{}, errors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %v", err)
+	}
+	if packetData.Packet == nil {
+		return CheckersPacketData{}, errors.Wrapf(sdkerrors.ErrUnknownRequest, "empty packet")
+	}
+	return packetData, nil
+}
@@ -23,6 +23,146 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CheckersPacketData 为模块所有 IBC 数据包的外层结构，通过 oneof 区分数据包的类型
+// 数据包以 ModuleCdc 的 JSON 编码发送，收发两端使用相同的编码
+// 新增的数据包类型（例如跨链游戏）使用新的字段编号，已使用的编号不能修改或复用
+type CheckersPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*CheckersPacketData_RecordPacket
+	//	*CheckersPacketData_GameInvitePacket
+	//	*CheckersPacketData_GameAcceptPacket
+	//	*CheckersPacketData_GameMovePacket
+	//	*CheckersPacketData_GameResignPacket
+	//	*CheckersPacketData_GameResultPacket
+	Packet isCheckersPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *CheckersPacketData) Reset()         { *m = CheckersPacketData{} }
+func (m *CheckersPacketData) String() string { return proto.CompactTextString(m) }
+func (*CheckersPacketData) ProtoMessage()    {}
+func (*CheckersPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{0}
+}
+func (m *CheckersPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckersPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckersPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckersPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckersPacketData.Merge(m, src)
+}
+func (m *CheckersPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckersPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckersPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckersPacketData proto.InternalMessageInfo
+
+type isCheckersPacketData_Packet interface {
+	isCheckersPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type CheckersPacketData_RecordPacket struct {
+	RecordPacket *RecordPacketData `protobuf:"bytes,1,opt,name=record_packet,json=recordPacket,proto3,oneof" json:"record_packet,omitempty"`
+}
+type CheckersPacketData_GameInvitePacket struct {
+	GameInvitePacket *GameInvitePacketData `protobuf:"bytes,2,opt,name=game_invite_packet,json=gameInvitePacket,proto3,oneof" json:"game_invite_packet,omitempty"`
+}
+type CheckersPacketData_GameAcceptPacket struct {
+	GameAcceptPacket *GameAcceptPacketData `protobuf:"bytes,3,opt,name=game_accept_packet,json=gameAcceptPacket,proto3,oneof" json:"game_accept_packet,omitempty"`
+}
+type CheckersPacketData_GameMovePacket struct {
+	GameMovePacket *GameMovePacketData `protobuf:"bytes,4,opt,name=game_move_packet,json=gameMovePacket,proto3,oneof" json:"game_move_packet,omitempty"`
+}
+type CheckersPacketData_GameResignPacket struct {
+	GameResignPacket *GameResignPacketData `protobuf:"bytes,5,opt,name=game_resign_packet,json=gameResignPacket,proto3,oneof" json:"game_resign_packet,omitempty"`
+}
+type CheckersPacketData_GameResultPacket struct {
+	GameResultPacket *GameResultPacketData `protobuf:"bytes,6,opt,name=game_result_packet,json=gameResultPacket,proto3,oneof" json:"game_result_packet,omitempty"`
+}
+
+func (*CheckersPacketData_RecordPacket) isCheckersPacketData_Packet()     {}
+func (*CheckersPacketData_GameInvitePacket) isCheckersPacketData_Packet() {}
+func (*CheckersPacketData_GameAcceptPacket) isCheckersPacketData_Packet() {}
+func (*CheckersPacketData_GameMovePacket) isCheckersPacketData_Packet()   {}
+func (*CheckersPacketData_GameResignPacket) isCheckersPacketData_Packet() {}
+func (*CheckersPacketData_GameResultPacket) isCheckersPacketData_Packet() {}
+
+func (m *CheckersPacketData) GetPacket() isCheckersPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetRecordPacket() *RecordPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_RecordPacket); ok {
+		return x.RecordPacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameInvitePacket() *GameInvitePacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameInvitePacket); ok {
+		return x.GameInvitePacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameAcceptPacket() *GameAcceptPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameAcceptPacket); ok {
+		return x.GameAcceptPacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameMovePacket() *GameMovePacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameMovePacket); ok {
+		return x.GameMovePacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameResignPacket() *GameResignPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameResignPacket); ok {
+		return x.GameResignPacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameResultPacket() *GameResultPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameResultPacket); ok {
+		return x.GameResultPacket
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CheckersPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CheckersPacketData_RecordPacket)(nil),
+		(*CheckersPacketData_GameInvitePacket)(nil),
+		(*CheckersPacketData_GameAcceptPacket)(nil),
+		(*CheckersPacketData_GameMovePacket)(nil),
+		(*CheckersPacketData_GameResignPacket)(nil),
+		(*CheckersPacketData_GameResultPacket)(nil),
+	}
+}
+
 // RecordPacketData IBC 发送的消息格式
 type RecordPacketData struct {
 	RecordData string `protobuf:"bytes,1,opt,name=record_data,json=recordData,proto3" json:"record_data,omitempty"`
@@ -33,7 +173,7 @@ func (m *RecordPacketData) Reset()         { *m = RecordPacketData{} }
 func (m *RecordPacketData) String() string { return proto.CompactTextString(m) }
 func (*RecordPacketData) ProtoMessage()    {}
 func (*RecordPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b2df68cd365656, []int{0}
+	return fileDescriptor_b1b2df68cd365656, []int{1}
 }
 func (m *RecordPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -79,13 +219,15 @@ func (m *RecordPacketData) GetSender() string {
 // RecordPacketAck IBC 消息的确认格式
 type RecordPacketAck struct {
 	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// height 为接收方保存 record 时的区块高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *RecordPacketAck) Reset()         { *m = RecordPacketAck{} }
 func (m *RecordPacketAck) String() string { return proto.CompactTextString(m) }
 func (*RecordPacketAck) ProtoMessage()    {}
 func (*RecordPacketAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b2df68cd365656, []int{1}
+	return fileDescriptor_b1b2df68cd365656, []int{2}
 }
 func (m *RecordPacketAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,145 +263,2409 @@ func (m *RecordPacketAck) GetResult() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*RecordPacketData)(nil), "buzzing.checkers.v1.RecordPacketData")
-	proto.RegisterType((*RecordPacketAck)(nil), "buzzing.checkers.v1.RecordPacketAck")
+func (m *RecordPacketAck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("buzzing/checkers/v1/packet.proto", fileDescriptor_b1b2df68cd365656) }
+// GameInvitePacketData 邀请对方链上的玩家进行一局游戏
+type GameInvitePacketData struct {
+	SenderGameId uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	// inviter 为发送方链上发出邀请的玩家
+	Inviter string `protobuf:"bytes,2,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	// invitee 为接收方链上被邀请的玩家
+	Invitee string `protobuf:"bytes,3,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	// inviter_color 为发出邀请的玩家执的棋子颜色，"b" 或 "r"
+	InviterColor string `protobuf:"bytes,4,opt,name=inviter_color,json=inviterColor,proto3" json:"inviter_color,omitempty"`
+}
 
-var fileDescriptor_b1b2df68cd365656 = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2a, 0xad, 0xaa,
-	0xca, 0xcc, 0x4b, 0xd7, 0x4f, 0xce, 0x48, 0x4d, 0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x4c, 0xce, 0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0xde, 0x5c, 0x02, 0x41, 0xa9, 0xc9, 0xf9, 0x45, 0x29, 0x01, 0x60, 0x03,
-	0x5c, 0x12, 0x4b, 0x12, 0x85, 0xe4, 0xb9, 0xb8, 0x8b, 0xc0, 0x62, 0xf1, 0x29, 0x89, 0x25, 0x89,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x5c, 0x10, 0x21, 0xb0, 0x02, 0x31, 0x2e, 0xb6, 0xe2,
-	0xd4, 0xbc, 0x94, 0xd4, 0x22, 0x09, 0x26, 0xb0, 0x1c, 0x94, 0xa7, 0xa4, 0xc9, 0xc5, 0x8f, 0x6c,
-	0x98, 0x63, 0x72, 0x36, 0x48, 0x69, 0x51, 0x6a, 0x71, 0x69, 0x4e, 0x09, 0xd4, 0x18, 0x28, 0xcf,
-	0xc9, 0xf4, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa4, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xd1, 0x7d, 0x9a, 0xc4, 0x06, 0x76, 0xb5, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x74, 0x58, 0x7f, 0x99, 0x04, 0x01, 0x00, 0x00,
+func (m *GameInvitePacketData) Reset()         { *m = GameInvitePacketData{} }
+func (m *GameInvitePacketData) String() string { return proto.CompactTextString(m) }
+func (*GameInvitePacketData) ProtoMessage()    {}
+func (*GameInvitePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{3}
+}
+func (m *GameInvitePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameInvitePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameInvitePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameInvitePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameInvitePacketData.Merge(m, src)
+}
+func (m *GameInvitePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameInvitePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameInvitePacketData.DiscardUnknown(m)
 }
 
-func (m *RecordPacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GameInvitePacketData proto.InternalMessageInfo
+
+func (m *GameInvitePacketData) GetSenderGameId() uint64 {
+	if m != nil {
+		return m.SenderGameId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RecordPacketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GameInvitePacketData) GetInviter() string {
+	if m != nil {
+		return m.Inviter
+	}
+	return ""
 }
 
-func (m *RecordPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+func (m *GameInvitePacketData) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
 	}
-	if len(m.RecordData) > 0 {
-		i -= len(m.RecordData)
-		copy(dAtA[i:], m.RecordData)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.RecordData)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *GameInvitePacketData) GetInviterColor() string {
+	if m != nil {
+		return m.InviterColor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RecordPacketAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// GameAcceptPacketData 接受对方链发来的游戏邀请
+type GameAcceptPacketData struct {
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (m *GameAcceptPacketData) Reset()         { *m = GameAcceptPacketData{} }
+func (m *GameAcceptPacketData) String() string { return proto.CompactTextString(m) }
+func (*GameAcceptPacketData) ProtoMessage()    {}
+func (*GameAcceptPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{4}
+}
+func (m *GameAcceptPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameAcceptPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameAcceptPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GameAcceptPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameAcceptPacketData.Merge(m, src)
+}
+func (m *GameAcceptPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameAcceptPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameAcceptPacketData.DiscardUnknown(m)
 }
 
-func (m *RecordPacketAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GameAcceptPacketData proto.InternalMessageInfo
+
+func (m *GameAcceptPacketData) GetSenderGameId() uint64 {
+	if m != nil {
+		return m.SenderGameId
+	}
+	return 0
 }
 
-func (m *RecordPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+func (m *GameAcceptPacketData) GetReceiverGameId() uint64 {
+	if m != nil {
+		return m.ReceiverGameId
+	}
+	return 0
+}
+
+func (m *GameAcceptPacketData) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+// GameMovePacketData 在跨链游戏中走一步棋
+type GameMovePacketData struct {
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64 `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	FromX          uint64 `protobuf:"varint,5,opt,name=from_x,json=fromX,proto3" json:"from_x,omitempty"`
+	FromY          uint64 `protobuf:"varint,6,opt,name=from_y,json=fromY,proto3" json:"from_y,omitempty"`
+	ToX            uint64 `protobuf:"varint,7,opt,name=to_x,json=toX,proto3" json:"to_x,omitempty"`
+	ToY            uint64 `protobuf:"varint,8,opt,name=to_y,json=toY,proto3" json:"to_y,omitempty"`
+}
+
+func (m *GameMovePacketData) Reset()         { *m = GameMovePacketData{} }
+func (m *GameMovePacketData) String() string { return proto.CompactTextString(m) }
+func (*GameMovePacketData) ProtoMessage()    {}
+func (*GameMovePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{5}
+}
+func (m *GameMovePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameMovePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameMovePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameMovePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameMovePacketData.Merge(m, src)
+}
+func (m *GameMovePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameMovePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameMovePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameMovePacketData proto.InternalMessageInfo
+
+func (m *GameMovePacketData) GetSenderGameId() uint64 {
+	if m != nil {
+		return m.SenderGameId
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetReceiverGameId() uint64 {
+	if m != nil {
+		return m.ReceiverGameId
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *GameMovePacketData) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetFromX() uint64 {
+	if m != nil {
+		return m.FromX
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetFromY() uint64 {
+	if m != nil {
+		return m.FromY
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetToX() uint64 {
+	if m != nil {
+		return m.ToX
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetToY() uint64 {
+	if m != nil {
+		return m.ToY
+	}
+	return 0
+}
+
+// GameResignPacketData 在跨链游戏中认输
+type GameResignPacketData struct {
+	SenderGameId   uint64 `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64 `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64 `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+}
+
+func (m *GameResignPacketData) Reset()         { *m = GameResignPacketData{} }
+func (m *GameResignPacketData) String() string { return proto.CompactTextString(m) }
+func (*GameResignPacketData) ProtoMessage()    {}
+func (*GameResignPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{6}
+}
+func (m *GameResignPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameResignPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameResignPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameResignPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameResignPacketData.Merge(m, src)
+}
+func (m *GameResignPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameResignPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameResignPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameResignPacketData proto.InternalMessageInfo
+
+func (m *GameResignPacketData) GetSenderGameId() uint64 {
+	if m != nil {
+		return m.SenderGameId
+	}
+	return 0
+}
+
+func (m *GameResignPacketData) GetReceiverGameId() uint64 {
+	if m != nil {
+		return m.ReceiverGameId
+	}
+	return 0
+}
+
+func (m *GameResignPacketData) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *GameResignPacketData) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+// GameResultPacketData 通知对方链游戏在发送方链上以 winner 获胜结束
+// 目前只用于对手超过 IbcGameTurnTimeout 没有走棋时，由等待的一方宣布获胜
+type GameResultPacketData struct {
+	SenderGameId   uint64        `protobuf:"varint,1,opt,name=sender_game_id,json=senderGameId,proto3" json:"sender_game_id,omitempty"`
+	ReceiverGameId uint64        `protobuf:"varint,2,opt,name=receiver_game_id,json=receiverGameId,proto3" json:"receiver_game_id,omitempty"`
+	Player         string        `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	MoveCount      uint64        `protobuf:"varint,4,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	Winner         string        `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	Reason         GameEndReason `protobuf:"varint,6,opt,name=reason,proto3,enum=buzzing.checkers.v1.GameEndReason" json:"reason,omitempty"`
+}
+
+func (m *GameResultPacketData) Reset()         { *m = GameResultPacketData{} }
+func (m *GameResultPacketData) String() string { return proto.CompactTextString(m) }
+func (*GameResultPacketData) ProtoMessage()    {}
+func (*GameResultPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{7}
+}
+func (m *GameResultPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameResultPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameResultPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameResultPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameResultPacketData.Merge(m, src)
+}
+func (m *GameResultPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameResultPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameResultPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameResultPacketData proto.InternalMessageInfo
+
+func (m *GameResultPacketData) GetSenderGameId() uint64 {
+	if m != nil {
+		return m.SenderGameId
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetReceiverGameId() uint64 {
+	if m != nil {
+		return m.ReceiverGameId
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *GameResultPacketData) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *GameResultPacketData) GetReason() GameEndReason {
+	if m != nil {
+		return m.Reason
+	}
+	return GameEndReason_GAME_END_REASON_UNSPECIFIED
+}
+
+// GamePacketAck 跨链游戏数据包的确认格式
+type GamePacketAck struct {
+	// game_id 为接收方链上的游戏 id
+	GameId uint64 `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *GamePacketAck) Reset()         { *m = GamePacketAck{} }
+func (m *GamePacketAck) String() string { return proto.CompactTextString(m) }
+func (*GamePacketAck) ProtoMessage()    {}
+func (*GamePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b2df68cd365656, []int{8}
+}
+func (m *GamePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GamePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GamePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GamePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GamePacketAck.Merge(m, src)
+}
+func (m *GamePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GamePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GamePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GamePacketAck proto.InternalMessageInfo
+
+func (m *GamePacketAck) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CheckersPacketData)(nil), "buzzing.checkers.v1.CheckersPacketData")
+	proto.RegisterType((*RecordPacketData)(nil), "buzzing.checkers.v1.RecordPacketData")
+	proto.RegisterType((*RecordPacketAck)(nil), "buzzing.checkers.v1.RecordPacketAck")
+	proto.RegisterType((*GameInvitePacketData)(nil), "buzzing.checkers.v1.GameInvitePacketData")
+	proto.RegisterType((*GameAcceptPacketData)(nil), "buzzing.checkers.v1.GameAcceptPacketData")
+	proto.RegisterType((*GameMovePacketData)(nil), "buzzing.checkers.v1.GameMovePacketData")
+	proto.RegisterType((*GameResignPacketData)(nil), "buzzing.checkers.v1.GameResignPacketData")
+	proto.RegisterType((*GameResultPacketData)(nil), "buzzing.checkers.v1.GameResultPacketData")
+	proto.RegisterType((*GamePacketAck)(nil), "buzzing.checkers.v1.GamePacketAck")
+}
+
+func init() { proto.RegisterFile("buzzing/checkers/v1/packet.proto", fileDescriptor_b1b2df68cd365656) }
+
+var fileDescriptor_b1b2df68cd365656 = []byte{
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x1b, 0xd7, 0x6d, 0xa6, 0x6d, 0x28, 0x4b, 0x29, 0x56, 0x11, 0xa6, 0x32, 0x20, 0xca,
+	0xc5, 0x51, 0x8b, 0xb8, 0x70, 0x6b, 0x0b, 0x02, 0x04, 0x48, 0x68, 0xb9, 0xb4, 0x5c, 0x2c, 0xd7,
+	0x5e, 0x1c, 0xab, 0x89, 0x37, 0xda, 0x38, 0xa6, 0xe9, 0x81, 0x67, 0xe0, 0xc2, 0x91, 0x47, 0xe0,
+	0x3d, 0x38, 0xf6, 0xc8, 0x11, 0x25, 0x0f, 0x80, 0x78, 0x03, 0xe4, 0xd9, 0xb5, 0xe3, 0xfc, 0x54,
+	0xe1, 0x84, 0x7a, 0xcb, 0x7c, 0xfb, 0xed, 0x37, 0x9f, 0x33, 0xdf, 0xd8, 0xb0, 0x7d, 0xd2, 0x3b,
+	0x3f, 0x8f, 0xe2, 0xb0, 0xe1, 0x37, 0x99, 0x7f, 0xca, 0x44, 0xb7, 0x91, 0xee, 0x36, 0x3a, 0x9e,
+	0x7f, 0xca, 0x12, 0xa7, 0x23, 0x78, 0xc2, 0xc9, 0x0d, 0xc5, 0x70, 0x72, 0x86, 0x93, 0xee, 0x6e,
+	0x6d, 0x84, 0x3c, 0xe4, 0x78, 0xde, 0xc8, 0x7e, 0x49, 0xea, 0xd6, 0x4c, 0x31, 0x96, 0xb2, 0x38,
+	0xe9, 0x4a, 0x86, 0xfd, 0x5d, 0x07, 0x72, 0xa8, 0x0e, 0xdf, 0x61, 0x97, 0x67, 0x5e, 0xe2, 0x91,
+	0x37, 0xb0, 0x26, 0x98, 0xcf, 0x45, 0xe0, 0xca, 0xd6, 0xa6, 0xb6, 0xad, 0xed, 0xac, 0xec, 0x3d,
+	0x70, 0x66, 0xf4, 0x76, 0x28, 0x32, 0x47, 0xb7, 0x5f, 0x56, 0xe8, 0xaa, 0x28, 0x61, 0xe4, 0x18,
+	0x48, 0xe8, 0xb5, 0x99, 0x1b, 0xc5, 0x69, 0x94, 0xb0, 0x5c, 0x72, 0x01, 0x25, 0x1f, 0xcd, 0x94,
+	0x7c, 0xe1, 0xb5, 0xd9, 0x2b, 0x64, 0x8f, 0xc9, 0xae, 0x87, 0x13, 0x78, 0x21, 0xed, 0xf9, 0x3e,
+	0xeb, 0x24, 0xb9, 0x74, 0x75, 0x8e, 0xf4, 0x3e, 0xb2, 0xa7, 0xa5, 0xcb, 0x38, 0x79, 0x0f, 0x88,
+	0xb9, 0x6d, 0x9e, 0x16, 0x9e, 0x75, 0x14, 0x7e, 0x78, 0xa9, 0xf0, 0x5b, 0x9e, 0x8e, 0x3b, 0xae,
+	0x87, 0x63, 0x68, 0xe1, 0x57, 0xb0, 0x6e, 0x14, 0xc6, 0xb9, 0xec, 0xe2, 0x1c, 0xbf, 0x14, 0xd9,
+	0xd3, 0x7e, 0xcb, 0x78, 0x59, 0xba, 0xd7, 0x2a, 0xfe, 0x0a, 0x63, 0xbe, 0x74, 0xaf, 0x95, 0xcc,
+	0x94, 0x2e, 0xf0, 0x83, 0x65, 0x30, 0xa4, 0x9c, 0xfd, 0x1a, 0xd6, 0x27, 0xc7, 0x4d, 0xee, 0xc2,
+	0x8a, 0x0a, 0x4b, 0xe0, 0x25, 0x1e, 0x46, 0xa5, 0x46, 0x41, 0x42, 0x48, 0xd8, 0x04, 0xa3, 0xcb,
+	0xe2, 0x80, 0x09, 0x9c, 0x79, 0x8d, 0xaa, 0xca, 0xde, 0x87, 0x6b, 0x65, 0xb1, 0x7d, 0xff, 0x34,
+	0xa3, 0x4a, 0xff, 0x4a, 0x46, 0x55, 0x19, 0xde, 0x64, 0x51, 0xd8, 0x94, 0xb1, 0xa9, 0x52, 0x55,
+	0xd9, 0x5f, 0x35, 0xd8, 0x98, 0x15, 0x16, 0x72, 0x1f, 0xea, 0xb2, 0x8b, 0x2b, 0xa3, 0x17, 0xa0,
+	0xa0, 0x4e, 0x57, 0x25, 0x8a, 0x77, 0x02, 0x62, 0xc2, 0x92, 0x0c, 0x65, 0x6e, 0x2d, 0x2f, 0x47,
+	0x27, 0xcc, 0xac, 0x96, 0x4f, 0x18, 0xb9, 0x07, 0x6b, 0x8a, 0xe4, 0xfa, 0xbc, 0xc5, 0x05, 0x86,
+	0xa2, 0x46, 0x57, 0x15, 0x78, 0x98, 0x61, 0xf6, 0x67, 0x69, 0x6b, 0x32, 0x68, 0xff, 0x68, 0x6b,
+	0x07, 0xd6, 0x05, 0xf3, 0x59, 0x94, 0x96, 0x78, 0x0b, 0xc8, 0xab, 0xe7, 0xb8, 0x62, 0x6e, 0x82,
+	0xd1, 0x69, 0x79, 0x7d, 0x26, 0x94, 0x4b, 0x55, 0xd9, 0xbf, 0x35, 0x20, 0xd3, 0x81, 0xfc, 0x5f,
+	0xed, 0xc9, 0x1d, 0x00, 0x5c, 0x1b, 0x9f, 0xf7, 0x62, 0xb9, 0x35, 0x3a, 0xad, 0x65, 0xc8, 0x61,
+	0x06, 0x90, 0x9b, 0x60, 0x7c, 0x14, 0xbc, 0xed, 0x9e, 0x61, 0xf2, 0x75, 0xba, 0x98, 0x55, 0x47,
+	0x05, 0xdc, 0x37, 0x8d, 0x11, 0x7c, 0x4c, 0xae, 0x83, 0x9e, 0x70, 0xf7, 0xcc, 0x5c, 0x42, 0xb0,
+	0x9a, 0xf0, 0x23, 0x05, 0xf5, 0xcd, 0xe5, 0x1c, 0x3a, 0xb6, 0xbf, 0xa9, 0x24, 0x4c, 0xee, 0xca,
+	0x15, 0x79, 0x66, 0xfb, 0xcf, 0xc8, 0xdf, 0xd8, 0xc2, 0x5d, 0x95, 0x99, 0x6c, 0x82, 0xf1, 0x29,
+	0x8a, 0x63, 0x26, 0x70, 0x26, 0x35, 0xaa, 0x2a, 0xf2, 0x34, 0xdb, 0x48, 0xaf, 0xcb, 0x63, 0x1c,
+	0x4a, 0x7d, 0xcf, 0xbe, 0xf4, 0x55, 0xf2, 0x3c, 0x0e, 0x28, 0x32, 0xa9, 0xba, 0x61, 0xef, 0xc0,
+	0x5a, 0x76, 0x30, 0x5a, 0xef, 0x5b, 0xb0, 0x34, 0xfe, 0x90, 0x46, 0x88, 0xa6, 0x0f, 0x9e, 0xfc,
+	0x18, 0x58, 0xda, 0xc5, 0xc0, 0xd2, 0x7e, 0x0d, 0x2c, 0xed, 0xcb, 0xd0, 0xaa, 0x5c, 0x0c, 0xad,
+	0xca, 0xcf, 0xa1, 0x55, 0xf9, 0x70, 0x3b, 0x8c, 0x92, 0x66, 0xef, 0xc4, 0xf1, 0x79, 0xbb, 0x31,
+	0xf9, 0x39, 0x3b, 0x31, 0xf0, 0x2b, 0xf6, 0xf8, 0xef, 0x00, 0x13, 0x49, 0x7c, 0x48, 0x36, 0x07,
+	0x00, 0x00,
+}
+
+func (m *CheckersPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckersPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckersPacketData_RecordPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_RecordPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordPacket != nil {
+		{
+			size, err := m.RecordPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameInvitePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameInvitePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameInvitePacket != nil {
+		{
+			size, err := m.GameInvitePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameAcceptPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameAcceptPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameAcceptPacket != nil {
+		{
+			size, err := m.GameAcceptPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameMovePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameMovePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameMovePacket != nil {
+		{
+			size, err := m.GameMovePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameResignPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameResignPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameResignPacket != nil {
+		{
+			size, err := m.GameResignPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameResultPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameResultPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameResultPacket != nil {
+		{
+			size, err := m.GameResultPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RecordPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordData) > 0 {
+		i -= len(m.RecordData)
+		copy(dAtA[i:], m.RecordData)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RecordData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameInvitePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameInvitePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameInvitePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InviterColor) > 0 {
+		i -= len(m.InviterColor)
+		copy(dAtA[i:], m.InviterColor)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.InviterColor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Invitee) > 0 {
+		i -= len(m.Invitee)
+		copy(dAtA[i:], m.Invitee)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Invitee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inviter) > 0 {
+		i -= len(m.Inviter)
+		copy(dAtA[i:], m.Inviter)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Inviter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SenderGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SenderGameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameAcceptPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameAcceptPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameAcceptPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReceiverGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceiverGameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SenderGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SenderGameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameMovePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameMovePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameMovePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToY != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ToY))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ToX != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ToX))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FromY != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.FromY))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FromX != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.FromX))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReceiverGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceiverGameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SenderGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SenderGameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameResignPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameResignPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameResignPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReceiverGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceiverGameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SenderGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SenderGameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameResultPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameResultPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameResultPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReceiverGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceiverGameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SenderGameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SenderGameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GamePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GamePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GamePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckersPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *CheckersPacketData_RecordPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordPacket != nil {
+		l = m.RecordPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameInvitePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameInvitePacket != nil {
+		l = m.GameInvitePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameAcceptPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameAcceptPacket != nil {
+		l = m.GameAcceptPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameMovePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameMovePacket != nil {
+		l = m.GameMovePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameResignPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameResignPacket != nil {
+		l = m.GameResignPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameResultPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameResultPacket != nil {
+		l = m.GameResultPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *RecordPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordData)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GameInvitePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderGameId != 0 {
+		n += 1 + sovPacket(uint64(m.SenderGameId))
+	}
+	l = len(m.Inviter)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Invitee)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.InviterColor)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameAcceptPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderGameId != 0 {
+		n += 1 + sovPacket(uint64(m.SenderGameId))
+	}
+	if m.ReceiverGameId != 0 {
+		n += 1 + sovPacket(uint64(m.ReceiverGameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameMovePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderGameId != 0 {
+		n += 1 + sovPacket(uint64(m.SenderGameId))
+	}
+	if m.ReceiverGameId != 0 {
+		n += 1 + sovPacket(uint64(m.ReceiverGameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	if m.FromX != 0 {
+		n += 1 + sovPacket(uint64(m.FromX))
+	}
+	if m.FromY != 0 {
+		n += 1 + sovPacket(uint64(m.FromY))
+	}
+	if m.ToX != 0 {
+		n += 1 + sovPacket(uint64(m.ToX))
+	}
+	if m.ToY != 0 {
+		n += 1 + sovPacket(uint64(m.ToY))
+	}
+	return n
+}
+
+func (m *GameResignPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderGameId != 0 {
+		n += 1 + sovPacket(uint64(m.SenderGameId))
+	}
+	if m.ReceiverGameId != 0 {
+		n += 1 + sovPacket(uint64(m.ReceiverGameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	return n
+}
+
+func (m *GameResultPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderGameId != 0 {
+		n += 1 + sovPacket(uint64(m.SenderGameId))
+	}
+	if m.ReceiverGameId != 0 {
+		n += 1 + sovPacket(uint64(m.ReceiverGameId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovPacket(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *GamePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameId != 0 {
+		n += 1 + sovPacket(uint64(m.GameId))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckersPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckersPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckersPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RecordPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_RecordPacket{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameInvitePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameInvitePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameInvitePacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameAcceptPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameAcceptPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameAcceptPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameMovePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameMovePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameMovePacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameResignPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameResignPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameResignPacket{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameResultPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameResultPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameResultPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameInvitePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameInvitePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameInvitePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+			}
+			m.SenderGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inviter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inviter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invitee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviterColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviterColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameAcceptPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameAcceptPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameAcceptPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+			}
+			m.SenderGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+			}
+			m.ReceiverGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameMovePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameMovePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameMovePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+			}
+			m.SenderGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+			}
+			m.ReceiverGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
+			}
+			m.FromX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+			}
+			m.FromY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+			}
+			m.ToX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
+			}
+			m.ToY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *RecordPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordData)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+func (m *GameResignPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameResignPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameResignPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+			}
+			m.SenderGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+			}
+			m.ReceiverGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RecordPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
+func (m *GameResultPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -282,15 +2688,53 @@ func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: GameResultPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GameResultPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGameId", wireType)
+			}
+			m.SenderGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGameId", wireType)
+			}
+			m.ReceiverGameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverGameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -318,11 +2762,30 @@ func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordData = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -350,8 +2813,27 @@ func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= GameEndReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -373,7 +2855,7 @@ func (m *RecordPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordPacketAck) Unmarshal(dAtA []byte) error {
+func (m *GamePacketAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,17 +2878,17 @@ func (m *RecordPacketAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPacketAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: GamePacketAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GamePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			var stringLen uint64
+			m.GameId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -416,24 +2898,11 @@ func (m *RecordPacketAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GameId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPacket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPacket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
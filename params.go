@@ -9,12 +9,174 @@
 // 通过治理提案可以修改参数，以适应链上运行的需求
 package checkers
 
+import (
+	"fmt"
+	"regexp"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultInvitationExpiryBlocks 定义游戏邀请默认的有效区块数
+	DefaultInvitationExpiryBlocks uint64 = 1000
+	// DefaultMatchmakingRatingWindow 定义匹配队列默认的初始等级分差
+	DefaultMatchmakingRatingWindow uint64 = 100
+	// DefaultMatchmakingWindowGrowth 定义匹配队列中每等待一个区块默认增加的等级分差
+	DefaultMatchmakingWindowGrowth uint64 = 10
+	// DefaultMaxTurnDuration 定义默认的每一步棋的最长时限（区块数）
+	DefaultMaxTurnDuration uint64 = 14400
+	// DefaultMaxActiveGames 定义每位玩家默认可以同时参与的游戏数量
+	DefaultMaxActiveGames uint64 = 20
+	// DefaultRecordMaxLength 定义 record 默认的最大字节数
+	DefaultRecordMaxLength uint64 = 256
+	// DefaultRecordRetention 定义 record 默认保留的区块数
+	DefaultRecordRetention uint64 = 100000
+	// DefaultRecordMinLength 定义 record 默认的最小字节数
+	DefaultRecordMinLength uint64 = 1
+	// DefaultMaxQueueSize 定义匹配队列中默认最多的玩家数量
+	DefaultMaxQueueSize uint64 = 100
+)
+
 // DefaultParams 返回默认的模块参数
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		InvitationExpiryBlocks:  DefaultInvitationExpiryBlocks,
+		MatchmakingRatingWindow: DefaultMatchmakingRatingWindow,
+		MatchmakingWindowGrowth: DefaultMatchmakingWindowGrowth,
+		MaxTurnDuration:         DefaultMaxTurnDuration,
+		MaxActiveGames:          DefaultMaxActiveGames,
+		MinWager:                math.OneInt(),
+		RecordMaxLength:         DefaultRecordMaxLength,
+		RecordRetention:         DefaultRecordRetention,
+		RecordMinLength:         DefaultRecordMinLength,
+		MaxQueueSize:            DefaultMaxQueueSize,
+	}
 }
 
 // Validate 对参数进行检查
 func (p Params) Validate() error {
+	if p.InvitationExpiryBlocks == 0 {
+		return fmt.Errorf("invitation expiry blocks must be positive")
+	}
+	// 允许的等级分差不再增长时，等级分相差较大的玩家可能永远无法配对
+	if p.MatchmakingWindowGrowth == 0 {
+		return fmt.Errorf("matchmaking window growth must be positive")
+	}
+	if p.MaxTurnDuration == 0 {
+		return fmt.Errorf("max turn duration must be positive")
+	}
+	if p.MaxActiveGames == 0 {
+		return fmt.Errorf("max active games must be positive")
+	}
+	if p.MaxQueueSize == 0 {
+		return fmt.Errorf("max queue size must be positive")
+	}
+	if p.MinWager.IsNil() || !p.MinWager.IsPositive() {
+		return fmt.Errorf("min wager must be positive")
+	}
+	unique := make(map[string]bool, len(p.AllowedWagerDenoms))
+	for _, denom := range p.AllowedWagerDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("allowed wager denom: %w", err)
+		}
+		if unique[denom] {
+			return fmt.Errorf("duplicate allowed wager denom %s", denom)
+		}
+		unique[denom] = true
+	}
+	if p.RecordMaxLength == 0 {
+		return fmt.Errorf("record max length must be positive")
+	}
+	if p.RecordRetention == 0 {
+		return fmt.Errorf("record retention must be positive")
+	}
+	if p.RecordMinLength > p.RecordMaxLength {
+		return fmt.Errorf("record min length %d is above the max length %d", p.RecordMinLength, p.RecordMaxLength)
+	}
+	if _, err := p.RecordDenylistPatterns(); err != nil {
+		return err
+	}
+	if p.RecordRateLimit > 0 && p.RecordRateWindow == 0 {
+		return fmt.Errorf("record rate window must be positive when the rate limit is set")
+	}
+	if p.HasRecordFee() {
+		if err := p.RecordFee.Validate(); err != nil {
+			return fmt.Errorf("record fee: %w", err)
+		}
+		if _, ok := RecordFeeDestination_name[int32(p.RecordFeeDestination)]; !ok || p.RecordFeeDestination == RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED {
+			return fmt.Errorf("record fee destination %s is invalid", p.RecordFeeDestination)
+		}
+	}
 	return nil
 }
+
+// HasRecordFee 返回添加 record 是否需要支付费用
+func (p Params) HasRecordFee() bool {
+	return p.RecordFee.Denom != "" && !p.RecordFee.Amount.IsNil() && p.RecordFee.Amount.IsPositive()
+}
+
+// RecordDenylistPatterns 编译 RecordDenylist 中的正则表达式
+// RE2 的匹配时间与输入长度成线性关系，不会因为恶意的 record 而耗尽 gas 之外的资源
+func (p Params) RecordDenylistPatterns() ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(p.RecordDenylist))
+	for _, pattern := range p.RecordDenylist {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("record denylist pattern %q: %w", pattern, err)
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
+
+// ValidateRecord 检查 MsgAddRecord 中的 record 是否满足长度和内容的限制
+// denylist 为 RecordDenylistPatterns 编译的正则表达式，由调用者缓存，避免每条 record 都重新编译
+func (p Params) ValidateRecord(text string, denylist []*regexp.Regexp) error {
+	length := uint64(len(text))
+	if length > p.RecordMaxLength {
+		return errors.Wrapf(ErrRecordTooLong, "%d bytes, at most %d", length, p.RecordMaxLength)
+	}
+	if length == 0 || length < p.RecordMinLength {
+		return errors.Wrapf(ErrRecordTooShort, "%d bytes, at least %d", length, max(p.RecordMinLength, 1))
+	}
+	for _, re := range denylist {
+		if re.MatchString(text) {
+			return errors.Wrapf(ErrRecordDenied, "pattern %q", re.String())
+		}
+	}
+	return nil
+}
+
+// ValidateWager 检查赌注是否满足参数的限制，没有赌注时总是满足
+func (p Params) ValidateWager(wager sdk.Coin) error {
+	if !HasWager(wager) {
+		return nil
+	}
+	if len(p.AllowedWagerDenoms) > 0 {
+		allowed := false
+		for _, denom := range p.AllowedWagerDenoms {
+			allowed = allowed || denom == wager.Denom
+		}
+		if !allowed {
+			return errors.Wrapf(ErrInvalidWager, "denom %s is not allowed", wager.Denom)
+		}
+	}
+	if wager.Amount.LT(p.MinWager) {
+		return errors.Wrapf(ErrInvalidWager, "%s is below the minimum %s", wager.Amount, p.MinWager)
+	}
+	return nil
+}
+
+// ValidateTurnDuration 检查每一步棋的时限，返回游戏实际使用的时限
+// 为 0 时表示没有指定时限，使用 MaxTurnDuration
+func (p Params) ValidateTurnDuration(turnDuration uint64) (uint64, error) {
+	if turnDuration == 0 {
+		return p.MaxTurnDuration, nil
+	}
+	if turnDuration > p.MaxTurnDuration {
+		return 0, errors.Wrapf(ErrInvalidTurnDuration, "%d is above the maximum %d", turnDuration, p.MaxTurnDuration)
+	}
+	return turnDuration, nil
+}
@@ -0,0 +1,45 @@
+package proof
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// QueryGame 查询索引为 index 的游戏在 height 时的值及其证明，height 为 0 时查询最新的高度
+// 节点不能为高度 1 及以下的查询生成证明
+func QueryGame(clientCtx client.Context, index string, height int64) (Proof, error) {
+	return queryKey(clientCtx, GameKey(index), height)
+}
+
+// QueryRecord 查询 id 对应的 record 在 height 时的值及其证明，height 为 0 时查询最新的高度
+func QueryRecord(clientCtx client.Context, id uint64, height int64) (Proof, error) {
+	return queryKey(clientCtx, RecordKey(id), height)
+}
+
+// queryKey 通过 ABCI 查询模块存储中的 key，并要求节点返回证明
+func queryKey(clientCtx client.Context, key []byte, height int64) (Proof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", checkers.ModuleName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return Proof{}, err
+	}
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return Proof{}, err
+	}
+	return Proof{
+		Height: res.Height,
+		Key:    key,
+		Value:  res.Value,
+		Proof:  merkleProof,
+	}, nil
+}
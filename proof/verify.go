@@ -0,0 +1,93 @@
+// Package proof 验证模块存储中的游戏和 record 的 ICS-23 merkle 证明
+//
+// 证明通过 ABCI 查询 store/checkers/key 获得（参见 QueryGame 和 QueryRecord），
+// 证明的根为查询高度之后的下一个区块头中的 AppHash
+// 验证只需要一个可信的区块头（例如由轻客户端验证过的区块头），不需要运行全节点
+package proof
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	cmttypes "github.com/cometbft/cometbft/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// Proof 为一个存储键在某一高度的值及其 merkle 证明
+type Proof struct {
+	// Height 为查询的高度，证明需要使用高度为 Height+1 的区块头验证
+	Height int64
+	// Key 为模块存储中的键，不包含模块存储的名称
+	Key []byte
+	// Value 为键对应的值，为空时 Proof 为不存在的证明
+	Value []byte
+	Proof commitmenttypes.MerkleProof
+}
+
+// GameKey 返回索引为 index 的游戏在模块存储中的键，参见 checkers.StoredGamesKey
+func GameKey(index string) []byte {
+	key, err := collections.EncodeKeyWithPrefix(checkers.StoredGamesKey.Bytes(), collections.StringKey, index)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// RecordKey 返回 id 对应的 record 在模块存储中的键，参见 checkers.RecordsKey
+func RecordKey(id uint64) []byte {
+	key, err := collections.EncodeKeyWithPrefix(checkers.RecordsKey.Bytes(), collections.Uint64Key, id)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// Verify 使用可信的区块头验证证明
+// Value 不为空时验证键和值存在于模块存储中，否则验证键不存在
+func Verify(header *cmttypes.Header, proof Proof) error {
+	if header.Height != proof.Height+1 {
+		return fmt.Errorf("proof at height %d must be verified with the header at height %d, got %d", proof.Height, proof.Height+1, header.Height)
+	}
+	root := commitmenttypes.NewMerkleRoot(header.AppHash)
+	path := commitmenttypes.NewMerklePath(checkers.ModuleName, string(proof.Key))
+	if len(proof.Value) == 0 {
+		return proof.Proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+	}
+	return proof.Proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, proof.Value)
+}
+
+// VerifyGame 验证索引为 index 的游戏的证明，返回游戏以及游戏是否存在
+func VerifyGame(header *cmttypes.Header, proof Proof, index string) (checkers.StoredGame, bool, error) {
+	var storedGame checkers.StoredGame
+	found, err := verifyValue(header, proof, GameKey(index), &storedGame)
+	return storedGame, found, err
+}
+
+// VerifyRecord 验证 id 对应的 record 的证明，返回 record 以及 record 是否存在
+// record 自身的 hash 链可以进一步通过 checkers.Record.VerifyHash 验证
+func VerifyRecord(header *cmttypes.Header, proof Proof, id uint64) (checkers.Record, bool, error) {
+	var record checkers.Record
+	found, err := verifyValue(header, proof, RecordKey(id), &record)
+	return record, found, err
+}
+
+// verifyValue 检查证明的键为 key 并验证证明，值存在时将其解码到 value 中
+// 模块通过 codec.CollValue 保存值，即值的 protobuf 编码
+func verifyValue(header *cmttypes.Header, proof Proof, key []byte, value interface{ Unmarshal([]byte) error }) (bool, error) {
+	if !bytes.Equal(proof.Key, key) {
+		return false, fmt.Errorf("proof is for key %X, expected %X", proof.Key, key)
+	}
+	if err := Verify(header, proof); err != nil {
+		return false, err
+	}
+	if len(proof.Value) == 0 {
+		return false, nil
+	}
+	if err := value.Unmarshal(proof.Value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,29 @@
+package checkers
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate 验证匹配队列中的玩家
+func (entry *QueueEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(entry.Player); err != nil {
+		return errors.Wrapf(ErrInvalidQueueEntry, "player %s: %s", entry.Player, err)
+	}
+	return validateWager(entry.Wager)
+}
+
+// HasWager 返回玩家是否设置了赌注
+func (entry *QueueEntry) HasWager() bool {
+	return HasWager(entry.Wager)
+}
+
+// RatingWindow 返回玩家在 height 时允许的最大等级分差
+// 初始为 params.MatchmakingRatingWindow，每等待一个区块增加 params.MatchmakingWindowGrowth
+func (entry *QueueEntry) RatingWindow(params Params, height int64) uint64 {
+	waited := uint64(0)
+	if height > entry.JoinedHeight {
+		waited = uint64(height - entry.JoinedHeight)
+	}
+	return params.MatchmakingRatingWindow + params.MatchmakingWindowGrowth*waited
+}
@@ -0,0 +1,61 @@
+package checkers
+
+// DefaultRating 定义新玩家的初始 Elo 等级分
+const DefaultRating uint64 = 1200
+
+// RatingKFactor 定义 Elo 等级分每局变化的最大幅度
+const RatingKFactor = 32
+
+// expectedScoreTable 为 FIDE 的等级分差与期望得分对照表
+// 第 i 项为期望得分为 (51+i)% 时，等级分差的上限（含）
+// 使用整数查表而非浮点运算，确保所有验证节点计算结果一致
+var expectedScoreTable = []uint64{
+	10, 17, 25, 32, 39, 46, 53, 61, 68, 76,
+	83, 91, 98, 106, 113, 121, 129, 137, 145, 153,
+	162, 170, 179, 188, 197, 206, 215, 225, 235, 245,
+	256, 267, 278, 290, 302, 315, 328, 344, 357, 374,
+	391, 411, 432, 456, 484, 517, 559, 619, 735,
+}
+
+// expectedScore 返回等级分为 rating 的玩家对阵等级分为 opponentRating 的玩家时的期望得分，单位为百分比
+func expectedScore(rating, opponentRating uint64) int64 {
+	higher, lower := rating, opponentRating
+	if higher < lower {
+		higher, lower = lower, higher
+	}
+	diff := higher - lower
+
+	score := int64(50)
+	if diff > 3 {
+		score = 100
+		for i, limit := range expectedScoreTable {
+			if diff <= limit {
+				score = int64(51 + i)
+				break
+			}
+		}
+	}
+	if rating < opponentRating {
+		return 100 - score
+	}
+	return score
+}
+
+// UpdateRatings 根据一局游戏的结果返回双方新的等级分
+// draw 为 true 时表示和棋，否则 winnerRating 对应的一方获胜
+func UpdateRatings(winnerRating, loserRating uint64, draw bool) (newWinnerRating, newLoserRating uint64) {
+	winnerScore := int64(100)
+	if draw {
+		winnerScore = 50
+	}
+	delta := RatingKFactor * (winnerScore - expectedScore(winnerRating, loserRating)) / 100
+	return addRating(winnerRating, delta), addRating(loserRating, -delta)
+}
+
+// addRating 将 delta 加到 rating 上，等级分不会低于 0
+func addRating(rating uint64, delta int64) uint64 {
+	if delta < 0 && uint64(-delta) > rating {
+		return 0
+	}
+	return uint64(int64(rating) + delta)
+}
@@ -0,0 +1,190 @@
+package checkers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// MaxRecordTags 定义一条 record 最多的标签数量
+	MaxRecordTags = 8
+	// MaxRecordTagLength 定义 record 标签的最大字节数
+	MaxRecordTagLength = 32
+	// MaxVerifyRecordRange 定义 Query/VerifyRecordRange 一次最多验证的 record 数量
+	MaxVerifyRecordRange = 1000
+	// DefaultPacketTimeoutHeightDelta 定义 MsgSendRecord 默认的超时高度，为对方链最新高度之后的区块数
+	DefaultPacketTimeoutHeightDelta = 1000
+	// DefaultPacketTimeoutDuration 定义 MsgSendRecord 默认的超时时间，从当前区块时间开始计算
+	DefaultPacketTimeoutDuration = 10 * time.Minute
+)
+
+// Validate 验证 record，内容不能为空
+// 长度等内容限制只在 MsgAddRecord 中通过 Params.ValidateRecord 检查：模块自己添加的 record
+// 不受参数限制，参数修改后已经保存的 record 也不需要满足新的限制
+func (record *Record) Validate() error {
+	if _, ok := RecordSource_name[int32(record.Source)]; !ok || record.Source == RecordSource_RECORD_SOURCE_UNSPECIFIED {
+		return errors.Wrapf(ErrInvalidRecord, "source %s", record.Source)
+	}
+	if record.Height < 0 {
+		return errors.Wrapf(ErrInvalidRecord, "height %d", record.Height)
+	}
+	if record.Text == "" {
+		return errors.Wrapf(ErrInvalidRecord, "empty text")
+	}
+	if record.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(record.Creator); err != nil {
+			return errors.Wrapf(ErrInvalidRecord, "creator %s: %s", record.Creator, err)
+		}
+	}
+	return ValidateRecordTags(record.Tags)
+}
+
+// ValidateRecordTags 验证 record 的标签，标签不能为空、不能重复，且数量和长度有上限
+func ValidateRecordTags(tags []string) error {
+	if len(tags) > MaxRecordTags {
+		return errors.Wrapf(ErrInvalidRecord, "%d tags, at most %d", len(tags), MaxRecordTags)
+	}
+	unique := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if tag == "" || len(tag) > MaxRecordTagLength {
+			return errors.Wrapf(ErrInvalidRecord, "tag %q must have between 1 and %d bytes", tag, MaxRecordTagLength)
+		}
+		if unique[tag] {
+			return errors.Wrapf(ErrInvalidRecord, "duplicate tag %q", tag)
+		}
+		unique[tag] = true
+	}
+	return nil
+}
+
+// ComputeHash 计算 record 的 hash，即清空 hash 字段后 record 的 protobuf 编码的 SHA-256
+// gogoproto 生成的编码按字段编号依次写入且不包含 map，对相同的 record 总是相同
+func (record Record) ComputeHash() []byte {
+	record.Hash = nil
+	bz, err := record.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// VerifyHash 检查 record 的 hash 与内容一致，且 prev_hash 与前一条 record 的 hash 一致
+func (record Record) VerifyHash(prevHash []byte) error {
+	if !bytes.Equal(record.PrevHash, prevHash) {
+		return errors.Wrapf(ErrRecordChainBroken, "record %d: prev hash %X, expected %X", record.Id, record.PrevHash, prevHash)
+	}
+	if hash := record.ComputeHash(); !bytes.Equal(record.Hash, hash) {
+		return errors.Wrapf(ErrRecordChainBroken, "record %d: hash %X, computed %X", record.Id, record.Hash, hash)
+	}
+	return nil
+}
+
+// VerifyRecordChain 验证按 id 升序排列的 record 链，以及链的头部
+//   - 每条 record 的 hash 都与内容一致
+//   - id 相邻的两条 record 通过 prev_hash 相连，被删除的 record 两侧的链接无法验证
+//   - head 不为空时，最后一条 record 的 id 不能超过 head，id 与 head 相同的 record 的 hash 与 head 一致
+//
+// 返回第一条验证失败的 record 的 id 和错误
+func VerifyRecordChain(records []Record, head *RecordLogHead) (uint64, error) {
+	if len(records) > 0 && head == nil {
+		return records[0].Id, errors.Wrapf(ErrRecordChainBroken, "records without a head")
+	}
+	for i, record := range records {
+		if i > 0 && record.Id <= records[i-1].Id {
+			return record.Id, errors.Wrapf(ErrRecordChainBroken, "record %d is not ordered after %d", record.Id, records[i-1].Id)
+		}
+		if i > 0 && record.Id == records[i-1].Id+1 {
+			if err := record.VerifyHash(records[i-1].Hash); err != nil {
+				return record.Id, err
+			}
+		} else if hash := record.ComputeHash(); !bytes.Equal(record.Hash, hash) {
+			return record.Id, errors.Wrapf(ErrRecordChainBroken, "record %d: hash %X, computed %X", record.Id, record.Hash, hash)
+		}
+		if record.Id > head.Id {
+			return record.Id, errors.Wrapf(ErrRecordChainBroken, "record %d is after the head %d", record.Id, head.Id)
+		}
+		if record.Id == head.Id && !bytes.Equal(record.Hash, head.Hash) {
+			return record.Id, errors.Wrapf(ErrRecordChainBroken, "record %d: hash %X, head %X", record.Id, record.Hash, head.Hash)
+		}
+	}
+	return 0, nil
+}
+
+// ValidateRemoteSender 检查对方链上的发送者为 bech32 地址，地址前缀由对方链决定，不做检查
+func ValidateRemoteSender(sender string) error {
+	if _, _, err := bech32.DecodeAndConvert(sender); err != nil {
+		return errors.Wrapf(ErrInvalidRemoteRecord, "sender %q: %s", sender, err)
+	}
+	return nil
+}
+
+// Validate 验证通过 IBC 收到的 record，内容不能为空
+// 与 Record.Validate 一样，内容限制只在收到数据包时通过 Params.ValidateRecord 检查
+func (remoteRecord *RemoteRecord) Validate() error {
+	if err := host.ChannelIdentifierValidator(remoteRecord.Channel); err != nil {
+		return errors.Wrapf(ErrInvalidRemoteRecord, "channel: %s", err)
+	}
+	if err := host.PortIdentifierValidator(remoteRecord.SourcePort); err != nil {
+		return errors.Wrapf(ErrInvalidRemoteRecord, "source port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(remoteRecord.SourceChannel); err != nil {
+		return errors.Wrapf(ErrInvalidRemoteRecord, "source channel: %s", err)
+	}
+	if err := ValidateRemoteSender(remoteRecord.Sender); err != nil {
+		return err
+	}
+	if remoteRecord.RecordData == "" {
+		return errors.Wrapf(ErrInvalidRemoteRecord, "empty record data")
+	}
+	return nil
+}
+
+// Validate 验证本链发送的数据包，数据包的 data 必须能够解析，托管的费用只能来自 record 数据包
+func (packet *OutgoingPacket) Validate() error {
+	if err := host.PortIdentifierValidator(packet.Port); err != nil {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(packet.Channel); err != nil {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "channel: %s", err)
+	}
+	if packet.Sequence == 0 {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "sequence must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(packet.Sender); err != nil {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "sender %s: %s", packet.Sender, err)
+	}
+	if _, ok := OutgoingPacketStatus_name[int32(packet.Status)]; !ok || packet.Status == OutgoingPacketStatus_OUTGOING_PACKET_STATUS_UNSPECIFIED {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "status %s", packet.Status)
+	}
+	if (packet.Status == OutgoingPacketStatus_OUTGOING_PACKET_STATUS_PENDING) != (packet.ResolvedHeight == 0) {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "resolved height %d with status %s", packet.ResolvedHeight, packet.Status)
+	}
+	packetData, err := UnmarshalCheckersPacketData(packet.Data)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "data: %s", err)
+	}
+	if packet.RetrySequence != 0 && packet.Status != OutgoingPacketStatus_OUTGOING_PACKET_STATUS_TIMED_OUT {
+		return errors.Wrapf(ErrInvalidOutgoingPacket, "retry sequence %d with status %s", packet.RetrySequence, packet.Status)
+	}
+	if HasWager(packet.EscrowedFee) {
+		if err := packet.EscrowedFee.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidOutgoingPacket, "escrowed fee: %s", err)
+		}
+		if _, ok := packetData.Packet.(*CheckersPacketData_RecordPacket); !ok {
+			return errors.Wrapf(ErrInvalidOutgoingPacket, "escrowed fee with packet type %T", packetData.Packet)
+		}
+		switch packet.FeeDestination {
+		case RecordFeeDestination_RECORD_FEE_DESTINATION_BURN, RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL:
+		default:
+			return errors.Wrapf(ErrInvalidOutgoingPacket, "fee destination %s", packet.FeeDestination)
+		}
+	}
+	return nil
+}
@@ -263,6 +263,10 @@ func (game *Game) playerHasMove(player Player) bool {
 	return false
 }
 
+func (game *Game) PlayerHasMove(player Player) bool {
+	return game.playerHasMove(player)
+}
+
 func (game *Game) playerHasJump(player Player) bool {
 	for loc, piece := range game.Pieces {
 		if piece.Player == player && game.jumpPossibleFrom(loc) {
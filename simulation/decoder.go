@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/buzzing/checkers"
+)
+
+// NewDecodeStore 返回一个解码函数，将模块存储中的两个键值对解码为可读的字符串
+// 模拟测试在导出再导入状态后比较两个存储，发现不一致时使用它打印差异
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, checkers.StoredGamesKey.Bytes()):
+			var gameA, gameB checkers.StoredGame
+			cdc.MustUnmarshal(kvA.Value, &gameA)
+			cdc.MustUnmarshal(kvB.Value, &gameB)
+			indexA := string(kvA.Key[len(checkers.StoredGamesKey.Bytes()):])
+			indexB := string(kvB.Key[len(checkers.StoredGamesKey.Bytes()):])
+			return fmt.Sprintf("%s: %v\n%s: %v", indexA, gameA, indexB, gameB)
+
+		case bytes.HasPrefix(kvA.Key, checkers.RecordsKey.Bytes()):
+			// record 的键 (区块高度, id) 也保存在值中
+			var recordA, recordB checkers.Record
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", checkers.ModuleName, kvA.Key))
+		}
+	}
+}
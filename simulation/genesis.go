@@ -0,0 +1,174 @@
+// Package simulation 模块的模拟测试支持
+// 为应用的模拟测试（simulation）和模糊测试提供随机的创世状态、随机的交易操作和存储解码器
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/rules"
+)
+
+const (
+	// genesisGameIndexPrefix 为随机创世状态中游戏索引的前缀
+	genesisGameIndexPrefix = "sim-genesis"
+	// maxGenesisMoves 为随机创世状态中一局已经结束的游戏最多走的步数
+	maxGenesisMoves = 500
+)
+
+// RandomizedGenState 生成随机的创世状态，包括随机的游戏、玩家统计和 record
+// 游戏中的棋盘由规则引擎从初始棋盘随机走棋得到，因此都是可以继续进行的合法棋局
+// 创世状态中的游戏都没有赌注，避免模块账户需要持有对应的托管余额
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	params := checkers.DefaultParams()
+
+	var games []checkers.IndexedStoredGame
+	if len(simState.Accounts) > 0 {
+		for i, n := 0, r.Intn(20); i < n; i++ {
+			black, _ := simtypes.RandomAcc(r, simState.Accounts)
+			red, _ := simtypes.RandomAcc(r, simState.Accounts)
+			games = append(games, checkers.IndexedStoredGame{
+				Index:      fmt.Sprintf("%s-%d", genesisGameIndexPrefix, i),
+				StoredGame: randomStoredGame(r, params, black.Address.String(), red.Address.String()),
+			})
+		}
+	}
+
+	var playerInfos []checkers.IndexedPlayerInfo
+	for _, account := range simState.Accounts {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		playerInfos = append(playerInfos, checkers.IndexedPlayerInfo{
+			Player: account.Address.String(),
+			PlayerInfo: checkers.PlayerInfo{
+				WonCount:   uint64(r.Intn(50)),
+				LostCount:  uint64(r.Intn(50)),
+				DrawnCount: uint64(r.Intn(10)),
+				Rating:     uint64(simtypes.RandIntBetween(r, 800, 1600)),
+			},
+		})
+	}
+
+	// 创世状态中的 record 位于高度 0，时间为创世时间，作者为随机的账户，依次连接成链
+	records := make([]checkers.Record, r.Intn(5))
+	var recordLogHead *checkers.RecordLogHead
+	for i := range records {
+		creator, _ := simtypes.RandomAcc(r, simState.Accounts)
+		records[i] = checkers.Record{
+			Id:      uint64(i),
+			Time:    simState.GenTimestamp,
+			Source:  checkers.RecordSource_RECORD_SOURCE_TX,
+			Text:    randomRecord(r, params),
+			Creator: creator.Address.String(),
+			Tags:    randomRecordTags(r),
+		}
+		if i > 0 {
+			records[i].PrevHash = records[i-1].Hash
+		}
+		records[i].Hash = records[i].ComputeHash()
+		recordLogHead = &checkers.RecordLogHead{Id: records[i].Id, Hash: records[i].Hash}
+	}
+
+	genesis := checkers.GenesisState{
+		Params:                params,
+		IndexedStoredGameList: games,
+		IndexedPlayerInfoList: playerInfos,
+		Records:               records,
+		RecordCount:           uint64(len(records)),
+		RecordLogHead:         recordLogHead,
+	}
+	if err := genesis.Validate(); err != nil {
+		panic(fmt.Errorf("invalid randomized %s genesis state: %w", checkers.ModuleName, err))
+	}
+	simState.GenState[checkers.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+// randomStoredGame 生成一局随机的游戏，可能是尚未被接受的邀请、正在进行的游戏或已经结束的游戏
+// 正在进行的游戏从初始棋盘随机走若干步，已经结束的游戏一直走到一方获胜
+func randomStoredGame(r *rand.Rand, params checkers.Params, black, red string) checkers.StoredGame {
+	game := rules.New()
+	storedGame := checkers.StoredGame{
+		Black:        black,
+		Red:          red,
+		Winner:       rules.PieceStrings[rules.NO_PLAYER],
+		TurnDuration: params.MaxTurnDuration,
+	}
+
+	switch r.Intn(3) {
+	case 0:
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_PENDING
+		storedGame.InvitationDeadline = int64(params.InvitationExpiryBlocks)
+	case 1:
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_ACTIVE
+		storedGame.BlackAccepted = true
+		storedGame.RedAccepted = true
+		for i, n := 0, r.Intn(40); i < n && game.Winner() == rules.NO_PLAYER; i++ {
+			if !playRandomMove(r, game) {
+				break
+			}
+			storedGame.MoveCount++
+		}
+		if game.Winner() == rules.NO_PLAYER && game.PlayerHasMove(game.Turn) {
+			// 创世时的区块高度为 0，deadline 即为每一步棋的时限
+			storedGame.Deadline = int64(storedGame.TurnDuration)
+		} else {
+			// 一方获胜，或者轮到的一方无棋可走时按和棋结束
+			storedGame.Status = checkers.GameStatus_GAME_STATUS_FINISHED
+		}
+	default:
+		storedGame.Status = checkers.GameStatus_GAME_STATUS_FINISHED
+		storedGame.BlackAccepted = true
+		storedGame.RedAccepted = true
+		// 双方都只剩王棋时可能永远分不出胜负，超过步数上限时按和棋结束
+		for i := 0; i < maxGenesisMoves && game.Winner() == rules.NO_PLAYER && playRandomMove(r, game); i++ {
+			storedGame.MoveCount++
+		}
+	}
+
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	if storedGame.Status == checkers.GameStatus_GAME_STATUS_FINISHED {
+		storedGame.Winner = rules.PieceStrings[game.Winner()]
+	}
+	return storedGame
+}
+
+// playRandomMove 在 game 中随机走一步合法的棋，没有合法的走法时返回 false
+func playRandomMove(r *rand.Rand, game *rules.Game) bool {
+	next, ok := randomMove(r, game)
+	if !ok {
+		return false
+	}
+	if _, err := game.Move(next.from, next.to); err != nil {
+		panic(err)
+	}
+	return true
+}
+
+// randomRecord 生成一条随机的、可以被 MsgAddRecord 接受的 record
+func randomRecord(r *rand.Rand, params checkers.Params) string {
+	// 参数已经通过验证，正则表达式可以编译
+	denylist, _ := params.RecordDenylistPatterns()
+	for {
+		record := simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, int(max(params.RecordMinLength, 1)), int(params.RecordMaxLength)+1))
+		// 长度或内容不满足参数限制的 record 会被拒绝，参见 Params.ValidateRecord
+		if params.ValidateRecord(record, denylist) == nil {
+			return record
+		}
+	}
+}
+
+// randomRecordTags 生成 0 到 3 个不重复的随机标签
+func randomRecordTags(r *rand.Rand) []string {
+	var tags []string
+	for i := r.Intn(4); i > 0; i-- {
+		tags = append(tags, fmt.Sprintf("tag-%d-%s", i, simtypes.RandStringOfLength(r, 4)))
+	}
+	return tags
+}
@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/buzzing/checkers/rules"
+)
+
+// move 为一步棋的起点和终点
+type move struct {
+	from rules.Pos
+	to   rules.Pos
+}
+
+// moveOffsets 为一步棋可能的位移，包括斜走一格和斜跳两格
+var moveOffsets = []rules.Pos{
+	{X: -1, Y: -1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: 1, Y: 1},
+	{X: -2, Y: -2}, {X: 2, Y: -2}, {X: -2, Y: 2}, {X: 2, Y: 2},
+}
+
+// legalMoves 返回当前回合一方所有合法的走法
+// rules.Game 的棋子保存在 map 中，遍历顺序不确定，因此按棋盘坐标枚举，保证同一个随机种子得到相同的结果
+func legalMoves(game *rules.Game) []move {
+	var moves []move
+	for y := 0; y < rules.BOARD_DIM; y++ {
+		for x := 0; x < rules.BOARD_DIM; x++ {
+			from := rules.Pos{X: x, Y: y}
+			piece, found := game.Pieces[from]
+			if !found || !game.TurnIs(piece.Player) {
+				continue
+			}
+			for _, offset := range moveOffsets {
+				to := rules.Pos{X: x + offset.X, Y: y + offset.Y}
+				if game.ValidMove(from, to) {
+					moves = append(moves, move{from: from, to: to})
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// randomMove 随机选择一步合法的走法，没有合法的走法时返回 false
+func randomMove(r *rand.Rand, game *rules.Game) (move, bool) {
+	moves := legalMoves(game)
+	if len(moves) == 0 {
+		return move{}, false
+	}
+	return moves[r.Intn(len(moves))], true
+}
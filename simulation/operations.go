@@ -0,0 +1,320 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+)
+
+// 模拟测试中各个操作的权重，可以在模拟测试的参数文件中通过对应的键覆盖
+const (
+	OpWeightMsgCreateGame   = "op_weight_msg_create_game"
+	OpWeightMsgAcceptGame   = "op_weight_msg_accept_game"
+	OpWeightMsgPlayMove     = "op_weight_msg_play_move"
+	OpWeightMsgResign       = "op_weight_msg_resign"
+	OpWeightMsgAddRecord    = "op_weight_msg_add_record"
+	OpWeightMsgDeleteRecord = "op_weight_msg_delete_record"
+
+	DefaultWeightMsgCreateGame   = 30
+	DefaultWeightMsgAcceptGame   = 30
+	DefaultWeightMsgPlayMove     = 100
+	DefaultWeightMsgResign       = 5
+	DefaultWeightMsgAddRecord    = 20
+	DefaultWeightMsgDeleteRecord = 5
+)
+
+// simGameIndexLength 为模拟测试中创建的游戏索引的随机部分的长度
+const simGameIndexLength = 12
+
+// WeightedOperations 返回模块所有的模拟操作及其权重
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak checkers.AccountKeeper,
+	bk checkers.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightCreateGame, weightAcceptGame, weightPlayMove, weightResign, weightAddRecord, weightDeleteRecord int
+	appParams.GetOrGenerate(OpWeightMsgCreateGame, &weightCreateGame, nil, func(_ *rand.Rand) {
+		weightCreateGame = DefaultWeightMsgCreateGame
+	})
+	appParams.GetOrGenerate(OpWeightMsgAcceptGame, &weightAcceptGame, nil, func(_ *rand.Rand) {
+		weightAcceptGame = DefaultWeightMsgAcceptGame
+	})
+	appParams.GetOrGenerate(OpWeightMsgPlayMove, &weightPlayMove, nil, func(_ *rand.Rand) {
+		weightPlayMove = DefaultWeightMsgPlayMove
+	})
+	appParams.GetOrGenerate(OpWeightMsgResign, &weightResign, nil, func(_ *rand.Rand) {
+		weightResign = DefaultWeightMsgResign
+	})
+	appParams.GetOrGenerate(OpWeightMsgAddRecord, &weightAddRecord, nil, func(_ *rand.Rand) {
+		weightAddRecord = DefaultWeightMsgAddRecord
+	})
+	appParams.GetOrGenerate(OpWeightMsgDeleteRecord, &weightDeleteRecord, nil, func(_ *rand.Rand) {
+		weightDeleteRecord = DefaultWeightMsgDeleteRecord
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightCreateGame, SimulateMsgCreateGame(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightAcceptGame, SimulateMsgAcceptGame(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightPlayMove, SimulateMsgPlayMove(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightResign, SimulateMsgResign(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightAddRecord, SimulateMsgAddRecord(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightDeleteRecord, SimulateMsgDeleteRecord(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateGame 随机选择两位玩家创建一局没有赌注的游戏，创建者为黑方
+// 黑方和红方为同一账户时，游戏创建后立即开始
+func SimulateMsgCreateGame(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgCreateGame{})
+		black, _ := simtypes.RandomAcc(r, accs)
+		red, _ := simtypes.RandomAcc(r, accs)
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		// 创建者执黑，创建即接受，红方在接受时才检查
+		active, err := k.ActiveGameCount(ctx, black.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to count active games"), nil, err
+		}
+		if active >= params.MaxActiveGames {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "too many active games"), nil, nil
+		}
+
+		index := fmt.Sprintf("sim-%s", simtypes.RandStringOfLength(r, simGameIndexLength))
+		found, err := k.StoredGames.Has(ctx, index)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to check game index"), nil, err
+		}
+		if found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "game index already used"), nil, nil
+		}
+
+		msg := &checkers.MsgCreateGame{
+			Creator: black.Address.String(),
+			Index:   index,
+			Black:   black.Address.String(),
+			Red:     red.Address.String(),
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, black, msg)
+	}
+}
+
+// SimulateMsgAcceptGame 随机选择一局等待接受的游戏，由尚未接受的一方接受邀请
+func SimulateMsgAcceptGame(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgAcceptGame{})
+		index, storedGame, found, err := randomGame(r, ctx, k, checkers.GameStatus_GAME_STATUS_PENDING)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get pending games"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "no pending games"), nil, nil
+		}
+
+		player := storedGame.Red
+		if !storedGame.BlackAccepted {
+			player = storedGame.Black
+		}
+		account, found := findAccount(accs, player)
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "player is not a simulation account"), nil, nil
+		}
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		active, err := k.ActiveGameCount(ctx, player)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to count active games"), nil, err
+		}
+		if active >= params.MaxActiveGames {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "too many active games"), nil, nil
+		}
+
+		msg := &checkers.MsgAcceptGame{
+			Creator:   player,
+			GameIndex: index,
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, account, msg)
+	}
+}
+
+// SimulateMsgPlayMove 随机选择一局正在进行的游戏，由当前回合的一方随机走一步合法的棋
+// 合法的走法由规则引擎枚举，因此每一步都应当被接受
+func SimulateMsgPlayMove(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgPlayMove{})
+		index, storedGame, found, err := randomGame(r, ctx, k, checkers.GameStatus_GAME_STATUS_ACTIVE)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get active games"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "no active games"), nil, nil
+		}
+
+		player, _ := storedGame.PlayerAddress(storedGame.Turn)
+		account, found := findAccount(accs, player)
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "player is not a simulation account"), nil, nil
+		}
+		game, err := storedGame.ParseGame()
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to parse game"), nil, err
+		}
+		next, found := randomMove(r, game)
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "no legal moves"), nil, nil
+		}
+
+		msg := &checkers.MsgPlayMove{
+			Creator:   player,
+			GameIndex: index,
+			FromX:     uint64(next.from.X),
+			FromY:     uint64(next.from.Y),
+			ToX:       uint64(next.to.X),
+			ToY:       uint64(next.to.Y),
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, account, msg)
+	}
+}
+
+// SimulateMsgResign 随机选择一局正在进行的游戏，由随机的一方认输
+func SimulateMsgResign(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgResign{})
+		index, storedGame, found, err := randomGame(r, ctx, k, checkers.GameStatus_GAME_STATUS_ACTIVE)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get active games"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "no active games"), nil, nil
+		}
+
+		player := storedGame.Black
+		if r.Intn(2) == 0 {
+			player = storedGame.Red
+		}
+		account, found := findAccount(accs, player)
+		if !found {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "player is not a simulation account"), nil, nil
+		}
+
+		msg := &checkers.MsgResign{
+			Creator:   player,
+			GameIndex: index,
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, account, msg)
+	}
+}
+
+// SimulateMsgAddRecord 由随机的账户添加一条随机的 record
+func SimulateMsgAddRecord(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgAddRecord{})
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		account, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &checkers.MsgAddRecord{
+			Creator: account.Address.String(),
+			Value:   randomRecord(r, params),
+			Tags:    randomRecordTags(r),
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, account, msg)
+	}
+}
+
+// SimulateMsgDeleteRecord 由随机的账户删除一条自己添加的 record
+func SimulateMsgDeleteRecord(txGen client.TxConfig, ak checkers.AccountKeeper, bk checkers.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&checkers.MsgDeleteRecord{})
+		account, _ := simtypes.RandomAcc(r, accs)
+
+		iter, err := k.Records.Indexes.Author.MatchExact(ctx, account.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to find records"), nil, err
+		}
+		ids, err := iter.PrimaryKeys()
+		if err != nil {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "unable to find records"), nil, err
+		}
+		if len(ids) == 0 {
+			return simtypes.NoOpMsg(checkers.ModuleName, msgType, "no record of the account"), nil, nil
+		}
+
+		msg := &checkers.MsgDeleteRecord{
+			Creator: account.Address.String(),
+			Id:      ids[r.Intn(len(ids))],
+		}
+		return deliver(r, app, ctx, txGen, ak, bk, account, msg)
+	}
+}
+
+// randomGame 从处于 status 状态的游戏中随机选择一局，没有这样的游戏时返回 false
+func randomGame(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, status checkers.GameStatus) (string, checkers.StoredGame, bool, error) {
+	iter, err := k.StoredGames.Indexes.Status.MatchExact(ctx, int32(status))
+	if err != nil {
+		return "", checkers.StoredGame{}, false, err
+	}
+	indexes, err := iter.PrimaryKeys()
+	if err != nil || len(indexes) == 0 {
+		return "", checkers.StoredGame{}, false, err
+	}
+	index := indexes[r.Intn(len(indexes))]
+	storedGame, err := k.GetGame(ctx, index)
+	return index, storedGame, err == nil, err
+}
+
+// findAccount 返回地址为 address 的模拟账户
+func findAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// deliver 由 account 签名并发送只包含 msg 的交易，手续费随机生成
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txGen client.TxConfig,
+	ak checkers.AccountKeeper, bk checkers.BankKeeper, account simtypes.Account, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         txGen,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    account,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    checkers.ModuleName,
+	})
+}
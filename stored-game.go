@@ -35,14 +35,209 @@ func (storedGame *StoredGame) ParseGame() (game *rules.Game, err error) {
 
 // Validate 验证游戏对局
 func (storedGame *StoredGame) Validate() (err error) {
-	_, err = storedGame.GetBlackAddress()
-	if err != nil {
+	if err = storedGame.ValidateStatus(); err != nil {
 		return err
 	}
-	_, err = storedGame.GetRedAddress()
-	if err != nil {
+	if err = storedGame.ValidatePlayers(); err != nil {
+		return err
+	}
+	if err = storedGame.ValidateWager(); err != nil {
+		return err
+	}
+	if err = storedGame.ValidateWinner(); err != nil {
+		return err
+	}
+	if err = storedGame.ValidateDrawOffer(); err != nil {
 		return err
 	}
 	_, err = storedGame.ParseGame()
 	return err
 }
+
+// ValidateStatus 验证游戏对局的状态是否为已定义的状态
+func (storedGame *StoredGame) ValidateStatus() error {
+	if _, ok := GameStatus_name[int32(storedGame.Status)]; !ok || storedGame.Status == GameStatus_GAME_STATUS_UNSPECIFIED {
+		return errors.Wrapf(ErrInvalidGameStatus, "%s", storedGame.Status)
+	}
+	return nil
+}
+
+// ValidatePlayers 验证黑方和红方的地址
+// 大厅中的游戏（GAME_STATUS_OPEN）恰好有一方为空，其余状态的游戏双方都必须是有效地址
+func (storedGame *StoredGame) ValidatePlayers() (err error) {
+	if storedGame.Status == GameStatus_GAME_STATUS_OPEN {
+		switch {
+		case storedGame.Black == "" && storedGame.Red != "":
+			_, err = storedGame.GetRedAddress()
+		case storedGame.Red == "" && storedGame.Black != "":
+			_, err = storedGame.GetBlackAddress()
+		default:
+			err = errors.Wrapf(ErrInvalidGameStatus, "open game must have exactly one empty seat")
+		}
+		return err
+	}
+	if _, err = storedGame.GetBlackAddress(); err != nil {
+		return err
+	}
+	_, err = storedGame.GetRedAddress()
+	return err
+}
+
+// ValidateWager 验证赌注，denom 为空时表示没有赌注，此时数量必须为 0
+func (storedGame *StoredGame) ValidateWager() error {
+	return validateWager(storedGame.Wager)
+}
+
+// HasWager 返回游戏是否设置了赌注
+func (storedGame *StoredGame) HasWager() bool {
+	return HasWager(storedGame.Wager)
+}
+
+// validateWager 验证赌注，denom 为空时表示没有赌注，此时数量必须为 0
+func validateWager(wager sdk.Coin) error {
+	if wager.Denom == "" {
+		if !wager.Amount.IsNil() && !wager.Amount.IsZero() {
+			return errors.Wrapf(ErrInvalidWager, "amount %s without denom", wager.Amount)
+		}
+		return nil
+	}
+	if err := wager.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	return nil
+}
+
+// HasWager 返回 wager 是否为有效的、数量为正的赌注
+func HasWager(wager sdk.Coin) bool {
+	return wager.Denom != "" && !wager.Amount.IsNil() && wager.Amount.IsPositive()
+}
+
+// SameWager 返回两份赌注是否相同，没有赌注的两方视为相同
+func SameWager(a, b sdk.Coin) bool {
+	if !HasWager(a) || !HasWager(b) {
+		return !HasWager(a) && !HasWager(b)
+	}
+	return a.IsEqual(b)
+}
+
+// IsAccepted 返回黑方和红方是否都已经接受了游戏邀请
+func (storedGame *StoredGame) IsAccepted() bool {
+	return storedGame.BlackAccepted && storedGame.RedAccepted
+}
+
+// ValidateWinner 验证获胜方的取值，只有已经结束的游戏才可能有获胜方
+func (storedGame *StoredGame) ValidateWinner() error {
+	switch storedGame.Winner {
+	case rules.PieceStrings[rules.NO_PLAYER]:
+		return nil
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+		if storedGame.Status == GameStatus_GAME_STATUS_FINISHED {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidWinner, "%s in status %s", storedGame.Winner, storedGame.Status)
+}
+
+// ValidateDrawOffer 验证和棋提议，只有正在进行的游戏才可能有待处理的和棋提议
+func (storedGame *StoredGame) ValidateDrawOffer() error {
+	switch storedGame.DrawOffer {
+	case "":
+		return nil
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+		if storedGame.Status == GameStatus_GAME_STATUS_ACTIVE {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidDrawOffer, "%s in status %s", storedGame.DrawOffer, storedGame.Status)
+}
+
+// PlayerAddress 返回执 color 颜色棋子的玩家地址
+func (storedGame *StoredGame) PlayerAddress(color string) (address string, found bool) {
+	switch color {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		return storedGame.Black, true
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		return storedGame.Red, true
+	}
+	return "", false
+}
+
+// OpponentColor 返回 color 颜色的对手的颜色
+func OpponentColor(color string) string {
+	return rules.PieceStrings[rules.Opponents[rules.StringPieces[color].Player]]
+}
+
+// MoveWinner 返回走棋之后的获胜方，一方的棋子被吃光，或者轮到走棋的一方无棋可走时，对方获胜
+// 游戏还没有结束时返回 rules.NO_PLAYER
+func MoveWinner(game *rules.Game) rules.Player {
+	if winner := game.Winner(); winner != rules.NO_PLAYER {
+		return winner
+	}
+	if !game.PlayerHasMove(game.Turn) {
+		return rules.Opponents[game.Turn]
+	}
+	return rules.NO_PLAYER
+}
+
+// PlayerColor 返回 address 在游戏对局中执的棋子颜色（参见 rules.PieceStrings）
+// 如果 address 不是该对局的玩家，返回 false
+// 当 address 同时执黑和执红时，返回当前回合的颜色
+func (storedGame *StoredGame) PlayerColor(address string) (color string, found bool) {
+	isBlack := storedGame.Black == address
+	isRed := storedGame.Red == address
+	switch {
+	case isBlack && isRed:
+		return storedGame.Turn, true
+	case isBlack:
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	case isRed:
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return "", false
+}
+
+// IsAwaitingMove 返回游戏对局是否正在进行且轮到 address 走棋
+func (storedGame *StoredGame) IsAwaitingMove(address string) bool {
+	if storedGame.Status != GameStatus_GAME_STATUS_ACTIVE {
+		return false
+	}
+	color, found := storedGame.PlayerColor(address)
+	return found && color == storedGame.Turn
+}
+
+// maxPiecesPerPlayer 为每一方开局时的棋子数量，之后只会减少
+const maxPiecesPerPlayer = 12
+
+// ValidatePosition 验证棋盘上的局面是否可能由合法的走棋得到
+// 棋子只能位于可用的（深色）格子上，每一方不超过开局时的棋子数量，
+// 且到达对方底线的棋子必须已经升变为王
+func (storedGame *StoredGame) ValidatePosition() error {
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	counts := make(map[rules.Player]int, 2)
+	for y := 0; y < rules.BOARD_DIM; y++ {
+		for x := 0; x < rules.BOARD_DIM; x++ {
+			pos := rules.Pos{X: x, Y: y}
+			piece, found := game.Pieces[pos]
+			if !found {
+				continue
+			}
+			if !rules.Usable[pos] {
+				return errors.Wrapf(ErrGameNotParseable, "piece on unusable square %v", pos)
+			}
+			if !piece.King && ((piece.Player == rules.BLACK_PLAYER && y == rules.BOARD_DIM-1) ||
+				(piece.Player == rules.RED_PLAYER && y == 0)) {
+				return errors.Wrapf(ErrGameNotParseable, "piece at %v should have been crowned", pos)
+			}
+			counts[piece.Player]++
+		}
+	}
+	for _, player := range []rules.Player{rules.BLACK_PLAYER, rules.RED_PLAYER} {
+		if counts[player] > maxPiecesPerPlayer {
+			return errors.Wrapf(ErrGameNotParseable, "%s has %d pieces, at most %d", player.Color, counts[player], maxPiecesPerPlayer)
+		}
+	}
+	return nil
+}
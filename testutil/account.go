@@ -0,0 +1,34 @@
+package testutil
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// AccountKeeper 为测试使用的 auth，只记录各模块账户的权限，实现 checkers.AccountKeeper
+type AccountKeeper struct {
+	Permissions map[string][]string
+}
+
+var _ checkers.AccountKeeper = (*AccountKeeper)(nil)
+
+// NewAccountKeeper 返回模块账户有 authtypes.Burner 权限的 auth
+func NewAccountKeeper() *AccountKeeper {
+	return &AccountKeeper{Permissions: map[string][]string{checkers.ModuleName: {authtypes.Burner}}}
+}
+
+func (a *AccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (a *AccountKeeper) GetModuleAddressAndPermissions(moduleName string) (sdk.AccAddress, []string) {
+	permissions, found := a.Permissions[moduleName]
+	if !found {
+		return nil, []string{}
+	}
+	return authtypes.NewModuleAddress(moduleName), permissions
+}
@@ -0,0 +1,64 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/buzzing/checkers"
+)
+
+// BankKeeper 为测试使用的内存中的 bank，实现 checkers.BankKeeper
+type BankKeeper struct {
+	Balances map[string]sdk.Coins
+}
+
+var _ checkers.BankKeeper = (*BankKeeper)(nil)
+
+// NewBankKeeper 返回没有任何余额的 bank
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{Balances: make(map[string]sdk.Coins)}
+}
+
+// Fund 向 addr 增加 amt
+func (b *BankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.Balances[addr.String()] = b.Balances[addr.String()].Add(amt...)
+}
+
+func (b *BankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.Balances[from.String()].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", b.Balances[from.String()], amt)
+	}
+	b.Balances[from.String()] = balance
+	b.Balances[to.String()] = b.Balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *BankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *BankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *BankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	moduleAddr := authtypes.NewModuleAddress(moduleName)
+	balance, negative := b.Balances[moduleAddr.String()].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", b.Balances[moduleAddr.String()], amt)
+	}
+	b.Balances[moduleAddr.String()] = balance
+	return nil
+}
+
+func (b *BankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
+
+func (b *BankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
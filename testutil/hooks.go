@@ -0,0 +1,37 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/buzzing/checkers"
+)
+
+// Hooks 为测试使用的 checkers.CheckersHooks，各个回调为空时直接返回
+type Hooks struct {
+	GameCreated func(ctx context.Context, index string, storedGame checkers.StoredGame) error
+	MovePlayed  func(ctx context.Context, index string, storedGame checkers.StoredGame, player string) error
+	GameEnded   func(ctx context.Context, index string, storedGame checkers.StoredGame) error
+}
+
+var _ checkers.CheckersHooks = Hooks{}
+
+func (h Hooks) AfterGameCreated(ctx context.Context, index string, storedGame checkers.StoredGame) error {
+	if h.GameCreated == nil {
+		return nil
+	}
+	return h.GameCreated(ctx, index, storedGame)
+}
+
+func (h Hooks) AfterMovePlayed(ctx context.Context, index string, storedGame checkers.StoredGame, player string) error {
+	if h.MovePlayed == nil {
+		return nil
+	}
+	return h.MovePlayed(ctx, index, storedGame, player)
+}
+
+func (h Hooks) AfterGameEnded(ctx context.Context, index string, storedGame checkers.StoredGame) error {
+	if h.GameEnded == nil {
+		return nil
+	}
+	return h.GameEnded(ctx, index, storedGame)
+}
@@ -0,0 +1,78 @@
+// Package testutil 为模块的测试提供使用内存存储的 keeper 和上下文
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/buzzing/checkers"
+	"github.com/buzzing/checkers/keeper"
+)
+
+// Fixture 为测试使用的 keeper 及其依赖，IBC 相关的依赖为空
+type Fixture struct {
+	Ctx          sdk.Context
+	Cdc          codec.Codec
+	Keeper       *keeper.Keeper
+	StoreService store.KVStoreService
+	Bank         *BankKeeper
+	Account      *AccountKeeper
+	Authority    string
+}
+
+// NewFixture 返回使用内存存储的 keeper，上下文的区块高度为 1，参数为默认参数
+func NewFixture(t testing.TB) *Fixture {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	checkers.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	key := storetypes.NewKVStoreKey(checkers.ModuleName)
+	storeService := runtime.NewKVStoreService(key)
+	ctx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = WithHeight(ctx, 1)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	bank := NewBankKeeper()
+	account := NewAccountKeeper()
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		storeService,
+		authority,
+		bank,
+		account,
+		nil,
+		runtime.ProvideHeaderInfoService(nil),
+		nil,
+		nil,
+	)
+	if err := k.Params.Set(ctx, checkers.DefaultParams()); err != nil {
+		t.Fatal(err)
+	}
+	return &Fixture{Ctx: ctx, Cdc: encCfg.Codec, Keeper: &k, StoreService: storeService, Bank: bank, Account: account, Authority: authority}
+}
+
+// WithHeight 返回区块高度为 height 的上下文，区块时间随高度每块增加 5 秒
+func WithHeight(ctx sdk.Context, height int64) sdk.Context {
+	blockTime := time.Unix(1700000000, 0).UTC().Add(time.Duration(height) * 5 * time.Second)
+	return ctx.WithBlockHeight(height).WithBlockTime(blockTime).
+		WithHeaderInfo(header.Info{Height: height, Time: blockTime})
+}
+
+// Address 返回测试使用的第 i 个账户地址
+func Address(i int) string {
+	addr := make([]byte, 20)
+	addr[0] = byte(i + 1)
+	return sdk.AccAddress(addr).String()
+}
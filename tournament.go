@@ -0,0 +1,283 @@
+package checkers
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/buzzing/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// 锦标赛中每局的得分，以半分为单位
+const (
+	TournamentWinPoints  uint32 = 2
+	TournamentDrawPoints uint32 = 1
+	TournamentLossPoints uint32 = 0
+	// TournamentByePoints 为轮空的得分，与胜局相同
+	TournamentByePoints = TournamentWinPoints
+)
+
+// DefaultPrizeShares 为没有指定 prize_shares 时的奖金分配，第一名获得全部奖池
+var DefaultPrizeShares = []uint32{100}
+
+// Validate 验证锦标赛
+func (tournament *Tournament) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tournament.Creator); err != nil {
+		return errors.Wrapf(ErrInvalidTournament, "creator %s: %s", tournament.Creator, err)
+	}
+	if tournament.Name == "" {
+		return errors.Wrapf(ErrInvalidTournament, "empty name")
+	}
+	if _, ok := TournamentStatus_name[int32(tournament.Status)]; !ok || tournament.Status == TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED {
+		return errors.Wrapf(ErrInvalidTournament, "status %s", tournament.Status)
+	}
+	switch tournament.Format {
+	case TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN:
+		// 循环赛的轮数在开始时根据人数计算
+		if tournament.Status == TournamentStatus_TOURNAMENT_STATUS_REGISTRATION && tournament.Rounds != 0 {
+			return errors.Wrapf(ErrInvalidTournament, "round robin rounds are computed from the players")
+		}
+	case TournamentFormat_TOURNAMENT_FORMAT_SWISS:
+		if tournament.Rounds == 0 {
+			return errors.Wrapf(ErrInvalidTournament, "swiss tournament needs at least one round")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidTournament, "format %s", tournament.Format)
+	}
+	if tournament.MaxPlayers < 2 {
+		return errors.Wrapf(ErrInvalidTournament, "max players %d, expected at least 2", tournament.MaxPlayers)
+	}
+	if uint64(len(tournament.Players)) > tournament.MaxPlayers {
+		return errors.Wrapf(ErrInvalidTournament, "%d players, at most %d", len(tournament.Players), tournament.MaxPlayers)
+	}
+	if tournament.TurnDuration == 0 {
+		return errors.Wrapf(ErrInvalidTournament, "turn duration must be positive")
+	}
+	if tournament.CurrentRound > tournament.Rounds {
+		return errors.Wrapf(ErrInvalidTournament, "current round %d after last round %d", tournament.CurrentRound, tournament.Rounds)
+	}
+	if err := validateWager(tournament.EntryFee); err != nil {
+		return err
+	}
+	if err := validateWager(tournament.PrizePool); err != nil {
+		return err
+	}
+	return tournament.ValidatePrizeShares()
+}
+
+// ValidatePrizeShares 验证奖金分配，每一项为正数，总和为 100，且不超过报名人数上限
+func (tournament *Tournament) ValidatePrizeShares() error {
+	if uint64(len(tournament.PrizeShares)) > tournament.MaxPlayers {
+		return errors.Wrapf(ErrInvalidTournament, "%d prize shares for %d players", len(tournament.PrizeShares), tournament.MaxPlayers)
+	}
+	total := uint32(0)
+	for _, share := range tournament.PrizeShares {
+		if share == 0 {
+			return errors.Wrapf(ErrInvalidTournament, "prize share must be positive")
+		}
+		total += share
+	}
+	if total != 100 {
+		return errors.Wrapf(ErrInvalidTournament, "prize shares sum to %d, expected 100", total)
+	}
+	return nil
+}
+
+// HasPlayer 返回 player 是否已经报名
+func (tournament *Tournament) HasPlayer(player string) bool {
+	for _, registered := range tournament.Players {
+		if registered == player {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 验证锦标赛的参赛玩家
+func (tournamentPlayer *TournamentPlayer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tournamentPlayer.Player); err != nil {
+		return errors.Wrapf(ErrInvalidTournament, "player %s: %s", tournamentPlayer.Player, err)
+	}
+	return nil
+}
+
+// HasPlayed 返回玩家是否已经与 opponent 对局过
+func (tournamentPlayer *TournamentPlayer) HasPlayed(opponent string) bool {
+	for _, result := range tournamentPlayer.Results {
+		if result.Opponent == opponent {
+			return true
+		}
+	}
+	return false
+}
+
+// HasBye 返回玩家是否已经轮空过
+func (tournamentPlayer *TournamentPlayer) HasBye() bool {
+	return tournamentPlayer.HasPlayed("")
+}
+
+// blackCount 返回玩家执黑的局数
+func (tournamentPlayer *TournamentPlayer) blackCount() int {
+	count := 0
+	for _, result := range tournamentPlayer.Results {
+		if result.Color == rules.PieceStrings[rules.BLACK_PLAYER] {
+			count++
+		}
+	}
+	return count
+}
+
+// TournamentPairing 为一轮中的一对玩家，Red 为空时表示 Black 轮空
+type TournamentPairing struct {
+	Black string
+	Red   string
+}
+
+// RoundRobinRounds 返回 n 位玩家的循环赛需要的轮数，人数为奇数时每轮有一位玩家轮空
+func RoundRobinRounds(n int) uint32 {
+	if n%2 == 1 {
+		n++
+	}
+	return uint32(n - 1)
+}
+
+// RoundRobinPairings 使用轮转法返回循环赛第 round 轮（从 1 开始）的配对
+// 第一位玩家固定，其余玩家每轮顺时针轮转一个位置，执黑方按轮次交替
+func RoundRobinPairings(players []string, round uint32) []TournamentPairing {
+	seats := append([]string{}, players...)
+	if len(seats)%2 == 1 {
+		seats = append(seats, "")
+	}
+	n := len(seats)
+	if n < 2 {
+		return nil
+	}
+
+	// 将除第一位以外的玩家轮转 round-1 个位置
+	rotated := make([]string, n)
+	rotated[0] = seats[0]
+	shift := int(round-1) % (n - 1)
+	for i := 1; i < n; i++ {
+		rotated[1+(i-1+shift)%(n-1)] = seats[i]
+	}
+
+	pairings := make([]TournamentPairing, 0, n/2)
+	for i := 0; i < n/2; i++ {
+		a, b := rotated[i], rotated[n-1-i]
+		if round%2 == 0 {
+			a, b = b, a
+		}
+		pairings = append(pairings, newPairing(a, b))
+	}
+	return pairings
+}
+
+// SwissPairings 返回瑞士制下一轮的配对，standings 需要已经按 SortStandings 排序
+// 人数为奇数时，排名最低且尚未轮空过的玩家轮空
+// 其余玩家从排名最高的开始，与排名最接近且尚未对局过的玩家配对，找不到时允许再次对局
+// 执黑局数较少的一方执黑，相同时排名较高的一方执黑
+func SwissPairings(standings []TournamentPlayer) []TournamentPairing {
+	unpaired := make([]*TournamentPlayer, 0, len(standings))
+	for i := range standings {
+		unpaired = append(unpaired, &standings[i])
+	}
+
+	var pairings []TournamentPairing
+	if len(unpaired)%2 == 1 {
+		bye := len(unpaired) - 1
+		for i := len(unpaired) - 1; i >= 0; i-- {
+			if !unpaired[i].HasBye() {
+				bye = i
+				break
+			}
+		}
+		pairings = append(pairings, TournamentPairing{Black: unpaired[bye].Player})
+		unpaired = append(unpaired[:bye], unpaired[bye+1:]...)
+	}
+
+	for len(unpaired) > 0 {
+		first := unpaired[0]
+		opponent := 1
+		for i := 1; i < len(unpaired); i++ {
+			if !first.HasPlayed(unpaired[i].Player) {
+				opponent = i
+				break
+			}
+		}
+		second := unpaired[opponent]
+		if second.blackCount() < first.blackCount() {
+			pairings = append(pairings, TournamentPairing{Black: second.Player, Red: first.Player})
+		} else {
+			pairings = append(pairings, TournamentPairing{Black: first.Player, Red: second.Player})
+		}
+		unpaired = append(unpaired[1:opponent], unpaired[opponent+1:]...)
+	}
+	return pairings
+}
+
+// newPairing 返回 a 执黑、b 执红的配对，其中一方为空时另一方轮空
+func newPairing(a, b string) TournamentPairing {
+	if a == "" {
+		return TournamentPairing{Black: b}
+	}
+	return TournamentPairing{Black: a, Red: b}
+}
+
+// ComputeTieBreaks 根据所有玩家的得分，重新计算每位玩家的 Buchholz 和 Sonneborn-Berger
+func ComputeTieBreaks(players []TournamentPlayer) {
+	scores := make(map[string]uint64, len(players))
+	for _, player := range players {
+		scores[player.Player] = player.Score
+	}
+	for i := range players {
+		players[i].Buchholz = 0
+		players[i].SonnebornBerger = 0
+		for _, result := range players[i].Results {
+			if result.Opponent == "" {
+				continue
+			}
+			players[i].Buchholz += scores[result.Opponent]
+			players[i].SonnebornBerger += uint64(result.Points) * scores[result.Opponent]
+		}
+	}
+}
+
+// SortStandings 按得分、Sonneborn-Berger、Buchholz 从高到低排列玩家，都相同时按报名顺序排列
+func SortStandings(tournament Tournament, players []TournamentPlayer) {
+	seeds := make(map[string]int, len(tournament.Players))
+	for i, player := range tournament.Players {
+		seeds[player] = i
+	}
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i], players[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.SonnebornBerger != b.SonnebornBerger {
+			return a.SonnebornBerger > b.SonnebornBerger
+		}
+		if a.Buchholz != b.Buchholz {
+			return a.Buchholz > b.Buchholz
+		}
+		return seeds[a.Player] < seeds[b.Player]
+	})
+}
+
+// PrizeAmounts 按 shares 分配奖池，返回从第一名开始每个名次的奖金
+// 名次数量不超过 players，整除的余数以及没有玩家的名次的奖金都归第一名
+func PrizeAmounts(pool math.Int, shares []uint32, players int) []math.Int {
+	if len(shares) > players {
+		shares = shares[:players]
+	}
+	amounts := make([]math.Int, len(shares))
+	paid := math.ZeroInt()
+	for i, share := range shares {
+		amounts[i] = pool.MulRaw(int64(share)).QuoRaw(100)
+		paid = paid.Add(amounts[i])
+	}
+	if len(amounts) > 0 {
+		amounts[0] = amounts[0].Add(pool.Sub(paid))
+	}
+	return amounts
+}
@@ -4,19 +4,25 @@
 package checkers
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,8 +30,268 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecordFeeDestination 定义了 record 费用的去向
+type RecordFeeDestination int32
+
+const (
+	RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED RecordFeeDestination = 0
+	// 费用被销毁，模块账户需要 burner 权限
+	RecordFeeDestination_RECORD_FEE_DESTINATION_BURN RecordFeeDestination = 1
+	// 费用被转入社区池，需要 distribution 模块
+	RecordFeeDestination_RECORD_FEE_DESTINATION_COMMUNITY_POOL RecordFeeDestination = 2
+)
+
+var RecordFeeDestination_name = map[int32]string{
+	0: "RECORD_FEE_DESTINATION_UNSPECIFIED",
+	1: "RECORD_FEE_DESTINATION_BURN",
+	2: "RECORD_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var RecordFeeDestination_value = map[string]int32{
+	"RECORD_FEE_DESTINATION_UNSPECIFIED":    0,
+	"RECORD_FEE_DESTINATION_BURN":           1,
+	"RECORD_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x RecordFeeDestination) String() string {
+	return proto.EnumName(RecordFeeDestination_name, int32(x))
+}
+
+func (RecordFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{0}
+}
+
+// GameStatus 定义了游戏对局所处的阶段
+type GameStatus int32
+
+const (
+	// GAME_STATUS_UNSPECIFIED 为未指定的状态，不应出现在存储的游戏中
+	GameStatus_GAME_STATUS_UNSPECIFIED GameStatus = 0
+	// GAME_STATUS_ACTIVE 表示游戏正在进行中
+	GameStatus_GAME_STATUS_ACTIVE GameStatus = 1
+	// GAME_STATUS_FINISHED 表示游戏已经结束
+	GameStatus_GAME_STATUS_FINISHED GameStatus = 2
+	// GAME_STATUS_PENDING 表示游戏邀请还在等待玩家接受
+	GameStatus_GAME_STATUS_PENDING GameStatus = 3
+	// GAME_STATUS_OPEN 表示游戏只有一方玩家，在大厅中等待任意玩家加入
+	GameStatus_GAME_STATUS_OPEN GameStatus = 4
+)
+
+var GameStatus_name = map[int32]string{
+	0: "GAME_STATUS_UNSPECIFIED",
+	1: "GAME_STATUS_ACTIVE",
+	2: "GAME_STATUS_FINISHED",
+	3: "GAME_STATUS_PENDING",
+	4: "GAME_STATUS_OPEN",
+}
+
+var GameStatus_value = map[string]int32{
+	"GAME_STATUS_UNSPECIFIED": 0,
+	"GAME_STATUS_ACTIVE":      1,
+	"GAME_STATUS_FINISHED":    2,
+	"GAME_STATUS_PENDING":     3,
+	"GAME_STATUS_OPEN":        4,
+}
+
+func (x GameStatus) String() string {
+	return proto.EnumName(GameStatus_name, int32(x))
+}
+
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{1}
+}
+
+// TournamentFormat 定义了锦标赛的赛制
+type TournamentFormat int32
+
+const (
+	TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED TournamentFormat = 0
+	// 循环赛，每位玩家与其他所有玩家各对局一次
+	TournamentFormat_TOURNAMENT_FORMAT_ROUND_ROBIN TournamentFormat = 1
+	// 瑞士制，每轮将积分相近、尚未对局过的玩家配对
+	TournamentFormat_TOURNAMENT_FORMAT_SWISS TournamentFormat = 2
+)
+
+var TournamentFormat_name = map[int32]string{
+	0: "TOURNAMENT_FORMAT_UNSPECIFIED",
+	1: "TOURNAMENT_FORMAT_ROUND_ROBIN",
+	2: "TOURNAMENT_FORMAT_SWISS",
+}
+
+var TournamentFormat_value = map[string]int32{
+	"TOURNAMENT_FORMAT_UNSPECIFIED": 0,
+	"TOURNAMENT_FORMAT_ROUND_ROBIN": 1,
+	"TOURNAMENT_FORMAT_SWISS":       2,
+}
+
+func (x TournamentFormat) String() string {
+	return proto.EnumName(TournamentFormat_name, int32(x))
+}
+
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{2}
+}
+
+// TournamentStatus 定义了锦标赛的状态
+type TournamentStatus int32
+
+const (
+	TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED TournamentStatus = 0
+	// 报名中，到达 start_height 后开始第一轮
+	TournamentStatus_TOURNAMENT_STATUS_REGISTRATION TournamentStatus = 1
+	// 进行中
+	TournamentStatus_TOURNAMENT_STATUS_RUNNING TournamentStatus = 2
+	// 已结束，奖金已经发放
+	TournamentStatus_TOURNAMENT_STATUS_FINISHED TournamentStatus = 3
+	// 报名人数不足而取消，报名费已经退回
+	TournamentStatus_TOURNAMENT_STATUS_CANCELLED TournamentStatus = 4
+)
+
+var TournamentStatus_name = map[int32]string{
+	0: "TOURNAMENT_STATUS_UNSPECIFIED",
+	1: "TOURNAMENT_STATUS_REGISTRATION",
+	2: "TOURNAMENT_STATUS_RUNNING",
+	3: "TOURNAMENT_STATUS_FINISHED",
+	4: "TOURNAMENT_STATUS_CANCELLED",
+}
+
+var TournamentStatus_value = map[string]int32{
+	"TOURNAMENT_STATUS_UNSPECIFIED":  0,
+	"TOURNAMENT_STATUS_REGISTRATION": 1,
+	"TOURNAMENT_STATUS_RUNNING":      2,
+	"TOURNAMENT_STATUS_FINISHED":     3,
+	"TOURNAMENT_STATUS_CANCELLED":    4,
+}
+
+func (x TournamentStatus) String() string {
+	return proto.EnumName(TournamentStatus_name, int32(x))
+}
+
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{3}
+}
+
+// RecordSource 定义了 record 的来源
+type RecordSource int32
+
+const (
+	RecordSource_RECORD_SOURCE_UNSPECIFIED RecordSource = 0
+	// 创世时由 InitGenesis 添加
+	RecordSource_RECORD_SOURCE_GENESIS RecordSource = 1
+	// 由 BeginBlock 添加
+	RecordSource_RECORD_SOURCE_BEGIN_BLOCK RecordSource = 2
+	// 由 EndBlock 添加
+	RecordSource_RECORD_SOURCE_END_BLOCK RecordSource = 3
+	// 由 MsgAddRecord 添加
+	RecordSource_RECORD_SOURCE_TX RecordSource = 4
+	// 迁移自共识版本 1 的 RecordList，原有的高度和时间未知，使用迁移时的区块
+	RecordSource_RECORD_SOURCE_LEGACY RecordSource = 5
+)
+
+var RecordSource_name = map[int32]string{
+	0: "RECORD_SOURCE_UNSPECIFIED",
+	1: "RECORD_SOURCE_GENESIS",
+	2: "RECORD_SOURCE_BEGIN_BLOCK",
+	3: "RECORD_SOURCE_END_BLOCK",
+	4: "RECORD_SOURCE_TX",
+	5: "RECORD_SOURCE_LEGACY",
+}
+
+var RecordSource_value = map[string]int32{
+	"RECORD_SOURCE_UNSPECIFIED": 0,
+	"RECORD_SOURCE_GENESIS":     1,
+	"RECORD_SOURCE_BEGIN_BLOCK": 2,
+	"RECORD_SOURCE_END_BLOCK":   3,
+	"RECORD_SOURCE_TX":          4,
+	"RECORD_SOURCE_LEGACY":      5,
+}
+
+func (x RecordSource) String() string {
+	return proto.EnumName(RecordSource_name, int32(x))
+}
+
+func (RecordSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{4}
+}
+
+// OutgoingPacketStatus 定义了发送的数据包的状态
+type OutgoingPacketStatus int32
+
+const (
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_UNSPECIFIED OutgoingPacketStatus = 0
+	// 已发送，还没有收到确认
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_PENDING OutgoingPacketStatus = 1
+	// 对方链成功处理了数据包
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_ACKNOWLEDGED OutgoingPacketStatus = 2
+	// 对方链返回了错误的确认
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_FAILED OutgoingPacketStatus = 3
+	// 数据包超时
+	OutgoingPacketStatus_OUTGOING_PACKET_STATUS_TIMED_OUT OutgoingPacketStatus = 4
+)
+
+var OutgoingPacketStatus_name = map[int32]string{
+	0: "OUTGOING_PACKET_STATUS_UNSPECIFIED",
+	1: "OUTGOING_PACKET_STATUS_PENDING",
+	2: "OUTGOING_PACKET_STATUS_ACKNOWLEDGED",
+	3: "OUTGOING_PACKET_STATUS_FAILED",
+	4: "OUTGOING_PACKET_STATUS_TIMED_OUT",
+}
+
+var OutgoingPacketStatus_value = map[string]int32{
+	"OUTGOING_PACKET_STATUS_UNSPECIFIED":  0,
+	"OUTGOING_PACKET_STATUS_PENDING":      1,
+	"OUTGOING_PACKET_STATUS_ACKNOWLEDGED": 2,
+	"OUTGOING_PACKET_STATUS_FAILED":       3,
+	"OUTGOING_PACKET_STATUS_TIMED_OUT":    4,
+}
+
+func (x OutgoingPacketStatus) String() string {
+	return proto.EnumName(OutgoingPacketStatus_name, int32(x))
+}
+
+func (OutgoingPacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{5}
+}
+
 // Params 定义了 checkers 模块的参数
 type Params struct {
+	// invitation_expiry_blocks 定义了游戏邀请的有效区块数
+	// 超过该区块数仍未被双方接受的游戏邀请将在 EndBlock 中被删除
+	InvitationExpiryBlocks uint64 `protobuf:"varint,1,opt,name=invitation_expiry_blocks,json=invitationExpiryBlocks,proto3" json:"invitation_expiry_blocks,omitempty"`
+	// matchmaking_rating_window 定义了匹配队列中两位玩家初始允许的最大等级分差
+	MatchmakingRatingWindow uint64 `protobuf:"varint,2,opt,name=matchmaking_rating_window,json=matchmakingRatingWindow,proto3" json:"matchmaking_rating_window,omitempty"`
+	// matchmaking_window_growth 定义了玩家在匹配队列中每等待一个区块，允许的等级分差增加的数值
+	MatchmakingWindowGrowth uint64 `protobuf:"varint,3,opt,name=matchmaking_window_growth,json=matchmakingWindowGrowth,proto3" json:"matchmaking_window_growth,omitempty"`
+	// max_turn_duration 定义了每一步棋的最长时限（区块数）
+	// 没有指定时限的游戏使用该时限
+	MaxTurnDuration uint64 `protobuf:"varint,4,opt,name=max_turn_duration,json=maxTurnDuration,proto3" json:"max_turn_duration,omitempty"`
+	// max_active_games 定义了每位玩家已经接受的、尚未结束的游戏数量上限，锦标赛的游戏不受限制
+	// 收到但还没有接受的邀请不计入
+	MaxActiveGames uint64 `protobuf:"varint,5,opt,name=max_active_games,json=maxActiveGames,proto3" json:"max_active_games,omitempty"`
+	// min_wager 定义了赌注的最小数量，不设置赌注的游戏不受限制
+	MinWager cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=min_wager,json=minWager,proto3,customtype=cosmossdk.io/math.Int" json:"min_wager"`
+	// allowed_wager_denoms 定义了允许作为赌注的代币，为空时不限制
+	AllowedWagerDenoms []string `protobuf:"bytes,7,rep,name=allowed_wager_denoms,json=allowedWagerDenoms,proto3" json:"allowed_wager_denoms,omitempty"`
+	// record_max_length 定义了 MsgAddRecord 和通过 IBC 收到的 record 的最大字节数
+	// 模块在 BeginBlock、EndBlock 等处自己添加的 record 不受限制
+	RecordMaxLength uint64 `protobuf:"varint,8,opt,name=record_max_length,json=recordMaxLength,proto3" json:"record_max_length,omitempty"`
+	// record_retention 定义了 record 保留的区块数，EndBlock 删除更早的 record
+	RecordRetention uint64 `protobuf:"varint,9,opt,name=record_retention,json=recordRetention,proto3" json:"record_retention,omitempty"`
+	// record_min_length 定义了 MsgAddRecord 中 record 的最小字节数，为 0 时只要求非空
+	RecordMinLength uint64 `protobuf:"varint,10,opt,name=record_min_length,json=recordMinLength,proto3" json:"record_min_length,omitempty"`
+	// record_denylist 定义了 MsgAddRecord 不接受的 record 的正则表达式（RE2 语法）
+	RecordDenylist []string `protobuf:"bytes,11,rep,name=record_denylist,json=recordDenylist,proto3" json:"record_denylist,omitempty"`
+	// record_rate_limit 定义了每个账户在 record_rate_window 个区块内最多添加的 record 数量，为 0 时不限制
+	RecordRateLimit uint64 `protobuf:"varint,12,opt,name=record_rate_limit,json=recordRateLimit,proto3" json:"record_rate_limit,omitempty"`
+	// record_rate_window 定义了限制 record 数量的区块窗口，窗口从高度为其整数倍的区块开始
+	RecordRateWindow uint64 `protobuf:"varint,13,opt,name=record_rate_window,json=recordRateWindow,proto3" json:"record_rate_window,omitempty"`
+	// record_fee 定义了每条 record 的费用，没有设置时不收取费用
+	RecordFee types.Coin `protobuf:"bytes,14,opt,name=record_fee,json=recordFee,proto3" json:"record_fee"`
+	// record_fee_destination 定义了 record 费用的去向，设置了 record_fee 时必须指定
+	RecordFeeDestination RecordFeeDestination `protobuf:"varint,15,opt,name=record_fee_destination,json=recordFeeDestination,proto3,enum=buzzing.checkers.v1.RecordFeeDestination" json:"record_fee_destination,omitempty"`
+	// max_queue_size 定义了匹配队列中最多的玩家数量，队列已满时不能再加入
+	// EndBlock 中配对的开销随队列长度的平方增长，因此需要限制队列的长度
+	MaxQueueSize uint64 `protobuf:"varint,16,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,14 +327,150 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetInvitationExpiryBlocks() uint64 {
+	if m != nil {
+		return m.InvitationExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMatchmakingRatingWindow() uint64 {
+	if m != nil {
+		return m.MatchmakingRatingWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMatchmakingWindowGrowth() uint64 {
+	if m != nil {
+		return m.MatchmakingWindowGrowth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTurnDuration() uint64 {
+	if m != nil {
+		return m.MaxTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveGames() uint64 {
+	if m != nil {
+		return m.MaxActiveGames
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedWagerDenoms() []string {
+	if m != nil {
+		return m.AllowedWagerDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRecordMaxLength() uint64 {
+	if m != nil {
+		return m.RecordMaxLength
+	}
+	return 0
+}
+
+func (m *Params) GetRecordRetention() uint64 {
+	if m != nil {
+		return m.RecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRecordMinLength() uint64 {
+	if m != nil {
+		return m.RecordMinLength
+	}
+	return 0
+}
+
+func (m *Params) GetRecordDenylist() []string {
+	if m != nil {
+		return m.RecordDenylist
+	}
+	return nil
+}
+
+func (m *Params) GetRecordRateLimit() uint64 {
+	if m != nil {
+		return m.RecordRateLimit
+	}
+	return 0
+}
+
+func (m *Params) GetRecordRateWindow() uint64 {
+	if m != nil {
+		return m.RecordRateWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRecordFee() types.Coin {
+	if m != nil {
+		return m.RecordFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRecordFeeDestination() RecordFeeDestination {
+	if m != nil {
+		return m.RecordFeeDestination
+	}
+	return RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (m *Params) GetMaxQueueSize() uint64 {
+	if m != nil {
+		return m.MaxQueueSize
+	}
+	return 0
+}
+
 // GenesisState 为 checkers 模块的创世状态
 type GenesisState struct {
 	// params 定义了模块的所有参数
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// indexedStoredGameList 定义了所有的 StoredGame
 	IndexedStoredGameList []IndexedStoredGame `protobuf:"bytes,2,rep,name=indexedStoredGameList,proto3" json:"indexedStoredGameList"`
-	// 用于测试 BeginBlocker 函数的测试字段
-	RecordList []string `protobuf:"bytes,3,rep,name=recordList,proto3" json:"recordList,omitempty"`
+	// recordList 为共识版本 1 中以字符串保存的 record，只在导入旧的创世状态时使用，
+	// 由 migrations/v2 的 MigrateGenesis 转换为 records
+	RecordList []string `protobuf:"bytes,3,rep,name=recordList,proto3" json:"recordList,omitempty"` // Deprecated: Do not use.
+	// indexedPlayerInfoList 定义了所有玩家的对局统计
+	IndexedPlayerInfoList []IndexedPlayerInfo `protobuf:"bytes,4,rep,name=indexedPlayerInfoList,proto3" json:"indexedPlayerInfoList"`
+	// queueEntryList 定义了匹配队列中的所有玩家
+	QueueEntryList []QueueEntry `protobuf:"bytes,5,rep,name=queueEntryList,proto3" json:"queueEntryList"`
+	// tournamentList 定义了所有锦标赛
+	TournamentList []Tournament `protobuf:"bytes,6,rep,name=tournamentList,proto3" json:"tournamentList"`
+	// tournamentPlayerList 定义了所有锦标赛的参赛玩家
+	TournamentPlayerList []TournamentPlayer `protobuf:"bytes,7,rep,name=tournamentPlayerList,proto3" json:"tournamentPlayerList"`
+	// tournamentCount 为下一个锦标赛的 id
+	TournamentCount uint64 `protobuf:"varint,8,opt,name=tournamentCount,proto3" json:"tournamentCount,omitempty"`
+	// records 定义了所有的 record
+	Records []Record `protobuf:"bytes,9,rep,name=records,proto3" json:"records"`
+	// recordCount 为已经分配的 record id 的数量
+	RecordCount uint64 `protobuf:"varint,10,opt,name=recordCount,proto3" json:"recordCount,omitempty"`
+	// recordRateCounters 定义了各账户在当前窗口内添加的 record 数量
+	RecordRateCounters []RecordRateCounter `protobuf:"bytes,11,rep,name=recordRateCounters,proto3" json:"recordRateCounters"`
+	// recordLogHead 为 record 链的头部，还没有添加过 record 时为空
+	RecordLogHead *RecordLogHead `protobuf:"bytes,12,opt,name=recordLogHead,proto3" json:"recordLogHead,omitempty"`
+	// remoteRecordList 定义了通过 IBC 从其他链收到的 record
+	RemoteRecordList []RemoteRecord `protobuf:"bytes,13,rep,name=remoteRecordList,proto3" json:"remoteRecordList"`
+	// outgoingPacketList 定义了本链发送的数据包
+	OutgoingPacketList []OutgoingPacket `protobuf:"bytes,14,rep,name=outgoingPacketList,proto3" json:"outgoingPacketList"`
+	// ibcGameList 定义了所有跨链游戏
+	IbcGameList []IbcGame `protobuf:"bytes,15,rep,name=ibcGameList,proto3" json:"ibcGameList"`
+	// ibcGameCount 为已经分配的跨链游戏 id 的数量
+	IbcGameCount uint64 `protobuf:"varint,16,opt,name=ibcGameCount,proto3" json:"ibcGameCount,omitempty"`
+	// ibcChannelList 定义了模块的端口上已经开启的通道
+	IbcChannelList []IbcChannel `protobuf:"bytes,17,rep,name=ibcChannelList,proto3" json:"ibcChannelList"`
+	// defaultChannel 为跨链功能的默认通道，没有设置时为空
+	DefaultChannel string `protobuf:"bytes,18,opt,name=defaultChannel,proto3" json:"defaultChannel,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +520,7 @@ func (m *GenesisState) GetIndexedStoredGameList() []IndexedStoredGame {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *GenesisState) GetRecordList() []string {
 	if m != nil {
 		return m.RecordList
@@ -125,6 +528,111 @@ func (m *GenesisState) GetRecordList() []string {
 	return nil
 }
 
+func (m *GenesisState) GetIndexedPlayerInfoList() []IndexedPlayerInfo {
+	if m != nil {
+		return m.IndexedPlayerInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueueEntryList() []QueueEntry {
+	if m != nil {
+		return m.QueueEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTournamentList() []Tournament {
+	if m != nil {
+		return m.TournamentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTournamentPlayerList() []TournamentPlayer {
+	if m != nil {
+		return m.TournamentPlayerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTournamentCount() uint64 {
+	if m != nil {
+		return m.TournamentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRecordRateCounters() []RecordRateCounter {
+	if m != nil {
+		return m.RecordRateCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordLogHead() *RecordLogHead {
+	if m != nil {
+		return m.RecordLogHead
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteRecordList() []RemoteRecord {
+	if m != nil {
+		return m.RemoteRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutgoingPacketList() []OutgoingPacket {
+	if m != nil {
+		return m.OutgoingPacketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcGameList() []IbcGame {
+	if m != nil {
+		return m.IbcGameList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcGameCount() uint64 {
+	if m != nil {
+		return m.IbcGameCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIbcChannelList() []IbcChannel {
+	if m != nil {
+		return m.IbcChannelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDefaultChannel() string {
+	if m != nil {
+		return m.DefaultChannel
+	}
+	return ""
+}
+
 // StoredGame 为一局游戏进行到某一步时的状态
 type StoredGame struct {
 	// board 定义了棋盘的状态，由规则文件(rules/checkers.go)序列化
@@ -132,9 +640,40 @@ type StoredGame struct {
 	// turn 定义了当前回合的玩家
 	Turn string `protobuf:"bytes,2,opt,name=turn,proto3" json:"turn,omitempty"`
 	// black 定义了黑方玩家的地址
+	// 处于 GAME_STATUS_OPEN 的游戏中，尚未有玩家加入的一方为空
 	Black string `protobuf:"bytes,3,opt,name=black,proto3" json:"black,omitempty"`
 	// red 定义了红方玩家的地址
 	Red string `protobuf:"bytes,4,opt,name=red,proto3" json:"red,omitempty"`
+	// status 定义了游戏对局当前所处的阶段
+	Status GameStatus `protobuf:"varint,5,opt,name=status,proto3,enum=buzzing.checkers.v1.GameStatus" json:"status,omitempty"`
+	// winner 定义了获胜方的颜色，与 turn 的取值相同
+	// 游戏未结束或以和棋结束时为 "*"
+	Winner string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	// move_count 定义了游戏对局中已经走过的步数
+	MoveCount uint64 `protobuf:"varint,7,opt,name=move_count,json=moveCount,proto3" json:"move_count,omitempty"`
+	// draw_offer 定义了提出和棋的一方的颜色，与 turn 的取值相同
+	// 没有待处理的和棋提议时为空
+	DrawOffer string `protobuf:"bytes,8,opt,name=draw_offer,json=drawOffer,proto3" json:"draw_offer,omitempty"`
+	// black_accepted 定义了黑方是否已经接受游戏邀请
+	BlackAccepted bool `protobuf:"varint,9,opt,name=black_accepted,json=blackAccepted,proto3" json:"black_accepted,omitempty"`
+	// red_accepted 定义了红方是否已经接受游戏邀请
+	RedAccepted bool `protobuf:"varint,10,opt,name=red_accepted,json=redAccepted,proto3" json:"red_accepted,omitempty"`
+	// invitation_deadline 定义了游戏邀请的过期区块高度
+	// 处于 GAME_STATUS_PENDING 的游戏在该高度之后仍未被双方接受，将被删除
+	InvitationDeadline int64 `protobuf:"varint,11,opt,name=invitation_deadline,json=invitationDeadline,proto3" json:"invitation_deadline,omitempty"`
+	// wager 定义了每位玩家的赌注，denom 为空时表示没有赌注
+	// 玩家接受游戏或加入游戏时，赌注托管到模块账户；游戏结束时由获胜方全部取得，
+	// 和棋时各自退回；游戏被拒绝或过期时退回已经托管的赌注
+	Wager types.Coin `protobuf:"bytes,12,opt,name=wager,proto3" json:"wager"`
+	// min_rating 定义了加入游戏的玩家需要达到的最低等级分，为 0 时不限制
+	MinRating uint64 `protobuf:"varint,13,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	// turn_duration 定义了每一步棋的时限（区块数），为 0 时不限时
+	TurnDuration uint64 `protobuf:"varint,14,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// deadline 定义了当前回合的玩家必须在该区块高度之前（含）走棋，否则判负
+	// 只有限时的、正在进行的游戏才有 deadline，否则为 0
+	Deadline int64 `protobuf:"varint,15,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// tournament_id 为游戏所属的锦标赛，为 0 时不属于任何锦标赛
+	TournamentId uint64 `protobuf:"varint,16,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -198,356 +737,7530 @@ func (m *StoredGame) GetRed() string {
 	return ""
 }
 
-// IndexedStoredGame 为 StoredGame 的包装，用于索引
-type IndexedStoredGame struct {
-	Index      string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	StoredGame StoredGame `protobuf:"bytes,2,opt,name=storedGame,proto3" json:"storedGame"`
+func (m *StoredGame) GetStatus() GameStatus {
+	if m != nil {
+		return m.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
 }
 
-func (m *IndexedStoredGame) Reset()         { *m = IndexedStoredGame{} }
-func (m *IndexedStoredGame) String() string { return proto.CompactTextString(m) }
-func (*IndexedStoredGame) ProtoMessage()    {}
-func (*IndexedStoredGame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70dac21e2ab53885, []int{3}
-}
-func (m *IndexedStoredGame) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *IndexedStoredGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_IndexedStoredGame.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StoredGame) GetWinner() string {
+	if m != nil {
+		return m.Winner
 	}
-}
-func (m *IndexedStoredGame) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexedStoredGame.Merge(m, src)
-}
-func (m *IndexedStoredGame) XXX_Size() int {
-	return m.Size()
-}
-func (m *IndexedStoredGame) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexedStoredGame.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_IndexedStoredGame proto.InternalMessageInfo
+func (m *StoredGame) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
 
-func (m *IndexedStoredGame) GetIndex() string {
+func (m *StoredGame) GetDrawOffer() string {
 	if m != nil {
-		return m.Index
+		return m.DrawOffer
 	}
 	return ""
 }
 
-func (m *IndexedStoredGame) GetStoredGame() StoredGame {
+func (m *StoredGame) GetBlackAccepted() bool {
 	if m != nil {
-		return m.StoredGame
+		return m.BlackAccepted
 	}
-	return StoredGame{}
+	return false
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "buzzing.checkers.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "buzzing.checkers.v1.GenesisState")
-	proto.RegisterType((*StoredGame)(nil), "buzzing.checkers.v1.StoredGame")
-	proto.RegisterType((*IndexedStoredGame)(nil), "buzzing.checkers.v1.IndexedStoredGame")
+func (m *StoredGame) GetRedAccepted() bool {
+	if m != nil {
+		return m.RedAccepted
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("buzzing/checkers/v1/types.proto", fileDescriptor_70dac21e2ab53885) }
+func (m *StoredGame) GetInvitationDeadline() int64 {
+	if m != nil {
+		return m.InvitationDeadline
+	}
+	return 0
+}
 
-var fileDescriptor_70dac21e2ab53885 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xa6, 0x2d, 0xb7, 0xa7, 0x77, 0x73, 0xe7, 0x56, 0x88, 0x2d, 0xa4, 0x25, 0x0b,
-	0x29, 0x82, 0x09, 0xad, 0xb8, 0x70, 0x69, 0x41, 0x8a, 0xe0, 0x42, 0xd2, 0x9d, 0x1b, 0x49, 0x32,
-	0x43, 0x1a, 0x6a, 0x32, 0x61, 0x66, 0x5a, 0xb4, 0x4f, 0x21, 0x3e, 0x8b, 0x0f, 0xd1, 0x8d, 0x50,
-	0x5c, 0xb9, 0x12, 0x69, 0x5f, 0x44, 0x32, 0x49, 0xb5, 0xb4, 0x01, 0x77, 0x73, 0xfe, 0x7c, 0xff,
-	0x9f, 0x73, 0x0e, 0x07, 0xda, 0xde, 0x74, 0x3e, 0x0f, 0xe3, 0xc0, 0xf6, 0xc7, 0xc4, 0x9f, 0x10,
-	0xc6, 0xed, 0x59, 0xcf, 0x16, 0x8f, 0x09, 0xe1, 0x56, 0xc2, 0xa8, 0xa0, 0xe8, 0x7f, 0x0e, 0x58,
-	0x1b, 0xc0, 0x9a, 0xf5, 0x9a, 0x87, 0x3e, 0xe5, 0x11, 0xe5, 0x77, 0x12, 0xb1, 0xb3, 0x22, 0xe3,
-	0x9b, 0x8d, 0x80, 0x06, 0x34, 0xd3, 0xd3, 0x57, 0xa6, 0x9a, 0x7f, 0xa0, 0x7a, 0xe3, 0x32, 0x37,
-	0xe2, 0xe6, 0xab, 0x0a, 0x7f, 0x87, 0x24, 0x26, 0x3c, 0xe4, 0x23, 0xe1, 0x0a, 0x82, 0xce, 0xa1,
-	0x9a, 0xc8, 0x4f, 0xba, 0xda, 0x51, 0xbb, 0xf5, 0x7e, 0xcb, 0x2a, 0xf8, 0xa3, 0x95, 0xb9, 0x07,
-	0xe5, 0xc5, 0x47, 0x5b, 0x71, 0x72, 0x03, 0xf2, 0xe0, 0x20, 0x8c, 0x31, 0x79, 0x20, 0x78, 0x24,
-	0x28, 0x23, 0x78, 0xe8, 0x46, 0xe4, 0x3a, 0xe4, 0x42, 0x2f, 0x75, 0xb4, 0x6e, 0xbd, 0x7f, 0x54,
-	0x98, 0x74, 0xb5, 0xeb, 0xc8, 0x43, 0x8b, 0xa3, 0x90, 0x01, 0xc0, 0x88, 0x4f, 0x19, 0x96, 0xc1,
-	0x5a, 0x47, 0xeb, 0xd6, 0x9c, 0x2d, 0xc5, 0x7c, 0x56, 0x01, 0x7e, 0x2c, 0xa8, 0x01, 0x15, 0x8f,
-	0xba, 0x0c, 0xcb, 0x61, 0x6a, 0x4e, 0x56, 0x20, 0x04, 0x65, 0x31, 0x65, 0xb1, 0x5e, 0x92, 0xa2,
-	0x7c, 0x23, 0x0b, 0x2a, 0xde, 0xbd, 0xeb, 0x4f, 0x74, 0x2d, 0x15, 0x07, 0xfa, 0xdb, 0xcb, 0x49,
-	0x23, 0xdf, 0xe4, 0x05, 0xc6, 0x8c, 0x70, 0x3e, 0x12, 0x2c, 0x8c, 0x03, 0x27, 0xc3, 0xd0, 0x31,
-	0x68, 0x8c, 0x60, 0xbd, 0xfc, 0x0b, 0x9d, 0x42, 0x66, 0x02, 0xff, 0xf6, 0xc6, 0x4c, 0x5b, 0x93,
-	0x23, 0x6e, 0x5a, 0x93, 0x05, 0xba, 0x04, 0xe0, 0xdf, 0x8c, 0x6c, 0xb0, 0xde, 0x6f, 0x17, 0x2e,
-	0x6e, 0x6f, 0x63, 0x5b, 0xc6, 0xc1, 0xd9, 0x62, 0x65, 0xa8, 0xcb, 0x95, 0xa1, 0x7e, 0xae, 0x0c,
-	0xf5, 0x69, 0x6d, 0x28, 0xcb, 0xb5, 0xa1, 0xbc, 0xaf, 0x0d, 0xe5, 0xb6, 0x15, 0x84, 0x62, 0x3c,
-	0xf5, 0x2c, 0x9f, 0x46, 0xf6, 0xee, 0xb1, 0x79, 0x55, 0x79, 0x1e, 0xa7, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x6a, 0xdc, 0xe7, 0x0d, 0x87, 0x02, 0x00, 0x00,
+func (m *StoredGame) GetWager() types.Coin {
+	if m != nil {
+		return m.Wager
+	}
+	return types.Coin{}
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StoredGame) GetMinRating() uint64 {
+	if m != nil {
+		return m.MinRating
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StoredGame) GetTurnDuration() uint64 {
+	if m != nil {
+		return m.TurnDuration
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *StoredGame) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StoredGame) GetTournamentId() uint64 {
+	if m != nil {
+		return m.TournamentId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PlayerInfo 记录了玩家的对局统计
+type PlayerInfo struct {
+	// won_count 为玩家获胜的对局数
+	WonCount uint64 `protobuf:"varint,1,opt,name=won_count,json=wonCount,proto3" json:"won_count,omitempty"`
+	// lost_count 为玩家落败的对局数
+	LostCount uint64 `protobuf:"varint,2,opt,name=lost_count,json=lostCount,proto3" json:"lost_count,omitempty"`
+	// drawn_count 为玩家和棋的对局数
+	DrawnCount uint64 `protobuf:"varint,3,opt,name=drawn_count,json=drawnCount,proto3" json:"drawn_count,omitempty"`
+	// rating 为玩家的 Elo 等级分，新玩家为 DefaultRating
+	Rating uint64 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordList) > 0 {
-		for iNdEx := len(m.RecordList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RecordList[iNdEx])
-			copy(dAtA[i:], m.RecordList[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.RecordList[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.IndexedStoredGameList) > 0 {
-		for iNdEx := len(m.IndexedStoredGameList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IndexedStoredGameList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *PlayerInfo) Reset()         { *m = PlayerInfo{} }
+func (m *PlayerInfo) String() string { return proto.CompactTextString(m) }
+func (*PlayerInfo) ProtoMessage()    {}
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{3}
+}
+func (m *PlayerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlayerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlayerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PlayerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerInfo.Merge(m, src)
+}
+func (m *PlayerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlayerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerInfo.DiscardUnknown(m)
 }
 
-func (m *StoredGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlayerInfo proto.InternalMessageInfo
+
+func (m *PlayerInfo) GetWonCount() uint64 {
+	if m != nil {
+		return m.WonCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StoredGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlayerInfo) GetLostCount() uint64 {
+	if m != nil {
+		return m.LostCount
+	}
+	return 0
 }
 
-func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Red) > 0 {
-		i -= len(m.Red)
-		copy(dAtA[i:], m.Red)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Red)))
-		i--
-		dAtA[i] = 0x22
+func (m *PlayerInfo) GetDrawnCount() uint64 {
+	if m != nil {
+		return m.DrawnCount
 	}
-	if len(m.Black) > 0 {
-		i -= len(m.Black)
-		copy(dAtA[i:], m.Black)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Black)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *PlayerInfo) GetRating() uint64 {
+	if m != nil {
+		return m.Rating
 	}
-	if len(m.Turn) > 0 {
-		i -= len(m.Turn)
-		copy(dAtA[i:], m.Turn)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Turn)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// IndexedPlayerInfo 为 PlayerInfo 的包装，用于索引
+type IndexedPlayerInfo struct {
+	Player     string     `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	PlayerInfo PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo"`
+}
+
+func (m *IndexedPlayerInfo) Reset()         { *m = IndexedPlayerInfo{} }
+func (m *IndexedPlayerInfo) String() string { return proto.CompactTextString(m) }
+func (*IndexedPlayerInfo) ProtoMessage()    {}
+func (*IndexedPlayerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{4}
+}
+func (m *IndexedPlayerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedPlayerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedPlayerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Board) > 0 {
-		i -= len(m.Board)
-		copy(dAtA[i:], m.Board)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Board)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IndexedPlayerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedPlayerInfo.Merge(m, src)
+}
+func (m *IndexedPlayerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedPlayerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedPlayerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedPlayerInfo proto.InternalMessageInfo
+
+func (m *IndexedPlayerInfo) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IndexedStoredGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IndexedPlayerInfo) GetPlayerInfo() PlayerInfo {
+	if m != nil {
+		return m.PlayerInfo
 	}
-	return dAtA[:n], nil
+	return PlayerInfo{}
 }
 
-func (m *IndexedStoredGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueueEntry 为匹配队列中的一位玩家
+type QueueEntry struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// rating 为玩家进入队列时的等级分
+	Rating uint64 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	// turn_duration 为玩家希望的每一步棋的时限（区块数），只与时限相同的玩家匹配
+	TurnDuration uint64 `protobuf:"varint,3,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// wager 为玩家希望的赌注，只与赌注相同的玩家匹配，进入队列时托管
+	Wager types.Coin `protobuf:"bytes,4,opt,name=wager,proto3" json:"wager"`
+	// joined_height 为玩家进入队列时的区块高度
+	JoinedHeight int64 `protobuf:"varint,5,opt,name=joined_height,json=joinedHeight,proto3" json:"joined_height,omitempty"`
 }
 
-func (m *IndexedStoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StoredGame.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueueEntry) Reset()         { *m = QueueEntry{} }
+func (m *QueueEntry) String() string { return proto.CompactTextString(m) }
+func (*QueueEntry) ProtoMessage()    {}
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{5}
+}
+func (m *QueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEntry.Merge(m, src)
+}
+func (m *QueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEntry.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueueEntry proto.InternalMessageInfo
+
+func (m *QueueEntry) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueueEntry) GetRating() uint64 {
+	if m != nil {
+		return m.Rating
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.IndexedStoredGameList) > 0 {
-		for _, e := range m.IndexedStoredGameList {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+func (m *QueueEntry) GetTurnDuration() uint64 {
+	if m != nil {
+		return m.TurnDuration
 	}
-	if len(m.RecordList) > 0 {
-		for _, s := range m.RecordList {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	return 0
+}
+
+func (m *QueueEntry) GetWager() types.Coin {
+	if m != nil {
+		return m.Wager
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *StoredGame) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueEntry) GetJoinedHeight() int64 {
+	if m != nil {
+		return m.JoinedHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Board)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// IndexedStoredGame 为 StoredGame 的包装，用于索引
+type IndexedStoredGame struct {
+	Index      string     `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	StoredGame StoredGame `protobuf:"bytes,2,opt,name=storedGame,proto3" json:"storedGame"`
+}
+
+func (m *IndexedStoredGame) Reset()         { *m = IndexedStoredGame{} }
+func (m *IndexedStoredGame) String() string { return proto.CompactTextString(m) }
+func (*IndexedStoredGame) ProtoMessage()    {}
+func (*IndexedStoredGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{6}
+}
+func (m *IndexedStoredGame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedStoredGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedStoredGame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Turn)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *IndexedStoredGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedStoredGame.Merge(m, src)
+}
+func (m *IndexedStoredGame) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedStoredGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedStoredGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedStoredGame proto.InternalMessageInfo
+
+func (m *IndexedStoredGame) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.Black)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *IndexedStoredGame) GetStoredGame() StoredGame {
+	if m != nil {
+		return m.StoredGame
 	}
-	l = len(m.Red)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return StoredGame{}
+}
+
+// Tournament 定义了一个锦标赛
+type Tournament struct {
+	Id      uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string           `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Format  TournamentFormat `protobuf:"varint,4,opt,name=format,proto3,enum=buzzing.checkers.v1.TournamentFormat" json:"format,omitempty"`
+	Status  TournamentStatus `protobuf:"varint,5,opt,name=status,proto3,enum=buzzing.checkers.v1.TournamentStatus" json:"status,omitempty"`
+	// sanctioned 为 true 时表示由 authority 创建的官方锦标赛，只有官方锦标赛的对局计入等级分
+	Sanctioned bool `protobuf:"varint,6,opt,name=sanctioned,proto3" json:"sanctioned,omitempty"`
+	// entry_fee 为每位玩家报名时托管的报名费，可选
+	EntryFee types.Coin `protobuf:"bytes,7,opt,name=entry_fee,json=entryFee,proto3" json:"entry_fee"`
+	// prize_pool 为奖池，即所有玩家的报名费之和
+	PrizePool types.Coin `protobuf:"bytes,8,opt,name=prize_pool,json=prizePool,proto3" json:"prize_pool"`
+	// prize_shares 为各名次分得奖池的百分比，从第一名开始，总和为 100
+	PrizeShares []uint32 `protobuf:"varint,9,rep,packed,name=prize_shares,json=prizeShares,proto3" json:"prize_shares,omitempty"`
+	// max_players 为报名人数上限
+	MaxPlayers uint64 `protobuf:"varint,10,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	// start_height 为报名截止、第一轮开始的区块高度
+	StartHeight int64 `protobuf:"varint,11,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// turn_duration 为锦标赛中每一步棋的时限（区块数），保证每一轮都能结束
+	TurnDuration uint64 `protobuf:"varint,12,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"`
+	// rounds 为总轮数，循环赛在开始时根据人数计算
+	Rounds uint32 `protobuf:"varint,13,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// current_round 为当前轮次，从 1 开始，报名期间为 0
+	CurrentRound uint32 `protobuf:"varint,14,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
+	// round_games 为当前轮次所有游戏的索引
+	RoundGames []string `protobuf:"bytes,15,rep,name=round_games,json=roundGames,proto3" json:"round_games,omitempty"`
+	// players 为按报名顺序排列的参赛玩家
+	Players []string `protobuf:"bytes,16,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (m *Tournament) Reset()         { *m = Tournament{} }
+func (m *Tournament) String() string { return proto.CompactTextString(m) }
+func (*Tournament) ProtoMessage()    {}
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{7}
+}
+func (m *Tournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tournament.Merge(m, src)
+}
+func (m *Tournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tournament proto.InternalMessageInfo
+
+func (m *Tournament) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Tournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Tournament) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tournament) GetFormat() TournamentFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TournamentFormat_TOURNAMENT_FORMAT_UNSPECIFIED
+}
+
+func (m *Tournament) GetStatus() TournamentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TournamentStatus_TOURNAMENT_STATUS_UNSPECIFIED
+}
+
+func (m *Tournament) GetSanctioned() bool {
+	if m != nil {
+		return m.Sanctioned
+	}
+	return false
+}
+
+func (m *Tournament) GetEntryFee() types.Coin {
+	if m != nil {
+		return m.EntryFee
+	}
+	return types.Coin{}
+}
+
+func (m *Tournament) GetPrizePool() types.Coin {
+	if m != nil {
+		return m.PrizePool
+	}
+	return types.Coin{}
+}
+
+func (m *Tournament) GetPrizeShares() []uint32 {
+	if m != nil {
+		return m.PrizeShares
+	}
+	return nil
+}
+
+func (m *Tournament) GetMaxPlayers() uint64 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
+}
+
+func (m *Tournament) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Tournament) GetTurnDuration() uint64 {
+	if m != nil {
+		return m.TurnDuration
+	}
+	return 0
+}
+
+func (m *Tournament) GetRounds() uint32 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+func (m *Tournament) GetCurrentRound() uint32 {
+	if m != nil {
+		return m.CurrentRound
+	}
+	return 0
+}
+
+func (m *Tournament) GetRoundGames() []string {
+	if m != nil {
+		return m.RoundGames
+	}
+	return nil
+}
+
+func (m *Tournament) GetPlayers() []string {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+// TournamentResult 为参赛玩家一轮的结果
+type TournamentResult struct {
+	Round uint32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// opponent 为对手，为空时表示本轮轮空
+	Opponent string `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	// color 为玩家执子的颜色，轮空时为空
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// points 为本轮得分，以半分为单位：胜 2，和 1，负 0，轮空 2
+	Points uint32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	// game_index 为本轮的游戏索引，轮空时为空
+	GameIndex string `protobuf:"bytes,5,opt,name=game_index,json=gameIndex,proto3" json:"game_index,omitempty"`
+}
+
+func (m *TournamentResult) Reset()         { *m = TournamentResult{} }
+func (m *TournamentResult) String() string { return proto.CompactTextString(m) }
+func (*TournamentResult) ProtoMessage()    {}
+func (*TournamentResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{8}
+}
+func (m *TournamentResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentResult.Merge(m, src)
+}
+func (m *TournamentResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentResult proto.InternalMessageInfo
+
+func (m *TournamentResult) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TournamentResult) GetOpponent() string {
+	if m != nil {
+		return m.Opponent
+	}
+	return ""
+}
+
+func (m *TournamentResult) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *TournamentResult) GetPoints() uint32 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *TournamentResult) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+// TournamentPlayer 为锦标赛的参赛玩家及其成绩
+type TournamentPlayer struct {
+	TournamentId uint64 `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Player       string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// score 为总得分，以半分为单位
+	Score uint64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// buchholz 为所有对手的总得分之和，以半分为单位，轮空不计
+	Buchholz uint64 `protobuf:"varint,4,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+	// sonneborn_berger 为每局得分与对手总得分乘积之和，以四分之一分为单位，轮空不计
+	SonnebornBerger uint64             `protobuf:"varint,5,opt,name=sonneborn_berger,json=sonnebornBerger,proto3" json:"sonneborn_berger,omitempty"`
+	Results         []TournamentResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results"`
+}
+
+func (m *TournamentPlayer) Reset()         { *m = TournamentPlayer{} }
+func (m *TournamentPlayer) String() string { return proto.CompactTextString(m) }
+func (*TournamentPlayer) ProtoMessage()    {}
+func (*TournamentPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{9}
+}
+func (m *TournamentPlayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentPlayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentPlayer.Merge(m, src)
+}
+func (m *TournamentPlayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentPlayer proto.InternalMessageInfo
+
+func (m *TournamentPlayer) GetTournamentId() uint64 {
+	if m != nil {
+		return m.TournamentId
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *TournamentPlayer) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetBuchholz() uint64 {
+	if m != nil {
+		return m.Buchholz
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetSonnebornBerger() uint64 {
+	if m != nil {
+		return m.SonnebornBerger
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetResults() []TournamentResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Record 为一条 record，按 id 保存，并按作者和区块高度建立索引
+type Record struct {
+	// id 为 record 的唯一编号，按添加的顺序递增
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height 和 time 为添加 record 的区块高度和区块头中的时间
+	Height int64        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time    `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Source RecordSource `protobuf:"varint,4,opt,name=source,proto3,enum=buzzing.checkers.v1.RecordSource" json:"source,omitempty"`
+	Text   string       `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// creator 为通过 MsgAddRecord 添加 record 的作者，由模块添加时为空
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	// tags 为作者为 record 添加的标签，可以为空
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// prev_hash 为前一条 record 的 hash，第一条 record 为空
+	PrevHash []byte `protobuf:"bytes,8,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	// hash 为清空 hash 字段后 record 的 protobuf 编码的 SHA-256，包含 prev_hash，从而将 record 连接成链
+	Hash []byte `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *Record) Reset()         { *m = Record{} }
+func (m *Record) String() string { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()    {}
+func (*Record) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{10}
+}
+func (m *Record) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record.Merge(m, src)
+}
+func (m *Record) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record proto.InternalMessageInfo
+
+func (m *Record) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Record) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Record) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Record) GetSource() RecordSource {
+	if m != nil {
+		return m.Source
+	}
+	return RecordSource_RECORD_SOURCE_UNSPECIFIED
+}
+
+func (m *Record) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Record) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Record) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Record) GetPrevHash() []byte {
+	if m != nil {
+		return m.PrevHash
+	}
+	return nil
+}
+
+func (m *Record) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// RecordLogHead 为 record 链的头部，即最后添加的 record
+type RecordLogHead struct {
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *RecordLogHead) Reset()         { *m = RecordLogHead{} }
+func (m *RecordLogHead) String() string { return proto.CompactTextString(m) }
+func (*RecordLogHead) ProtoMessage()    {}
+func (*RecordLogHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{11}
+}
+func (m *RecordLogHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordLogHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordLogHead.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordLogHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordLogHead.Merge(m, src)
+}
+func (m *RecordLogHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordLogHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordLogHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordLogHead proto.InternalMessageInfo
+
+func (m *RecordLogHead) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecordLogHead) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// RecordRateCounter 记录一个账户在一个区块窗口内通过 MsgAddRecord 添加的 record 数量
+type RecordRateCounter struct {
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// window_start 为窗口的第一个区块的高度
+	WindowStart int64  `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Count       uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *RecordRateCounter) Reset()         { *m = RecordRateCounter{} }
+func (m *RecordRateCounter) String() string { return proto.CompactTextString(m) }
+func (*RecordRateCounter) ProtoMessage()    {}
+func (*RecordRateCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{12}
+}
+func (m *RecordRateCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordRateCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordRateCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordRateCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordRateCounter.Merge(m, src)
+}
+func (m *RecordRateCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordRateCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordRateCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordRateCounter proto.InternalMessageInfo
+
+func (m *RecordRateCounter) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *RecordRateCounter) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *RecordRateCounter) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// RemoteRecord 为通过 IBC 从其他链收到的 record，按 (channel, sequence) 保存
+type RemoteRecord struct {
+	// channel 为本链接收数据包的通道，不同的对方链可能使用相同的通道 id，因此不使用对方的通道作为键
+	Channel  string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// source_port 和 source_channel 为对方链发送数据包的端口和通道
+	SourcePort    string `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// sender 为对方链上发送 record 的账户，使用对方链的地址前缀
+	Sender     string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	RecordData string `protobuf:"bytes,6,opt,name=record_data,json=recordData,proto3" json:"record_data,omitempty"`
+	// height 和 time 为本链收到数据包的区块高度和区块头中的时间
+	Height int64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RemoteRecord) Reset()         { *m = RemoteRecord{} }
+func (m *RemoteRecord) String() string { return proto.CompactTextString(m) }
+func (*RemoteRecord) ProtoMessage()    {}
+func (*RemoteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{13}
+}
+func (m *RemoteRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteRecord.Merge(m, src)
+}
+func (m *RemoteRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteRecord proto.InternalMessageInfo
+
+func (m *RemoteRecord) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RemoteRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *RemoteRecord) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *RemoteRecord) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *RemoteRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RemoteRecord) GetRecordData() string {
+	if m != nil {
+		return m.RecordData
+	}
+	return ""
+}
+
+func (m *RemoteRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RemoteRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// OutgoingPacket 为本链发送的数据包，按 (channel, sequence) 保存
+type OutgoingPacket struct {
+	Port     string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel  string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender 为发送数据包的账户，只有 sender 可以重新发送超时的数据包
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// sent_height 为发送数据包的区块高度
+	SentHeight            int64                `protobuf:"varint,6,opt,name=sent_height,json=sentHeight,proto3" json:"sent_height,omitempty"`
+	TimeoutRevisionNumber uint64               `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64               `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	TimeoutTimestamp      uint64               `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Status                OutgoingPacketStatus `protobuf:"varint,10,opt,name=status,proto3,enum=buzzing.checkers.v1.OutgoingPacketStatus" json:"status,omitempty"`
+	// result 为对方链返回的确认中的结果或错误信息
+	Result string `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
+	// resolved_height 为收到确认或超时的区块高度，处于 PENDING 状态时为 0
+	ResolvedHeight int64 `protobuf:"varint,12,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// data 为发送的 CheckersPacketData 的编码，与数据包中的数据相同
+	Data []byte `protobuf:"bytes,13,opt,name=data,proto3" json:"data,omitempty"`
+	// escrowed_fee 为发送 record 时托管在模块账户中的费用，denom 为空时表示没有费用
+	// 收到成功的确认后按 fee_destination 销毁或转入社区池，失败或超时时退回 sender
+	EscrowedFee    types.Coin           `protobuf:"bytes,14,opt,name=escrowed_fee,json=escrowedFee,proto3" json:"escrowed_fee"`
+	FeeDestination RecordFeeDestination `protobuf:"varint,15,opt,name=fee_destination,json=feeDestination,proto3,enum=buzzing.checkers.v1.RecordFeeDestination" json:"fee_destination,omitempty"`
+	// retry_sequence 为重新发送这个数据包时使用的序号，没有重新发送时为 0
+	RetrySequence uint64 `protobuf:"varint,16,opt,name=retry_sequence,json=retrySequence,proto3" json:"retry_sequence,omitempty"`
+}
+
+func (m *OutgoingPacket) Reset()         { *m = OutgoingPacket{} }
+func (m *OutgoingPacket) String() string { return proto.CompactTextString(m) }
+func (*OutgoingPacket) ProtoMessage()    {}
+func (*OutgoingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{14}
+}
+func (m *OutgoingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingPacket.Merge(m, src)
+}
+func (m *OutgoingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingPacket proto.InternalMessageInfo
+
+func (m *OutgoingPacket) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *OutgoingPacket) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *OutgoingPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *OutgoingPacket) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetStatus() OutgoingPacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OutgoingPacketStatus_OUTGOING_PACKET_STATUS_UNSPECIFIED
+}
+
+func (m *OutgoingPacket) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *OutgoingPacket) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func (m *OutgoingPacket) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *OutgoingPacket) GetEscrowedFee() types.Coin {
+	if m != nil {
+		return m.EscrowedFee
+	}
+	return types.Coin{}
+}
+
+func (m *OutgoingPacket) GetFeeDestination() RecordFeeDestination {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return RecordFeeDestination_RECORD_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (m *OutgoingPacket) GetRetrySequence() uint64 {
+	if m != nil {
+		return m.RetrySequence
+	}
+	return 0
+}
+
+// IbcChannel 为模块的端口上已经开启的通道，在通道握手完成时保存，按 channel_id 保存
+type IbcChannel struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// counterparty_port_id 和 counterparty_channel_id 为对方链上的端口和通道
+	CounterpartyPortId    string   `protobuf:"bytes,3,opt,name=counterparty_port_id,json=counterpartyPortId,proto3" json:"counterparty_port_id,omitempty"`
+	CounterpartyChannelId string   `protobuf:"bytes,4,opt,name=counterparty_channel_id,json=counterpartyChannelId,proto3" json:"counterparty_channel_id,omitempty"`
+	ConnectionHops        []string `protobuf:"bytes,5,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty"`
+	Version               string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// opened_height 为通道握手完成的区块高度
+	OpenedHeight int64 `protobuf:"varint,7,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
+	// packets_sent 为本链在这个通道上发送的数据包数量
+	PacketsSent uint64 `protobuf:"varint,8,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	// packets_received 为本链在这个通道上成功处理的数据包数量
+	PacketsReceived uint64 `protobuf:"varint,9,opt,name=packets_received,json=packetsReceived,proto3" json:"packets_received,omitempty"`
+	// packets_failed 为本链发送后对方链返回错误或超时的数据包数量
+	PacketsFailed uint64 `protobuf:"varint,10,opt,name=packets_failed,json=packetsFailed,proto3" json:"packets_failed,omitempty"`
+	// closed 表示通道已经被关闭，关闭的通道不能再发送数据包
+	Closed bool `protobuf:"varint,11,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (m *IbcChannel) Reset()         { *m = IbcChannel{} }
+func (m *IbcChannel) String() string { return proto.CompactTextString(m) }
+func (*IbcChannel) ProtoMessage()    {}
+func (*IbcChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{15}
+}
+func (m *IbcChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcChannel.Merge(m, src)
+}
+func (m *IbcChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcChannel proto.InternalMessageInfo
+
+func (m *IbcChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IbcChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IbcChannel) GetCounterpartyPortId() string {
+	if m != nil {
+		return m.CounterpartyPortId
+	}
+	return ""
+}
+
+func (m *IbcChannel) GetCounterpartyChannelId() string {
+	if m != nil {
+		return m.CounterpartyChannelId
+	}
+	return ""
+}
+
+func (m *IbcChannel) GetConnectionHops() []string {
+	if m != nil {
+		return m.ConnectionHops
+	}
+	return nil
+}
+
+func (m *IbcChannel) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *IbcChannel) GetOpenedHeight() int64 {
+	if m != nil {
+		return m.OpenedHeight
+	}
+	return 0
+}
+
+func (m *IbcChannel) GetPacketsSent() uint64 {
+	if m != nil {
+		return m.PacketsSent
+	}
+	return 0
+}
+
+func (m *IbcChannel) GetPacketsReceived() uint64 {
+	if m != nil {
+		return m.PacketsReceived
+	}
+	return 0
+}
+
+func (m *IbcChannel) GetPacketsFailed() uint64 {
+	if m != nil {
+		return m.PacketsFailed
+	}
+	return 0
+}
+
+func (m *IbcChannel) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+// IbcGame 为与另一条链上的玩家进行的游戏，两条链各自保存一份，按 (channel, id) 保存
+// 玩家在本链上的操作立即生效，并通过数据包发送到对方链；对方链返回错误或数据包超时后，
+// 游戏恢复到操作之前的状态
+type IbcGame struct {
+	// channel 为本链与对方链通信的通道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// id 为本链上的游戏 id，remote_id 为对方链上的游戏 id
+	// 本链发出的邀请在收到确认之前 remote_id 为 0
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RemoteId uint64 `protobuf:"varint,3,opt,name=remote_id,json=remoteId,proto3" json:"remote_id,omitempty"`
+	// local_player 为本链上的玩家，remote_player 为对方链上的玩家
+	LocalPlayer  string `protobuf:"bytes,4,opt,name=local_player,json=localPlayer,proto3" json:"local_player,omitempty"`
+	RemotePlayer string `protobuf:"bytes,5,opt,name=remote_player,json=remotePlayer,proto3" json:"remote_player,omitempty"`
+	// local_color 为本链上的玩家执的棋子颜色
+	LocalColor string `protobuf:"bytes,6,opt,name=local_color,json=localColor,proto3" json:"local_color,omitempty"`
+	// game 为游戏的状态，black 和 red 分别为双方在各自链上的地址
+	// 跨链游戏没有赌注和每一步棋的时限，也不计入对局统计
+	Game StoredGame `protobuf:"bytes,7,opt,name=game,proto3" json:"game"`
+	// pending_sequence 为本链发出、还没有收到确认的数据包的序号，为 0 时没有等待确认的数据包
+	PendingSequence uint64 `protobuf:"varint,8,opt,name=pending_sequence,json=pendingSequence,proto3" json:"pending_sequence,omitempty"`
+	// rollback 为发送 pending_sequence 之前游戏的状态，数据包失败时恢复
+	// 本链发出的邀请失败时游戏被删除，此时 rollback 为空
+	Rollback *StoredGame `protobuf:"bytes,9,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	// updated_time 为游戏最后一次改变的区块时间，本链发出的数据包在收到确认时更新
+	// 用于判断对手是否超时以及邀请是否过期
+	UpdatedTime time.Time `protobuf:"bytes,10,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updated_time"`
+}
+
+func (m *IbcGame) Reset()         { *m = IbcGame{} }
+func (m *IbcGame) String() string { return proto.CompactTextString(m) }
+func (*IbcGame) ProtoMessage()    {}
+func (*IbcGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70dac21e2ab53885, []int{16}
+}
+func (m *IbcGame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcGame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcGame.Merge(m, src)
+}
+func (m *IbcGame) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcGame proto.InternalMessageInfo
+
+func (m *IbcGame) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *IbcGame) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IbcGame) GetRemoteId() uint64 {
+	if m != nil {
+		return m.RemoteId
+	}
+	return 0
+}
+
+func (m *IbcGame) GetLocalPlayer() string {
+	if m != nil {
+		return m.LocalPlayer
+	}
+	return ""
+}
+
+func (m *IbcGame) GetRemotePlayer() string {
+	if m != nil {
+		return m.RemotePlayer
+	}
+	return ""
+}
+
+func (m *IbcGame) GetLocalColor() string {
+	if m != nil {
+		return m.LocalColor
+	}
+	return ""
+}
+
+func (m *IbcGame) GetGame() StoredGame {
+	if m != nil {
+		return m.Game
+	}
+	return StoredGame{}
+}
+
+func (m *IbcGame) GetPendingSequence() uint64 {
+	if m != nil {
+		return m.PendingSequence
+	}
+	return 0
+}
+
+func (m *IbcGame) GetRollback() *StoredGame {
+	if m != nil {
+		return m.Rollback
+	}
+	return nil
+}
+
+func (m *IbcGame) GetUpdatedTime() time.Time {
+	if m != nil {
+		return m.UpdatedTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("buzzing.checkers.v1.RecordFeeDestination", RecordFeeDestination_name, RecordFeeDestination_value)
+	proto.RegisterEnum("buzzing.checkers.v1.GameStatus", GameStatus_name, GameStatus_value)
+	proto.RegisterEnum("buzzing.checkers.v1.TournamentFormat", TournamentFormat_name, TournamentFormat_value)
+	proto.RegisterEnum("buzzing.checkers.v1.TournamentStatus", TournamentStatus_name, TournamentStatus_value)
+	proto.RegisterEnum("buzzing.checkers.v1.RecordSource", RecordSource_name, RecordSource_value)
+	proto.RegisterEnum("buzzing.checkers.v1.OutgoingPacketStatus", OutgoingPacketStatus_name, OutgoingPacketStatus_value)
+	proto.RegisterType((*Params)(nil), "buzzing.checkers.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "buzzing.checkers.v1.GenesisState")
+	proto.RegisterType((*StoredGame)(nil), "buzzing.checkers.v1.StoredGame")
+	proto.RegisterType((*PlayerInfo)(nil), "buzzing.checkers.v1.PlayerInfo")
+	proto.RegisterType((*IndexedPlayerInfo)(nil), "buzzing.checkers.v1.IndexedPlayerInfo")
+	proto.RegisterType((*QueueEntry)(nil), "buzzing.checkers.v1.QueueEntry")
+	proto.RegisterType((*IndexedStoredGame)(nil), "buzzing.checkers.v1.IndexedStoredGame")
+	proto.RegisterType((*Tournament)(nil), "buzzing.checkers.v1.Tournament")
+	proto.RegisterType((*TournamentResult)(nil), "buzzing.checkers.v1.TournamentResult")
+	proto.RegisterType((*TournamentPlayer)(nil), "buzzing.checkers.v1.TournamentPlayer")
+	proto.RegisterType((*Record)(nil), "buzzing.checkers.v1.Record")
+	proto.RegisterType((*RecordLogHead)(nil), "buzzing.checkers.v1.RecordLogHead")
+	proto.RegisterType((*RecordRateCounter)(nil), "buzzing.checkers.v1.RecordRateCounter")
+	proto.RegisterType((*RemoteRecord)(nil), "buzzing.checkers.v1.RemoteRecord")
+	proto.RegisterType((*OutgoingPacket)(nil), "buzzing.checkers.v1.OutgoingPacket")
+	proto.RegisterType((*IbcChannel)(nil), "buzzing.checkers.v1.IbcChannel")
+	proto.RegisterType((*IbcGame)(nil), "buzzing.checkers.v1.IbcGame")
+}
+
+func init() { proto.RegisterFile("buzzing/checkers/v1/types.proto", fileDescriptor_70dac21e2ab53885) }
+
+var fileDescriptor_70dac21e2ab53885 = []byte{
+	// 2989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x37, 0x29, 0x8a, 0x22, 0x1f, 0xff, 0x88, 0x9e, 0xc8, 0x36, 0x6d, 0xd7, 0x92, 0x4c, 0xe7,
+	0x8f, 0xec, 0x34, 0x54, 0xec, 0x20, 0x69, 0xd2, 0xb4, 0x01, 0x28, 0x72, 0x25, 0xb1, 0x91, 0x48,
+	0x65, 0x48, 0xd5, 0x4d, 0x51, 0x60, 0xb1, 0xdc, 0x1d, 0x91, 0x5b, 0x93, 0x3b, 0xcc, 0xee, 0x50,
+	0x92, 0x7d, 0x28, 0x50, 0xa0, 0xa7, 0xa2, 0x05, 0xd2, 0x53, 0x3f, 0x40, 0xbf, 0x41, 0xe1, 0x4b,
+	0x4f, 0xbd, 0xe6, 0x18, 0x04, 0x05, 0x9a, 0xf6, 0x90, 0x16, 0xc9, 0x77, 0xe8, 0xb9, 0x98, 0x37,
+	0xb3, 0xe4, 0x52, 0xa4, 0x24, 0xbb, 0x3d, 0x89, 0xf3, 0x7b, 0xbf, 0xf7, 0x76, 0xe6, 0xcd, 0x9b,
+	0xf7, 0xde, 0x8c, 0x60, 0xad, 0x33, 0x7a, 0xf6, 0xcc, 0xf5, 0xba, 0x9b, 0x76, 0x8f, 0xd9, 0x4f,
+	0x98, 0x1f, 0x6c, 0x1e, 0x3f, 0xdc, 0x14, 0x4f, 0x87, 0x2c, 0x28, 0x0f, 0x7d, 0x2e, 0x38, 0x79,
+	0x45, 0x13, 0xca, 0x21, 0xa1, 0x7c, 0xfc, 0xf0, 0xd6, 0x4d, 0x9b, 0x07, 0x03, 0x1e, 0x98, 0x48,
+	0xd9, 0x54, 0x03, 0xc5, 0xbf, 0xb5, 0xd2, 0xe5, 0x5d, 0xae, 0x70, 0xf9, 0x4b, 0xa3, 0xab, 0x8a,
+	0xb3, 0xd9, 0xb1, 0x02, 0xb6, 0x79, 0xfc, 0xb0, 0xc3, 0x84, 0xf5, 0x70, 0xd3, 0xe6, 0xae, 0xa7,
+	0xe5, 0x6b, 0x5d, 0xce, 0xbb, 0x7d, 0xb6, 0x89, 0xa3, 0xce, 0xe8, 0x68, 0x53, 0xb8, 0x03, 0x16,
+	0x08, 0x6b, 0x30, 0x54, 0x84, 0xd2, 0x3f, 0x92, 0x90, 0x3c, 0xb0, 0x7c, 0x6b, 0x10, 0x90, 0xf7,
+	0xa1, 0xe8, 0x7a, 0xc7, 0xae, 0xb0, 0x84, 0xcb, 0x3d, 0x93, 0x9d, 0x0e, 0x5d, 0xff, 0xa9, 0xd9,
+	0xe9, 0x73, 0xfb, 0x49, 0x50, 0x8c, 0xad, 0xc7, 0x36, 0x12, 0xf4, 0xfa, 0x44, 0x6e, 0xa0, 0x78,
+	0x0b, 0xa5, 0xe4, 0x87, 0x70, 0x73, 0x60, 0x09, 0xbb, 0x37, 0xb0, 0x9e, 0xb8, 0x5e, 0xd7, 0xf4,
+	0x2d, 0x21, 0xff, 0x9c, 0xb8, 0x9e, 0xc3, 0x4f, 0x8a, 0x71, 0x54, 0xbd, 0x11, 0x21, 0x50, 0x94,
+	0x3f, 0x46, 0xf1, 0x59, 0x5d, 0xa5, 0x64, 0x76, 0x7d, 0x7e, 0x22, 0x7a, 0xc5, 0x85, 0x19, 0x5d,
+	0xa5, 0xb5, 0x83, 0x62, 0xf2, 0x00, 0xae, 0x0e, 0xac, 0x53, 0x53, 0x8c, 0x7c, 0xcf, 0x74, 0x46,
+	0x3e, 0x4e, 0xac, 0x98, 0x40, 0x9d, 0xe5, 0x81, 0x75, 0xda, 0x1e, 0xf9, 0x5e, 0x4d, 0xc3, 0x64,
+	0x03, 0x0a, 0x92, 0x6b, 0xd9, 0xc2, 0x3d, 0x66, 0x66, 0xd7, 0x1a, 0xb0, 0xa0, 0xb8, 0x88, 0xd4,
+	0xfc, 0xc0, 0x3a, 0xad, 0x20, 0xbc, 0x23, 0x51, 0xb2, 0x0b, 0xe9, 0x81, 0xeb, 0x99, 0x27, 0x56,
+	0x97, 0xf9, 0xc5, 0xe4, 0x7a, 0x6c, 0x23, 0xbd, 0xf5, 0xe6, 0x17, 0xdf, 0xac, 0x5d, 0xf9, 0xe7,
+	0x37, 0x6b, 0xd7, 0x94, 0xbb, 0x03, 0xe7, 0x49, 0xd9, 0xe5, 0x9b, 0x03, 0x4b, 0xf4, 0xca, 0x75,
+	0x4f, 0x7c, 0xf5, 0xfc, 0x2d, 0xd0, 0x7b, 0x55, 0xf7, 0x04, 0x4d, 0x0d, 0x5c, 0xef, 0xb1, 0x54,
+	0x26, 0x6f, 0xc3, 0x8a, 0xd5, 0xef, 0xf3, 0x13, 0xe6, 0x28, 0x6b, 0xa6, 0xc3, 0x3c, 0x3e, 0x08,
+	0x8a, 0x4b, 0xeb, 0x0b, 0x1b, 0x69, 0x4a, 0xb4, 0x0c, 0xb9, 0x35, 0x94, 0xc8, 0x15, 0xf9, 0xcc,
+	0xe6, 0xbe, 0x63, 0xca, 0xc9, 0xf6, 0x99, 0xd7, 0x15, 0xbd, 0x62, 0x4a, 0xad, 0x48, 0x09, 0xf6,
+	0xad, 0xd3, 0x3d, 0x84, 0xc9, 0x7d, 0x28, 0x68, 0xae, 0xcf, 0x04, 0xf3, 0x70, 0xf1, 0xe9, 0x28,
+	0x95, 0x86, 0x70, 0xd4, 0xac, 0xeb, 0x85, 0x66, 0x61, 0xca, 0xac, 0xeb, 0x69, 0xb3, 0x6f, 0x80,
+	0x86, 0xe4, 0x6c, 0x9f, 0xf6, 0xdd, 0x40, 0x14, 0x33, 0x38, 0xdf, 0xbc, 0x82, 0x6b, 0x1a, 0x8d,
+	0x18, 0xf5, 0x2d, 0xc1, 0xcc, 0xbe, 0x3b, 0x70, 0x45, 0x31, 0x3b, 0x35, 0x01, 0x4b, 0xb0, 0x3d,
+	0x09, 0x93, 0xef, 0x03, 0x89, 0x72, 0x75, 0x68, 0xe4, 0x90, 0x5c, 0x98, 0x90, 0x75, 0x4c, 0x7c,
+	0x04, 0xa0, 0xd9, 0x47, 0x8c, 0x15, 0xf3, 0xeb, 0xb1, 0x8d, 0xcc, 0xa3, 0x9b, 0x65, 0xed, 0x62,
+	0x19, 0xea, 0x65, 0x1d, 0xea, 0xe5, 0x2a, 0x77, 0xbd, 0xad, 0x84, 0xdc, 0x1d, 0x9a, 0x56, 0x2a,
+	0xdb, 0x8c, 0x11, 0x13, 0xae, 0x4f, 0xf4, 0x4d, 0x87, 0x05, 0xc2, 0xf5, 0x54, 0x70, 0x2c, 0xaf,
+	0xc7, 0x36, 0xf2, 0x8f, 0xee, 0x97, 0xe7, 0x1c, 0xbe, 0x32, 0x0d, 0xf5, 0x6b, 0x13, 0x05, 0xba,
+	0xe2, 0xcf, 0x41, 0xc9, 0xab, 0x20, 0x83, 0xc6, 0xfc, 0x6c, 0xc4, 0x46, 0xcc, 0x0c, 0xdc, 0x67,
+	0xac, 0x58, 0xc0, 0xa5, 0x64, 0x07, 0xd6, 0xe9, 0x27, 0x12, 0x6c, 0xb9, 0xcf, 0x58, 0xe9, 0x3f,
+	0x69, 0xc8, 0xee, 0x30, 0x8f, 0x05, 0x6e, 0xd0, 0x12, 0x96, 0x60, 0xe4, 0x03, 0x48, 0x0e, 0xf1,
+	0xac, 0xe1, 0x79, 0xca, 0x3c, 0xba, 0x3d, 0x77, 0x1e, 0xea, 0x38, 0xea, 0x55, 0x69, 0x05, 0xd2,
+	0x81, 0x6b, 0xae, 0xe7, 0xb0, 0x53, 0xe6, 0xb4, 0x04, 0xf7, 0x99, 0x23, 0x43, 0x75, 0x4f, 0xee,
+	0x4d, 0x7c, 0x7d, 0x61, 0x23, 0xf3, 0xe8, 0xf5, 0xb9, 0x96, 0xea, 0x67, 0x35, 0xb4, 0xd1, 0xf9,
+	0xa6, 0x48, 0x29, 0x74, 0x3b, 0x1a, 0x5e, 0x90, 0x9b, 0xbe, 0x15, 0x2f, 0xc6, 0x68, 0x04, 0x8d,
+	0xcc, 0xe3, 0xa0, 0x6f, 0x3d, 0x65, 0x7e, 0xdd, 0x3b, 0xe2, 0x48, 0x4f, 0x5c, 0x3e, 0x8f, 0x89,
+	0xc6, 0x99, 0x79, 0x4c, 0x9b, 0x22, 0xfb, 0x90, 0x47, 0xcf, 0x1a, 0x9e, 0xf0, 0x9f, 0xa2, 0xf1,
+	0x45, 0x34, 0xbe, 0x36, 0xd7, 0xf8, 0x27, 0x63, 0xaa, 0xb6, 0x7a, 0x46, 0x59, 0x9a, 0x13, 0x7c,
+	0xe4, 0x7b, 0xd6, 0x80, 0x79, 0x02, 0xcd, 0x25, 0x2f, 0x30, 0xd7, 0x1e, 0x53, 0x43, 0x73, 0xd3,
+	0xca, 0xc4, 0x84, 0x95, 0x09, 0xa2, 0x66, 0x8e, 0x46, 0x97, 0xd0, 0xe8, 0x6b, 0x97, 0x18, 0x55,
+	0x0a, 0xda, 0xf4, 0x5c, 0x43, 0x64, 0x03, 0x96, 0x27, 0x78, 0x95, 0x8f, 0x3c, 0x11, 0x66, 0x80,
+	0x33, 0x30, 0xf9, 0x10, 0x96, 0xd4, 0xd6, 0x04, 0xc5, 0xf4, 0xfa, 0xc2, 0xb9, 0x01, 0xa5, 0x02,
+	0x5b, 0x7f, 0x33, 0xd4, 0x20, 0xeb, 0x90, 0x51, 0x3f, 0xd5, 0x27, 0x54, 0x36, 0x88, 0x42, 0xe4,
+	0x17, 0x40, 0x26, 0x47, 0x13, 0x21, 0xe6, 0x07, 0xc5, 0xcc, 0x05, 0x1b, 0x4d, 0xcf, 0xd2, 0xf5,
+	0x47, 0xe7, 0xd8, 0x21, 0xbb, 0x90, 0xd3, 0x71, 0xc5, 0xbb, 0xbb, 0xcc, 0x72, 0x30, 0x75, 0x64,
+	0x1e, 0x95, 0x2e, 0x30, 0xac, 0x99, 0x74, 0x5a, 0x91, 0xb4, 0x64, 0x22, 0x1c, 0x70, 0xc1, 0xe8,
+	0x24, 0x7a, 0x73, 0x38, 0xcb, 0xbb, 0xe7, 0x18, 0x9b, 0x90, 0xf5, 0x04, 0x67, 0x0c, 0x90, 0x4f,
+	0x81, 0xf0, 0x91, 0xe8, 0x72, 0xd7, 0xeb, 0x1e, 0x58, 0xf6, 0x13, 0xa6, 0x22, 0x27, 0x8f, 0x66,
+	0xef, 0xcd, 0x35, 0xdb, 0x9c, 0xa2, 0x87, 0x2b, 0x9f, 0x35, 0x42, 0x6a, 0x90, 0x71, 0x3b, 0xf6,
+	0xf8, 0x04, 0x2f, 0xa3, 0xcd, 0xef, 0xcd, 0x3f, 0x39, 0x8a, 0xa7, 0x8d, 0x45, 0xd5, 0x48, 0x09,
+	0xb2, 0x7a, 0xa8, 0x36, 0x50, 0x67, 0xa0, 0x28, 0x26, 0x43, 0xdf, 0xed, 0xd8, 0xd5, 0x9e, 0xe5,
+	0x79, 0xac, 0x8f, 0x1f, 0xbb, 0x7a, 0x41, 0xe8, 0xd7, 0xc7, 0xd4, 0x30, 0xf4, 0xa7, 0x95, 0xc9,
+	0xeb, 0x90, 0x77, 0xd8, 0x91, 0x35, 0xea, 0x0b, 0x8d, 0x16, 0x89, 0x2c, 0x8f, 0xf4, 0x0c, 0x5a,
+	0xfa, 0x3a, 0x01, 0x30, 0xc9, 0x2d, 0x64, 0x05, 0x16, 0x3b, 0xdc, 0xf2, 0x1d, 0xcc, 0x7a, 0x69,
+	0xaa, 0x06, 0x84, 0x40, 0x42, 0x16, 0x6e, 0xec, 0x0f, 0xd2, 0x14, 0x7f, 0x93, 0x32, 0x2c, 0x76,
+	0xfa, 0x96, 0xfd, 0x04, 0x0b, 0x7f, 0x7a, 0xab, 0xf8, 0xd5, 0xf3, 0xb7, 0x56, 0x74, 0xda, 0xaf,
+	0x38, 0x8e, 0xcf, 0x82, 0xa0, 0x25, 0x7c, 0xd9, 0x41, 0x28, 0x1a, 0x79, 0x00, 0x0b, 0x3e, 0x73,
+	0x8a, 0x89, 0x4b, 0xd8, 0x92, 0x44, 0x7e, 0x00, 0xc9, 0x40, 0x58, 0x62, 0xa4, 0xca, 0x7e, 0xfe,
+	0x1c, 0x1f, 0xc8, 0x09, 0xb7, 0x90, 0x46, 0x35, 0x9d, 0x5c, 0x87, 0xe4, 0x89, 0xeb, 0x79, 0x61,
+	0x33, 0x40, 0xf5, 0x88, 0xdc, 0x01, 0x18, 0xf0, 0x63, 0x66, 0xda, 0xe8, 0xfe, 0x25, 0x74, 0x7f,
+	0x5a, 0x22, 0xca, 0xf7, 0x77, 0x00, 0x1c, 0xdf, 0x3a, 0x31, 0xf9, 0xd1, 0x11, 0xf3, 0xf1, 0x04,
+	0xa7, 0x69, 0x5a, 0x22, 0x4d, 0x09, 0x90, 0xd7, 0x20, 0x8f, 0x6b, 0x30, 0x2d, 0xdb, 0x66, 0x43,
+	0xc1, 0x1c, 0xac, 0xdd, 0x29, 0x9a, 0x43, 0xb4, 0xa2, 0x41, 0x72, 0x17, 0xb2, 0x3e, 0x73, 0x26,
+	0x24, 0x40, 0x52, 0xc6, 0x67, 0xce, 0x98, 0xb2, 0x09, 0xaf, 0x44, 0xfa, 0x36, 0x87, 0x59, 0x4e,
+	0xdf, 0xf5, 0x58, 0x31, 0xb3, 0x1e, 0xdb, 0x58, 0xa0, 0x64, 0x22, 0xaa, 0x69, 0x09, 0x79, 0x17,
+	0x16, 0x55, 0x73, 0x93, 0x7d, 0xb1, 0xca, 0xaa, 0xd8, 0xb8, 0x5e, 0xd7, 0xd3, 0xdd, 0x9d, 0xae,
+	0xdd, 0xb2, 0x53, 0x52, 0xed, 0x1c, 0xb9, 0x07, 0xb9, 0xe9, 0x46, 0x2c, 0xaf, 0x02, 0x52, 0x44,
+	0xbb, 0xb0, 0x5b, 0x90, 0x1a, 0x4f, 0x70, 0x19, 0x27, 0x38, 0x1e, 0xa3, 0x81, 0x71, 0x82, 0x33,
+	0x5d, 0x27, 0x8c, 0xe8, 0x09, 0x58, 0x77, 0x4a, 0xbf, 0x8e, 0x01, 0x4c, 0xca, 0x05, 0xb9, 0x0d,
+	0xe9, 0x13, 0xee, 0xe9, 0x2d, 0x50, 0x4d, 0x6a, 0xea, 0x84, 0x7b, 0xe3, 0x1d, 0xe8, 0xf3, 0x40,
+	0x68, 0xa9, 0xea, 0x43, 0xd3, 0x12, 0x51, 0xe2, 0x35, 0xc8, 0xc8, 0xed, 0x08, 0xb5, 0x55, 0xaf,
+	0x89, 0x7b, 0xa6, 0xf5, 0xaf, 0x43, 0x52, 0x2f, 0x56, 0xf5, 0x94, 0x7a, 0x54, 0xfa, 0x5d, 0x0c,
+	0xae, 0xce, 0x94, 0x34, 0xf2, 0x36, 0x24, 0x87, 0x38, 0x2a, 0xc6, 0x2e, 0x09, 0x47, 0xcd, 0x23,
+	0x06, 0xc0, 0x70, 0xac, 0x8f, 0xf3, 0x3b, 0xef, 0x64, 0xce, 0x54, 0xce, 0x88, 0x62, 0xe9, 0xef,
+	0x31, 0x80, 0x49, 0x11, 0xfc, 0x1f, 0xe6, 0x31, 0x59, 0x67, 0x3c, 0xba, 0xce, 0xd9, 0x1d, 0x5d,
+	0x98, 0xb3, 0xa3, 0xe3, 0x60, 0x4a, 0xbc, 0x54, 0x30, 0xdd, 0x83, 0xdc, 0x2f, 0xb9, 0xeb, 0x31,
+	0xc7, 0xec, 0x31, 0xb7, 0xdb, 0x13, 0x78, 0x28, 0x17, 0x68, 0x56, 0x81, 0xbb, 0x88, 0x95, 0x86,
+	0x63, 0x3f, 0x4f, 0x67, 0x13, 0x6c, 0x1b, 0xc2, 0x6c, 0x82, 0x03, 0xe9, 0xcb, 0x60, 0xcc, 0xb9,
+	0xd0, 0x97, 0x33, 0xdd, 0x50, 0x44, 0xb1, 0xf4, 0x9b, 0x45, 0x80, 0x49, 0xb1, 0x26, 0x79, 0x88,
+	0xbb, 0x8e, 0x8e, 0xab, 0xb8, 0xeb, 0x90, 0x47, 0xb0, 0x64, 0xfb, 0xcc, 0x12, 0xdc, 0x2f, 0xc6,
+	0x2f, 0x71, 0x6e, 0x48, 0x94, 0x79, 0x4e, 0x5a, 0x53, 0x29, 0x8d, 0xe2, 0x6f, 0xf2, 0x63, 0x48,
+	0x1e, 0x71, 0x7f, 0x60, 0x09, 0xf4, 0x5a, 0xfe, 0xd2, 0xae, 0x61, 0x1b, 0xc9, 0x54, 0x2b, 0x49,
+	0xf5, 0xa9, 0x54, 0x76, 0x99, 0xfa, 0x99, 0x84, 0xb6, 0x0a, 0x10, 0x58, 0x9e, 0x2d, 0xb7, 0x8f,
+	0x39, 0x98, 0xd4, 0x52, 0x34, 0x82, 0x90, 0x1f, 0x41, 0x9a, 0xc9, 0x50, 0xc2, 0xee, 0x7b, 0xe9,
+	0xc5, 0xb6, 0x35, 0x85, 0x1a, 0xb2, 0xf9, 0xfe, 0x08, 0x60, 0xe8, 0xbb, 0xcf, 0x98, 0x39, 0xe4,
+	0xbc, 0x5f, 0x4c, 0xbd, 0x98, 0x7a, 0x1a, 0x55, 0x0e, 0x38, 0xef, 0xcb, 0x8c, 0xa7, 0xf4, 0x83,
+	0x9e, 0xe5, 0x33, 0xd5, 0xd9, 0xe4, 0x68, 0x06, 0xb1, 0x16, 0x42, 0xf2, 0xe4, 0xca, 0xf6, 0x5b,
+	0x85, 0x6f, 0xa0, 0x5b, 0x17, 0x18, 0x58, 0xa7, 0xea, 0x9c, 0x04, 0xd2, 0x46, 0x20, 0x2c, 0x5f,
+	0x84, 0xc1, 0xa5, 0x72, 0x61, 0x06, 0x31, 0x15, 0x5b, 0xb3, 0xc1, 0x9d, 0x9d, 0x13, 0xdc, 0xf2,
+	0x64, 0xf0, 0x91, 0xe7, 0x04, 0x98, 0xee, 0x72, 0x54, 0x8f, 0xa4, 0xb2, 0x3d, 0xf2, 0x7d, 0x99,
+	0xa7, 0x10, 0xc1, 0x5c, 0x97, 0xa3, 0x59, 0x0d, 0x52, 0x89, 0xc9, 0x59, 0xa2, 0x50, 0x5f, 0x36,
+	0x97, 0xf1, 0x12, 0x05, 0x08, 0xa9, 0x8b, 0x66, 0x11, 0x96, 0xc2, 0x25, 0x14, 0x50, 0x18, 0x0e,
+	0x4b, 0x7f, 0x88, 0x41, 0x61, 0xb2, 0x7d, 0x94, 0x05, 0xa3, 0xbe, 0x90, 0x81, 0xaf, 0x3e, 0x16,
+	0xc3, 0x8f, 0xa9, 0x81, 0xcc, 0xa8, 0x7c, 0x38, 0xe4, 0x1e, 0xd3, 0x29, 0x2e, 0x4d, 0xc7, 0x63,
+	0xa9, 0x61, 0xf3, 0x3e, 0xf7, 0x75, 0xec, 0xa9, 0x81, 0x5c, 0xd4, 0x90, 0xbb, 0x9e, 0x08, 0x30,
+	0xf8, 0x72, 0x54, 0x8f, 0x64, 0xba, 0x94, 0x33, 0x35, 0xd5, 0xe9, 0x5a, 0x54, 0x05, 0x4b, 0x22,
+	0x78, 0x06, 0x4b, 0xbf, 0x8f, 0x47, 0xe7, 0xa4, 0x3c, 0x3d, 0x9b, 0xb3, 0x63, 0xb3, 0x39, 0x3b,
+	0x92, 0x91, 0xe2, 0x2f, 0x98, 0x91, 0x56, 0x60, 0x31, 0xb0, 0xb9, 0xcf, 0x74, 0xc6, 0x51, 0x03,
+	0xb9, 0xd4, 0xce, 0xc8, 0xee, 0xf5, 0x78, 0xff, 0x99, 0xce, 0xc8, 0xe3, 0xb1, 0xbc, 0x0c, 0x07,
+	0xdc, 0xf3, 0x58, 0x87, 0xfb, 0x9e, 0xd9, 0x61, 0xbe, 0xcc, 0x48, 0xea, 0x7a, 0xbf, 0x3c, 0xc6,
+	0xb7, 0x10, 0x26, 0x86, 0xec, 0x9a, 0xa5, 0x47, 0x03, 0x7d, 0x11, 0xb8, 0xec, 0xf8, 0x28, 0xff,
+	0x4f, 0xfa, 0x67, 0xd4, 0x2d, 0xfd, 0x39, 0x0e, 0x49, 0xd5, 0x2f, 0xce, 0xa4, 0x89, 0xeb, 0x90,
+	0xd4, 0x81, 0x17, 0xc7, 0xc0, 0xd3, 0x23, 0xf2, 0x3e, 0x24, 0xe4, 0xfb, 0x0b, 0xae, 0x2a, 0xf3,
+	0xe8, 0x56, 0x59, 0x3d, 0xce, 0x94, 0xc3, 0xc7, 0x99, 0x72, 0x3b, 0x7c, 0x9c, 0xd9, 0x4a, 0xc9,
+	0x6f, 0x7d, 0xfe, 0xaf, 0xb5, 0x18, 0x45, 0x0d, 0x79, 0x73, 0x0c, 0xf8, 0xc8, 0xb7, 0x99, 0x4e,
+	0x18, 0x77, 0x2f, 0xe8, 0x92, 0x5b, 0x48, 0xa4, 0x5a, 0x01, 0xfb, 0x2c, 0x76, 0x2a, 0xf4, 0x86,
+	0xe2, 0xef, 0x68, 0x1e, 0x4b, 0xbe, 0x44, 0x1e, 0x13, 0x56, 0x37, 0x7c, 0xbc, 0xc0, 0xdf, 0xb2,
+	0xfc, 0x0e, 0x7d, 0x76, 0x6c, 0xf6, 0xac, 0x40, 0x3d, 0x53, 0x64, 0x69, 0x4a, 0x02, 0xbb, 0x56,
+	0xd0, 0x93, 0x0a, 0x88, 0xa7, 0x11, 0xc7, 0xdf, 0xa5, 0x77, 0x20, 0x37, 0xd5, 0xca, 0xcf, 0xb8,
+	0x2e, 0x54, 0x8a, 0x47, 0x94, 0x7e, 0x05, 0x57, 0x67, 0x2e, 0x16, 0x32, 0xa8, 0xac, 0x91, 0xe8,
+	0xf1, 0x17, 0x28, 0x73, 0x8a, 0x27, 0x93, 0x82, 0x7e, 0x5d, 0xc2, 0x3c, 0xa0, 0xf7, 0x26, 0xa3,
+	0xb0, 0x96, 0x84, 0xd4, 0x81, 0x99, 0x34, 0x03, 0x6a, 0x50, 0xfa, 0x63, 0x1c, 0xb2, 0xd1, 0x3b,
+	0x83, 0x3c, 0xb8, 0xb6, 0x6e, 0x80, 0x55, 0x11, 0x0a, 0x87, 0x32, 0x44, 0x03, 0xf6, 0xd9, 0x88,
+	0x79, 0x36, 0xd3, 0xc5, 0x74, 0x3c, 0x96, 0xf9, 0x40, 0x6d, 0x89, 0x39, 0xe4, 0xbe, 0xd0, 0x67,
+	0x12, 0x14, 0x74, 0xc0, 0x7d, 0x21, 0x5b, 0x42, 0x4d, 0x08, 0xad, 0x63, 0x63, 0x4b, 0x73, 0x0a,
+	0xd5, 0xdd, 0xb5, 0x8c, 0xae, 0x80, 0x79, 0x8e, 0x0e, 0xf0, 0x34, 0xd5, 0x23, 0x69, 0x3f, 0x7c,
+	0xb8, 0xb1, 0x84, 0xa5, 0x9b, 0x55, 0x7d, 0x77, 0xaf, 0x59, 0xc2, 0x8a, 0x84, 0xe5, 0xd2, 0xdc,
+	0xb0, 0x4c, 0xbd, 0x6c, 0x58, 0x96, 0x9e, 0x2f, 0x42, 0x7e, 0xfa, 0xda, 0x23, 0x37, 0x10, 0x97,
+	0xa7, 0x1c, 0x83, 0xbf, 0xa3, 0xfe, 0x8a, 0x9f, 0xef, 0xaf, 0x85, 0x33, 0xfe, 0x7a, 0x7b, 0xbc,
+	0xce, 0xcb, 0xfa, 0xfb, 0x88, 0x07, 0x02, 0x99, 0x87, 0xf4, 0x2a, 0x93, 0xb8, 0x4a, 0x90, 0x90,
+	0x4e, 0xfa, 0xef, 0xc1, 0x0d, 0x39, 0x6f, 0x3e, 0x12, 0xa6, 0xcf, 0x8e, 0xdd, 0x40, 0x36, 0xcc,
+	0xde, 0x68, 0xd0, 0x61, 0xbe, 0xee, 0xdf, 0xaf, 0x69, 0x31, 0xd5, 0xd2, 0x06, 0x0a, 0xe7, 0xea,
+	0xe9, 0x8f, 0xa4, 0xe6, 0xea, 0xe9, 0xef, 0xbd, 0x09, 0x57, 0x43, 0xbd, 0xf1, 0xc3, 0xab, 0x7e,
+	0xa3, 0x2b, 0x68, 0xc1, 0xd8, 0xb9, 0xa4, 0x32, 0xae, 0xea, 0x70, 0xc1, 0x2b, 0xd5, 0xb4, 0xbb,
+	0x67, 0xaf, 0x2a, 0x2a, 0x3d, 0x61, 0xc5, 0x4b, 0x53, 0x3d, 0x52, 0x6f, 0x7a, 0x01, 0xef, 0x1f,
+	0x4f, 0xfa, 0xad, 0x2c, 0x3a, 0x27, 0x1f, 0xc2, 0x7a, 0xc2, 0x04, 0x12, 0x18, 0x3c, 0x39, 0x75,
+	0xfc, 0xe4, 0x6f, 0xb2, 0x05, 0x59, 0x16, 0xd8, 0x3e, 0x3e, 0x63, 0xbe, 0xc4, 0x7b, 0x5c, 0x26,
+	0x54, 0x92, 0x4d, 0x01, 0x85, 0xe5, 0xff, 0xfb, 0x29, 0x2e, 0x7f, 0x34, 0x35, 0x96, 0xc7, 0xc5,
+	0x67, 0xb2, 0x4d, 0x19, 0x47, 0x90, 0xba, 0x30, 0xe4, 0x10, 0x6d, 0x69, 0xf0, 0x27, 0x89, 0xd4,
+	0x62, 0x21, 0x49, 0xa3, 0x47, 0xa3, 0xf4, 0xa7, 0x05, 0x80, 0xc9, 0x65, 0x57, 0x16, 0x3e, 0x1d,
+	0x8f, 0x61, 0x05, 0x4b, 0xd3, 0xb4, 0x46, 0xea, 0x0e, 0xb9, 0x01, 0x4b, 0x32, 0x8a, 0xa5, 0x4c,
+	0x45, 0x6f, 0x52, 0x0e, 0xb1, 0xae, 0xad, 0xd8, 0x2a, 0x1b, 0x0d, 0x2d, 0x5f, 0x3c, 0x35, 0x43,
+	0x96, 0x3a, 0xd9, 0x24, 0x2a, 0x3b, 0x50, 0x1a, 0xef, 0xc1, 0x8d, 0x29, 0x8d, 0xc8, 0x67, 0xd5,
+	0x51, 0xbf, 0x16, 0x15, 0x57, 0xc7, 0x53, 0x78, 0x03, 0x96, 0x6d, 0x59, 0xc5, 0xb0, 0x43, 0x33,
+	0x7b, 0x7c, 0x18, 0xe0, 0x93, 0x58, 0x9a, 0xe6, 0x27, 0xf0, 0x2e, 0x1f, 0x62, 0x4b, 0x71, 0xcc,
+	0x7c, 0x19, 0x81, 0xfa, 0xfc, 0x87, 0x43, 0x59, 0xa9, 0xf9, 0x90, 0x45, 0x1a, 0x6e, 0x95, 0x03,
+	0xb2, 0x0a, 0xd4, 0xdb, 0x2f, 0x7b, 0x2f, 0x8c, 0xab, 0xc0, 0x94, 0xa7, 0x46, 0x07, 0x77, 0x46,
+	0x63, 0x2d, 0xd9, 0x53, 0xdc, 0x87, 0x42, 0x48, 0xf1, 0x99, 0xcd, 0xdc, 0x63, 0x7d, 0x73, 0x4d,
+	0xd0, 0x65, 0x8d, 0x53, 0x0d, 0xcb, 0x0d, 0x0a, 0xa9, 0x47, 0x96, 0xdb, 0xd7, 0xb7, 0xd7, 0x04,
+	0xcd, 0x69, 0x74, 0x1b, 0x41, 0x19, 0xb4, 0x76, 0x9f, 0x07, 0xcc, 0xc1, 0xa0, 0x4d, 0x51, 0x3d,
+	0x2a, 0xfd, 0x75, 0x01, 0x96, 0xf4, 0xfb, 0xc7, 0x05, 0x19, 0x57, 0x15, 0x90, 0xf8, 0xb8, 0x80,
+	0xdc, 0x86, 0xb4, 0x7a, 0xcb, 0x09, 0x77, 0x22, 0x41, 0x53, 0x0a, 0xa8, 0x3b, 0xe4, 0x43, 0xc8,
+	0xf6, 0xb9, 0x6d, 0xf5, 0x75, 0xeb, 0x78, 0x69, 0x62, 0xc9, 0x20, 0x7b, 0xd2, 0xeb, 0x68, 0xcb,
+	0x5a, 0x5b, 0xa5, 0xdf, 0xac, 0x02, 0x35, 0x69, 0x0d, 0x94, 0x8e, 0xa9, 0x1a, 0x2f, 0x9d, 0x84,
+	0x11, 0xaa, 0x4a, 0x84, 0x7c, 0x00, 0x09, 0xd9, 0x53, 0x15, 0x97, 0x5e, 0xe6, 0x8a, 0x82, 0x2a,
+	0xe8, 0x7a, 0xe6, 0x39, 0xf2, 0xdf, 0x24, 0xe3, 0x90, 0xd7, 0x2f, 0x83, 0x1a, 0x0f, 0x83, 0x9e,
+	0x7c, 0x08, 0x29, 0x9f, 0xf7, 0xfb, 0x1d, 0xf9, 0x96, 0x92, 0x7e, 0xa1, 0x2f, 0xd1, 0xb1, 0x02,
+	0xd9, 0x81, 0xec, 0x68, 0xe8, 0x58, 0x82, 0x39, 0x98, 0xb5, 0x8a, 0xf0, 0x12, 0x75, 0x21, 0xa3,
+	0x35, 0xa5, 0xec, 0xc1, 0x6f, 0x63, 0xb0, 0x32, 0xef, 0x28, 0x93, 0xd7, 0xa1, 0x44, 0x8d, 0x6a,
+	0x93, 0xd6, 0xcc, 0x6d, 0xc3, 0x30, 0x6b, 0x46, 0xab, 0x5d, 0x6f, 0x54, 0xda, 0xf5, 0x66, 0xc3,
+	0x3c, 0x6c, 0xb4, 0x0e, 0x8c, 0x6a, 0x7d, 0xbb, 0x6e, 0xd4, 0x0a, 0x57, 0xc8, 0x1a, 0xdc, 0x3e,
+	0x87, 0xb7, 0x75, 0x48, 0x1b, 0x85, 0x18, 0xb9, 0x0f, 0xaf, 0x9d, 0x43, 0xa8, 0x36, 0xf7, 0xf7,
+	0x0f, 0x1b, 0xf5, 0xf6, 0xa7, 0xe6, 0x41, 0xb3, 0xb9, 0x57, 0x88, 0xcb, 0xc9, 0xc0, 0xe4, 0x75,
+	0x87, 0xdc, 0x86, 0x1b, 0x3b, 0x95, 0x7d, 0xc3, 0x6c, 0xb5, 0x2b, 0xed, 0xc3, 0xd6, 0x99, 0xef,
+	0x5e, 0x07, 0x12, 0x15, 0x56, 0xaa, 0xed, 0xfa, 0x4f, 0x8d, 0x42, 0x8c, 0x14, 0x61, 0x25, 0x8a,
+	0x6f, 0xd7, 0x1b, 0xf5, 0xd6, 0xae, 0x51, 0x2b, 0xc4, 0xc9, 0x0d, 0x78, 0x25, 0x2a, 0x39, 0x30,
+	0x1a, 0xb5, 0x7a, 0x63, 0xa7, 0xb0, 0x40, 0x56, 0xa0, 0x10, 0x15, 0x34, 0x0f, 0x8c, 0x46, 0x21,
+	0xf1, 0x60, 0x04, 0x85, 0xb3, 0xb7, 0x3b, 0x72, 0x17, 0xee, 0xb4, 0x9b, 0x87, 0xb4, 0x51, 0xd9,
+	0x37, 0x1a, 0x6d, 0x73, 0xbb, 0x49, 0xf7, 0x2b, 0xed, 0x33, 0xf3, 0x9a, 0x4b, 0xa1, 0xcd, 0xc3,
+	0x46, 0xcd, 0xa4, 0xcd, 0xad, 0xba, 0xf4, 0xc8, 0x6d, 0xb8, 0x31, 0x4b, 0x69, 0x3d, 0xae, 0xb7,
+	0x5a, 0x85, 0xf8, 0x83, 0xbf, 0x4c, 0xdd, 0x2b, 0xb4, 0x27, 0xa6, 0x8d, 0xce, 0xf5, 0x47, 0x09,
+	0x56, 0x67, 0x29, 0xd4, 0xd8, 0xa9, 0xb7, 0xda, 0x14, 0xdd, 0x5d, 0x88, 0x91, 0x3b, 0x70, 0x73,
+	0x0e, 0xe7, 0xb0, 0xd1, 0x90, 0x7e, 0x88, 0x93, 0x55, 0xb8, 0x35, 0x2b, 0x1e, 0x3b, 0x70, 0x41,
+	0x6e, 0xf5, 0xac, 0xbc, 0x5a, 0x69, 0x54, 0x8d, 0xbd, 0x3d, 0xa3, 0x56, 0x48, 0x3c, 0x78, 0x1e,
+	0x83, 0xac, 0x0a, 0x26, 0xd5, 0xe0, 0xca, 0x0f, 0xea, 0xbd, 0x6f, 0x35, 0x0f, 0x69, 0xd5, 0x38,
+	0x33, 0xe7, 0x9b, 0x70, 0x6d, 0x5a, 0xbc, 0x63, 0x34, 0x8c, 0x56, 0xbd, 0xa5, 0xa6, 0x3a, 0x2d,
+	0xda, 0x32, 0x76, 0xea, 0x0d, 0x73, 0x6b, 0xaf, 0x59, 0xfd, 0xb8, 0x10, 0x97, 0x2e, 0x9c, 0x16,
+	0x1b, 0x8d, 0x9a, 0x16, 0xe2, 0x7e, 0x4e, 0x0b, 0xdb, 0x3f, 0x2b, 0x24, 0x64, 0x60, 0x4c, 0xa3,
+	0x7b, 0xc6, 0x4e, 0xa5, 0xfa, 0x69, 0x61, 0xf1, 0xc1, 0xdf, 0x62, 0xb0, 0x32, 0xaf, 0x66, 0xcb,
+	0x33, 0xd0, 0x3c, 0x6c, 0xef, 0x34, 0xeb, 0x8d, 0x1d, 0xf3, 0xa0, 0x52, 0xfd, 0xd8, 0x38, 0xdf,
+	0xf7, 0xe7, 0xf0, 0xc2, 0x20, 0x8b, 0x91, 0x37, 0xe0, 0xde, 0x39, 0x9c, 0x4a, 0xf5, 0xe3, 0x46,
+	0xf3, 0xf1, 0x9e, 0x51, 0xdb, 0xc1, 0x30, 0xbd, 0x0b, 0x77, 0xce, 0x21, 0x6e, 0x57, 0xea, 0x7b,
+	0xb8, 0x11, 0xaf, 0xc2, 0xfa, 0x39, 0x94, 0x76, 0x7d, 0xdf, 0xa8, 0x99, 0xcd, 0xc3, 0x76, 0x21,
+	0xb1, 0xf5, 0xee, 0x17, 0xdf, 0xae, 0xc6, 0xbe, 0xfc, 0x76, 0x35, 0xf6, 0xef, 0x6f, 0x57, 0x63,
+	0x9f, 0x7f, 0xb7, 0x7a, 0xe5, 0xcb, 0xef, 0x56, 0xaf, 0x7c, 0xfd, 0xdd, 0xea, 0x95, 0x9f, 0xdf,
+	0xee, 0xba, 0xa2, 0x37, 0xea, 0x94, 0x6d, 0x3e, 0xd8, 0x3c, 0xfb, 0x4f, 0xf0, 0x4e, 0x12, 0x93,
+	0xc7, 0x3b, 0xff, 0x1d, 0x00, 0xfc, 0x91, 0x97, 0x60, 0x1f, 0x1f, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxQueueSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxQueueSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RecordFeeDestination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordFeeDestination))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.RecordFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.RecordRateWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordRateWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RecordRateLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordRateLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RecordDenylist) > 0 {
+		for iNdEx := len(m.RecordDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordDenylist[iNdEx])
+			copy(dAtA[i:], m.RecordDenylist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RecordDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RecordMinLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordMinLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RecordRetention != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordRetention))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RecordMaxLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordMaxLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AllowedWagerDenoms) > 0 {
+		for iNdEx := len(m.AllowedWagerDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedWagerDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedWagerDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedWagerDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.MinWager.Size()
+		i -= size
+		if _, err := m.MinWager.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxActiveGames != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxActiveGames))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTurnDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTurnDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MatchmakingWindowGrowth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MatchmakingWindowGrowth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchmakingRatingWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MatchmakingRatingWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InvitationExpiryBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InvitationExpiryBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultChannel) > 0 {
+		i -= len(m.DefaultChannel)
+		copy(dAtA[i:], m.DefaultChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DefaultChannel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.IbcChannelList) > 0 {
+		for iNdEx := len(m.IbcChannelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcChannelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.IbcGameCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IbcGameCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.IbcGameList) > 0 {
+		for iNdEx := len(m.IbcGameList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcGameList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.OutgoingPacketList) > 0 {
+		for iNdEx := len(m.OutgoingPacketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingPacketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RemoteRecordList) > 0 {
+		for iNdEx := len(m.RemoteRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.RecordLogHead != nil {
+		{
+			size, err := m.RecordLogHead.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RecordRateCounters) > 0 {
+		for iNdEx := len(m.RecordRateCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordRateCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TournamentCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TournamentCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TournamentPlayerList) > 0 {
+		for iNdEx := len(m.TournamentPlayerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentPlayerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TournamentList) > 0 {
+		for iNdEx := len(m.TournamentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.QueueEntryList) > 0 {
+		for iNdEx := len(m.QueueEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueueEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IndexedPlayerInfoList) > 0 {
+		for iNdEx := len(m.IndexedPlayerInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexedPlayerInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecordList) > 0 {
+		for iNdEx := len(m.RecordList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordList[iNdEx])
+			copy(dAtA[i:], m.RecordList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RecordList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IndexedStoredGameList) > 0 {
+		for iNdEx := len(m.IndexedStoredGameList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexedStoredGameList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StoredGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TournamentId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TournamentId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TurnDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TurnDuration))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinRating != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinRating))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.Wager.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.InvitationDeadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InvitationDeadline))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RedAccepted {
+		i--
+		if m.RedAccepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BlackAccepted {
+		i--
+		if m.BlackAccepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DrawOffer) > 0 {
+		i -= len(m.DrawOffer)
+		copy(dAtA[i:], m.DrawOffer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DrawOffer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Red) > 0 {
+		i -= len(m.Red)
+		copy(dAtA[i:], m.Red)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Red)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Black) > 0 {
+		i -= len(m.Black)
+		copy(dAtA[i:], m.Black)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Black)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Turn) > 0 {
+		i -= len(m.Turn)
+		copy(dAtA[i:], m.Turn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Turn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlayerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlayerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rating != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DrawnCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DrawnCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LostCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LostCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WonCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WonCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedPlayerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedPlayerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedPlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JoinedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Wager.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TurnDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TurnDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rating != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedStoredGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedStoredGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedStoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredGame.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Players) > 0 {
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Players[iNdEx])
+			copy(dAtA[i:], m.Players[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Players[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RoundGames) > 0 {
+		for iNdEx := len(m.RoundGames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoundGames[iNdEx])
+			copy(dAtA[i:], m.RoundGames[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RoundGames[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.CurrentRound != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentRound))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TurnDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TurnDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxPlayers != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPlayers))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PrizeShares) > 0 {
+		dAtA9 := make([]byte, len(m.PrizeShares)*10)
+		var j8 int
+		for _, num := range m.PrizeShares {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTypes(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.PrizePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.EntryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Sanctioned {
+		i--
+		if m.Sanctioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Format != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Points != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Opponent) > 0 {
+		i -= len(m.Opponent)
+		copy(dAtA[i:], m.Opponent)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Opponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentPlayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentPlayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SonnebornBerger != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SonnebornBerger))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Buchholz != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Buchholz))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Score != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TournamentId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TournamentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Record) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PrevHash) > 0 {
+		i -= len(m.PrevHash)
+		copy(dAtA[i:], m.PrevHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PrevHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x20
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordLogHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordLogHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordLogHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordRateCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRateCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRateCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RecordData) > 0 {
+		i -= len(m.RecordData)
+		copy(dAtA[i:], m.RecordData)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecordData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetrySequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetrySequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FeeDestination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FeeDestination))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.EscrowedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PacketsFailed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PacketsFailed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PacketsReceived != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PacketsReceived))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PacketsSent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PacketsSent))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OpenedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpenedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CounterpartyChannelId) > 0 {
+		i -= len(m.CounterpartyChannelId)
+		copy(dAtA[i:], m.CounterpartyChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CounterpartyChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyPortId) > 0 {
+		i -= len(m.CounterpartyPortId)
+		copy(dAtA[i:], m.CounterpartyPortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CounterpartyPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x52
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PendingSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PendingSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Game.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.LocalColor) > 0 {
+		i -= len(m.LocalColor)
+		copy(dAtA[i:], m.LocalColor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LocalColor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RemotePlayer) > 0 {
+		i -= len(m.RemotePlayer)
+		copy(dAtA[i:], m.RemotePlayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RemotePlayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LocalPlayer) > 0 {
+		i -= len(m.LocalPlayer)
+		copy(dAtA[i:], m.LocalPlayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LocalPlayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemoteId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RemoteId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvitationExpiryBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.InvitationExpiryBlocks))
+	}
+	if m.MatchmakingRatingWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MatchmakingRatingWindow))
+	}
+	if m.MatchmakingWindowGrowth != 0 {
+		n += 1 + sovTypes(uint64(m.MatchmakingWindowGrowth))
+	}
+	if m.MaxTurnDuration != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTurnDuration))
+	}
+	if m.MaxActiveGames != 0 {
+		n += 1 + sovTypes(uint64(m.MaxActiveGames))
+	}
+	l = m.MinWager.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.AllowedWagerDenoms) > 0 {
+		for _, s := range m.AllowedWagerDenoms {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RecordMaxLength != 0 {
+		n += 1 + sovTypes(uint64(m.RecordMaxLength))
+	}
+	if m.RecordRetention != 0 {
+		n += 1 + sovTypes(uint64(m.RecordRetention))
+	}
+	if m.RecordMinLength != 0 {
+		n += 1 + sovTypes(uint64(m.RecordMinLength))
+	}
+	if len(m.RecordDenylist) > 0 {
+		for _, s := range m.RecordDenylist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RecordRateLimit != 0 {
+		n += 1 + sovTypes(uint64(m.RecordRateLimit))
+	}
+	if m.RecordRateWindow != 0 {
+		n += 1 + sovTypes(uint64(m.RecordRateWindow))
+	}
+	l = m.RecordFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RecordFeeDestination != 0 {
+		n += 1 + sovTypes(uint64(m.RecordFeeDestination))
+	}
+	if m.MaxQueueSize != 0 {
+		n += 2 + sovTypes(uint64(m.MaxQueueSize))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.IndexedStoredGameList) > 0 {
+		for _, e := range m.IndexedStoredGameList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RecordList) > 0 {
+		for _, s := range m.RecordList {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.IndexedPlayerInfoList) > 0 {
+		for _, e := range m.IndexedPlayerInfoList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.QueueEntryList) > 0 {
+		for _, e := range m.QueueEntryList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TournamentList) > 0 {
+		for _, e := range m.TournamentList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TournamentPlayerList) > 0 {
+		for _, e := range m.TournamentPlayerList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.TournamentCount != 0 {
+		n += 1 + sovTypes(uint64(m.TournamentCount))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovTypes(uint64(m.RecordCount))
+	}
+	if len(m.RecordRateCounters) > 0 {
+		for _, e := range m.RecordRateCounters {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RecordLogHead != nil {
+		l = m.RecordLogHead.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.RemoteRecordList) > 0 {
+		for _, e := range m.RemoteRecordList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.OutgoingPacketList) > 0 {
+		for _, e := range m.OutgoingPacketList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.IbcGameList) > 0 {
+		for _, e := range m.IbcGameList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.IbcGameCount != 0 {
+		n += 2 + sovTypes(uint64(m.IbcGameCount))
+	}
+	if len(m.IbcChannelList) > 0 {
+		for _, e := range m.IbcChannelList {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.DefaultChannel)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StoredGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Turn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Black)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Red)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovTypes(uint64(m.MoveCount))
+	}
+	l = len(m.DrawOffer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlackAccepted {
+		n += 2
+	}
+	if m.RedAccepted {
+		n += 2
+	}
+	if m.InvitationDeadline != 0 {
+		n += 1 + sovTypes(uint64(m.InvitationDeadline))
+	}
+	l = m.Wager.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MinRating != 0 {
+		n += 1 + sovTypes(uint64(m.MinRating))
+	}
+	if m.TurnDuration != 0 {
+		n += 1 + sovTypes(uint64(m.TurnDuration))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	if m.TournamentId != 0 {
+		n += 2 + sovTypes(uint64(m.TournamentId))
+	}
+	return n
+}
+
+func (m *PlayerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WonCount != 0 {
+		n += 1 + sovTypes(uint64(m.WonCount))
+	}
+	if m.LostCount != 0 {
+		n += 1 + sovTypes(uint64(m.LostCount))
+	}
+	if m.DrawnCount != 0 {
+		n += 1 + sovTypes(uint64(m.DrawnCount))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovTypes(uint64(m.Rating))
+	}
+	return n
+}
+
+func (m *IndexedPlayerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.PlayerInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovTypes(uint64(m.Rating))
+	}
+	if m.TurnDuration != 0 {
+		n += 1 + sovTypes(uint64(m.TurnDuration))
+	}
+	l = m.Wager.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.JoinedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.JoinedHeight))
+	}
+	return n
+}
+
+func (m *IndexedStoredGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.StoredGame.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Tournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTypes(uint64(m.Format))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Sanctioned {
+		n += 2
+	}
+	l = m.EntryFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PrizePool.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PrizeShares) > 0 {
+		l = 0
+		for _, e := range m.PrizeShares {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.MaxPlayers != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPlayers))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.TurnDuration != 0 {
+		n += 1 + sovTypes(uint64(m.TurnDuration))
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovTypes(uint64(m.Rounds))
+	}
+	if m.CurrentRound != 0 {
+		n += 1 + sovTypes(uint64(m.CurrentRound))
+	}
+	if len(m.RoundGames) > 0 {
+		for _, s := range m.RoundGames {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Players) > 0 {
+		for _, s := range m.Players {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TournamentResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = len(m.Opponent)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovTypes(uint64(m.Points))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TournamentPlayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TournamentId != 0 {
+		n += 1 + sovTypes(uint64(m.TournamentId))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTypes(uint64(m.Score))
+	}
+	if m.Buchholz != 0 {
+		n += 1 + sovTypes(uint64(m.Buchholz))
+	}
+	if m.SonnebornBerger != 0 {
+		n += 1 + sovTypes(uint64(m.SonnebornBerger))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Record) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PrevHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordLogHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordRateCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStart))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *RemoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RecordData)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *OutgoingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SentHeight))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutTimestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ResolvedHeight))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.EscrowedFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.FeeDestination != 0 {
+		n += 1 + sovTypes(uint64(m.FeeDestination))
+	}
+	if m.RetrySequence != 0 {
+		n += 2 + sovTypes(uint64(m.RetrySequence))
+	}
+	return n
+}
+
+func (m *IbcChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CounterpartyPortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OpenedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.OpenedHeight))
+	}
+	if m.PacketsSent != 0 {
+		n += 1 + sovTypes(uint64(m.PacketsSent))
+	}
+	if m.PacketsReceived != 0 {
+		n += 1 + sovTypes(uint64(m.PacketsReceived))
+	}
+	if m.PacketsFailed != 0 {
+		n += 1 + sovTypes(uint64(m.PacketsFailed))
+	}
+	if m.Closed {
+		n += 2
+	}
+	return n
+}
+
+func (m *IbcGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.RemoteId != 0 {
+		n += 1 + sovTypes(uint64(m.RemoteId))
+	}
+	l = len(m.LocalPlayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RemotePlayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LocalColor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Game.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PendingSequence != 0 {
+		n += 1 + sovTypes(uint64(m.PendingSequence))
+	}
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationExpiryBlocks", wireType)
+			}
+			m.InvitationExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvitationExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchmakingRatingWindow", wireType)
+			}
+			m.MatchmakingRatingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchmakingRatingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchmakingWindowGrowth", wireType)
+			}
+			m.MatchmakingWindowGrowth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchmakingWindowGrowth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+			}
+			m.MaxTurnDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTurnDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGames", wireType)
+			}
+			m.MaxActiveGames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveGames |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedWagerDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedWagerDenoms = append(m.AllowedWagerDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordMaxLength", wireType)
+			}
+			m.RecordMaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordMaxLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRetention", wireType)
+			}
+			m.RecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordMinLength", wireType)
+			}
+			m.RecordMinLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordMinLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordDenylist = append(m.RecordDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRateLimit", wireType)
+			}
+			m.RecordRateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRateLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRateWindow", wireType)
+			}
+			m.RecordRateWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRateWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFeeDestination", wireType)
+			}
+			m.RecordFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordFeeDestination |= RecordFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+			}
+			m.MaxQueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueueSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedStoredGameList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedStoredGameList = append(m.IndexedStoredGameList, IndexedStoredGame{})
+			if err := m.IndexedStoredGameList[len(m.IndexedStoredGameList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordList = append(m.RecordList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedPlayerInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedPlayerInfoList = append(m.IndexedPlayerInfoList, IndexedPlayerInfo{})
+			if err := m.IndexedPlayerInfoList[len(m.IndexedPlayerInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueEntryList = append(m.QueueEntryList, QueueEntry{})
+			if err := m.QueueEntryList[len(m.QueueEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentList = append(m.TournamentList, Tournament{})
+			if err := m.TournamentList[len(m.TournamentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentPlayerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentPlayerList = append(m.TournamentPlayerList, TournamentPlayer{})
+			if err := m.TournamentPlayerList[len(m.TournamentPlayerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentCount", wireType)
+			}
+			m.TournamentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TournamentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRateCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordRateCounters = append(m.RecordRateCounters, RecordRateCounter{})
+			if err := m.RecordRateCounters[len(m.RecordRateCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordLogHead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordLogHead == nil {
+				m.RecordLogHead = &RecordLogHead{}
+			}
+			if err := m.RecordLogHead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteRecordList = append(m.RemoteRecordList, RemoteRecord{})
+			if err := m.RemoteRecordList[len(m.RemoteRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingPacketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingPacketList = append(m.OutgoingPacketList, OutgoingPacket{})
+			if err := m.OutgoingPacketList[len(m.OutgoingPacketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcGameList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcGameList = append(m.IbcGameList, IbcGame{})
+			if err := m.IbcGameList[len(m.IbcGameList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcGameCount", wireType)
+			}
+			m.IbcGameCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcGameCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannelList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannelList = append(m.IbcChannelList, IbcChannel{})
+			if err := m.IbcChannelList[len(m.IbcChannelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoredGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Black = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Red = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= GameStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawOffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrawOffer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackAccepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlackAccepted = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedAccepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedAccepted = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationDeadline", wireType)
+			}
+			m.InvitationDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvitationDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Wager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRating", wireType)
+			}
+			m.MinRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+			}
+			m.TurnDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TurnDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+			}
+			m.TournamentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TournamentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlayerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlayerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WonCount", wireType)
+			}
+			m.WonCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WonCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostCount", wireType)
+			}
+			m.LostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawnCount", wireType)
+			}
+			m.DrawnCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawnCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedPlayerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedPlayerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedPlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+			}
+			m.TurnDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TurnDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Wager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedHeight", wireType)
+			}
+			m.JoinedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedStoredGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedStoredGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedStoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredGame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= TournamentFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TournamentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sanctioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sanctioned = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PrizeShares = append(m.PrizeShares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PrizeShares) == 0 {
+					m.PrizeShares = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PrizeShares = append(m.PrizeShares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeShares", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+			}
+			m.MaxPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlayers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+			}
+			m.TurnDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TurnDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRound", wireType)
+			}
+			m.CurrentRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundGames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundGames = append(m.RoundGames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Players = append(m.Players, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opponent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentPlayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentPlayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentPlayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+			}
+			m.TournamentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TournamentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buchholz", wireType)
+			}
+			m.Buchholz = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Buchholz |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SonnebornBerger", wireType)
+			}
+			m.SonnebornBerger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SonnebornBerger |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, TournamentResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Record) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= RecordSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevHash = append(m.PrevHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevHash == nil {
+				m.PrevHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordLogHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordLogHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordLogHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordRateCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordRateCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordRateCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *IndexedStoredGame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.StoredGame.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OutgoingPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -557,25 +8270,391 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+			}
+			m.TimeoutRevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+			}
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutgoingPacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			m.FeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDestination |= RecordFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrySequence", wireType)
+			}
+			m.RetrySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetrySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -597,7 +8676,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *IbcChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,21 +8695,117 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -640,30 +8815,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexedStoredGameList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -673,29 +8847,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexedStoredGameList = append(m.IndexedStoredGameList, IndexedStoredGame{})
-			if err := m.IndexedStoredGameList[len(m.IndexedStoredGameList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -723,8 +8895,104 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordList = append(m.RecordList, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+			}
+			m.OpenedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsSent", wireType)
+			}
+			m.PacketsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsReceived", wireType)
+			}
+			m.PacketsReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsFailed", wireType)
+			}
+			m.PacketsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -746,7 +9014,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoredGame) Unmarshal(dAtA []byte) error {
+func (m *IbcGame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -769,15 +9037,15 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoredGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: IbcGame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IbcGame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -805,11 +9073,49 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Board = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteId", wireType)
+			}
+			m.RemoteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalPlayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -837,11 +9143,11 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Turn = string(dAtA[iNdEx:postIndex])
+			m.LocalPlayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePlayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -869,11 +9175,11 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Black = string(dAtA[iNdEx:postIndex])
+			m.RemotePlayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalColor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -901,63 +9207,65 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Red = string(dAtA[iNdEx:postIndex])
+			m.LocalColor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IndexedStoredGame) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if err := m.Game.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSequence", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedStoredGame: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedStoredGame: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -967,27 +9275,31 @@ func (m *IndexedStoredGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Rollback == nil {
+				m.Rollback = &StoredGame{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1014,7 +9326,7 @@ func (m *IndexedStoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredGame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex